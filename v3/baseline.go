@@ -0,0 +1,161 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package zlint
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/zmap/zlint/v3/lint"
+)
+
+// A Baseline records the findings of a run of ZLint so that later runs may be
+// compared against it. Findings are keyed by the fingerprint of the object
+// that was linted (see Fingerprint) and then by lint name. Only results with a
+// status of notice, warn, error or fatal are findings.
+//
+// A Baseline serializes to and from JSON, e.g.
+//
+// ```
+//
+//	{"findings":{"3817c1e5...":{"e_rsa_fermat_factorization":"error"}}}
+//
+// ```
+type Baseline struct {
+	Findings map[string]map[string]lint.LintStatus `json:"findings"`
+}
+
+// A BaselineEntry is a single failed lint for a single linted object.
+type BaselineEntry struct {
+	Fingerprint string          `json:"fingerprint"`
+	Lint        string          `json:"lint"`
+	Status      lint.LintStatus `json:"status"`
+	// Previous is the status of a finding within the baseline that has since
+	// escalated to the more severe Status, e.g. from warn to error. It is
+	// lint.Reserved for all other entries.
+	Previous lint.LintStatus `json:"previous,omitempty"`
+}
+
+// A BaselineComparison is the outcome of comparing a run of ZLint against a
+// Baseline.
+type BaselineComparison struct {
+	// New holds the findings of the run that are not within the baseline, as
+	// well as those that have escalated to a more severe status since it was
+	// recorded.
+	New []BaselineEntry `json:"new"`
+	// Fixed holds the findings of the baseline that are no longer present
+	// within the run. Only the objects that were linted by the run are
+	// considered.
+	Fixed []BaselineEntry `json:"fixed"`
+	// Unchanged holds the findings of the run that are also within the
+	// baseline at the same or a more severe status.
+	Unchanged []BaselineEntry `json:"unchanged"`
+}
+
+// Fingerprint returns the hex encoded SHA-256 hash of the DER encoding of a
+// linted object (e.g. the Raw field of a certificate).
+func Fingerprint(der []byte) string {
+	sum := sha256.Sum256(der)
+	return hex.EncodeToString(sum[:])
+}
+
+// NewBaseline returns an empty Baseline.
+func NewBaseline() *Baseline {
+	return &Baseline{Findings: make(map[string]map[string]lint.LintStatus)}
+}
+
+// ReadBaseline reads a Baseline that was previously written with Write.
+func ReadBaseline(r io.Reader) (*Baseline, error) {
+	b := NewBaseline()
+	if err := json.NewDecoder(r).Decode(b); err != nil {
+		return nil, fmt.Errorf("failed to parse baseline: %w", err)
+	}
+	if b.Findings == nil {
+		b.Findings = make(map[string]map[string]lint.LintStatus)
+	}
+	return b, nil
+}
+
+// Write serializes the Baseline to w as JSON.
+func (b *Baseline) Write(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", " ")
+	return enc.Encode(b)
+}
+
+// Add records the findings within results for the object with the given
+// fingerprint. The object is recorded even if it has no findings, so that a
+// comparison can tell that all of its previous findings were fixed.
+func (b *Baseline) Add(fingerprint string, results *ResultSet) {
+	findings, ok := b.Findings[fingerprint]
+	if !ok {
+		findings = make(map[string]lint.LintStatus)
+		b.Findings[fingerprint] = findings
+	}
+	for name, res := range results.Results {
+		switch res.Status {
+		case lint.Notice, lint.Warn, lint.Error, lint.Fatal:
+			findings[name] = res.Status
+		}
+	}
+}
+
+// Compare compares the findings of the run recorded within current against
+// those of the Baseline. Findings are matched by fingerprint and lint name. A
+// finding whose status has escalated (e.g. from warn to error) is reported as
+// new, with its status within the baseline as Previous, whereas one whose
+// status has de-escalated is reported as unchanged with its current status.
+// Each list of findings is sorted by fingerprint and then by lint name.
+func (b *Baseline) Compare(current *Baseline) BaselineComparison {
+	cmp := BaselineComparison{New: []BaselineEntry{}, Fixed: []BaselineEntry{}, Unchanged: []BaselineEntry{}}
+	for fingerprint, findings := range current.Findings {
+		previous := b.Findings[fingerprint]
+		for name, status := range findings {
+			finding := BaselineEntry{Fingerprint: fingerprint, Lint: name, Status: status}
+			was, ok := previous[name]
+			switch {
+			case !ok:
+				cmp.New = append(cmp.New, finding)
+			case status > was:
+				finding.Previous = was
+				cmp.New = append(cmp.New, finding)
+			default:
+				cmp.Unchanged = append(cmp.Unchanged, finding)
+			}
+		}
+		for name, status := range previous {
+			if _, ok := findings[name]; !ok {
+				cmp.Fixed = append(cmp.Fixed, BaselineEntry{Fingerprint: fingerprint, Lint: name, Status: status})
+			}
+		}
+	}
+	sortBaselineEntries(cmp.New)
+	sortBaselineEntries(cmp.Fixed)
+	sortBaselineEntries(cmp.Unchanged)
+	return cmp
+}
+
+func sortBaselineEntries(entries []BaselineEntry) {
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Fingerprint != entries[j].Fingerprint {
+			return entries[i].Fingerprint < entries[j].Fingerprint
+		}
+		return entries[i].Lint < entries[j].Lint
+	})
+}
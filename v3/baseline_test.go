@@ -0,0 +1,93 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package zlint
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/zmap/zlint/v3/lint"
+)
+
+func TestBaselineCompare(t *testing.T) {
+	results := func(statuses map[string]lint.LintStatus) *ResultSet {
+		res := &ResultSet{Results: make(map[string]*lint.LintResult)}
+		for name, status := range statuses {
+			res.Results[name] = &lint.LintResult{Status: status}
+		}
+		return res
+	}
+
+	stored := NewBaseline()
+	stored.Add("aa", results(map[string]lint.LintStatus{
+		"e_fixed":       lint.Error,
+		"w_unchanged":   lint.Warn,
+		"e_escalated":   lint.Warn,
+		"e_deescalated": lint.Fatal,
+		"n_pass":        lint.Pass,
+	}))
+	stored.Add("bb", results(map[string]lint.LintStatus{
+		"e_not_relinted": lint.Error,
+	}))
+	var buf bytes.Buffer
+	if err := stored.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	previous, err := ReadBaseline(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(previous, stored) {
+		t.Fatalf("expected the baseline to survive a round trip, got %v", previous.Findings)
+	}
+
+	current := NewBaseline()
+	current.Add("aa", results(map[string]lint.LintStatus{
+		"e_fixed":       lint.Pass,
+		"w_unchanged":   lint.Warn,
+		"e_escalated":   lint.Error,
+		"e_deescalated": lint.Error,
+		"e_new":         lint.Error,
+	}))
+	current.Add("cc", results(map[string]lint.LintStatus{
+		"n_new": lint.Notice,
+	}))
+
+	got := previous.Compare(current)
+	want := BaselineComparison{
+		New: []BaselineEntry{
+			{Fingerprint: "aa", Lint: "e_escalated", Status: lint.Error, Previous: lint.Warn},
+			{Fingerprint: "aa", Lint: "e_new", Status: lint.Error},
+			{Fingerprint: "cc", Lint: "n_new", Status: lint.Notice},
+		},
+		Fixed: []BaselineEntry{
+			{Fingerprint: "aa", Lint: "e_fixed", Status: lint.Error},
+		},
+		Unchanged: []BaselineEntry{
+			{Fingerprint: "aa", Lint: "e_deescalated", Status: lint.Error},
+			{Fingerprint: "aa", Lint: "w_unchanged", Status: lint.Warn},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+}
+
+func TestReadBaselineInvalid(t *testing.T) {
+	if _, err := ReadBaseline(bytes.NewBufferString(`{"findings":{"aa":{"e_lint":"bogus"}}}`)); err == nil {
+		t.Error("expected an error for an unknown lint status")
+	}
+}
@@ -15,7 +15,10 @@
 package zlint
 
 import (
+	"context"
 	"encoding/pem"
+	"fmt"
+	"runtime"
 	"testing"
 
 	"github.com/zmap/zcrypto/x509"
@@ -179,3 +182,51 @@ func BenchmarkZlint(b *testing.B) {
 		})
 	}
 }
+
+// BenchmarkLintCertificateWithOptions compares linting a certificate serially
+// with linting it on worker pools of increasing size, both with the default
+// configuration and with the Fermat factorization lint configured to run a
+// large number of rounds (as is common when linting at scale).
+func BenchmarkLintCertificateWithOptions(b *testing.B) {
+	certDerBlock, _ := pem.Decode([]byte(bigCertificatePem))
+	x509Cert, err := x509.ParseCertificate(certDerBlock.Bytes)
+	if err != nil {
+		b.Fatalf("Error parsing certificate: %s", err.Error())
+	}
+
+	configs := []struct {
+		name   string
+		config string
+	}{
+		{"Default config", ""},
+		{"Fermat 1000 rounds", "[e_rsa_fermat_factorization]\nRounds = 1000\n"},
+	}
+	workers := []int{1, 2, 4, 8}
+	if runtime.NumCPU() > 8 {
+		workers = append(workers, runtime.NumCPU())
+	}
+
+	for _, c := range configs {
+		config, err := lint.NewConfigFromString(c.config)
+		if err != nil {
+			b.Fatalf("Error parsing configuration: %s", err.Error())
+		}
+		registry, err := lint.GlobalRegistry().Filter(lint.FilterOptions{})
+		if err != nil {
+			b.Fatalf("Error filtering registry: %s", err.Error())
+		}
+		registry.SetConfiguration(config)
+
+		for _, n := range workers {
+			opts := LintOptions{Workers: n}
+			b.Run(fmt.Sprintf("%s/%d workers", c.name, n), func(b *testing.B) {
+				var lintResult *ResultSet
+				for i := 0; i < b.N; i++ {
+					lintResult = LintCertificateWithOptions(context.Background(), x509Cert, registry, opts)
+				}
+
+				globalLintResult = lintResult
+			})
+		}
+	}
+}
@@ -0,0 +1,106 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+// Command zlint-server serves an HTTP JSON API for linting certificates and
+// CRLs, so that tools which lint many objects need not start a zlint process
+// for each of them.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/zmap/zlint/v3"
+	"github.com/zmap/zlint/v3/lint"
+
+	_ "github.com/zmap/zlint/v3/profiles"
+)
+
+var ( // flags
+	listenAddr   string
+	config       string
+	profileFiles string
+	lintFiles    string
+	workers      int
+	lintTimeout  time.Duration
+	maxBodyBytes int64
+	printVersion bool
+
+	// version is replaced by GoReleaser or `make` using an LDFlags option at
+	// build time. Here we supply a default value for folks that `go install` or
+	// `go build` directly from src.
+	version = "dev-unknown"
+)
+
+func init() {
+	flag.StringVar(&listenAddr, "listen", "localhost:8080", "The address to serve the HTTP API on")
+	flag.StringVar(&config, "config", "", "A path to a TOML configuration used for requests that do not provide their own")
+	flag.StringVar(&lintFiles, "lintFiles", "", "Comma-separated list of JSON or TOML rule files declaring additional certificate lints")
+	flag.StringVar(&profileFiles, "profileFiles", "", "Comma-separated list of JSON or TOML files declaring additional linting profiles")
+	flag.IntVar(&workers, "workers", 1, "The maximum number of lints to run concurrently against each object")
+	flag.DurationVar(&lintTimeout, "lintTimeout", 0, "The maximum amount of time any single lint may run for before it is abandoned and reported as fatal (e.g. 500ms). Zero means no limit")
+	flag.Int64Var(&maxBodyBytes, "maxBodyBytes", 1<<20, "The maximum size of a request body in bytes")
+	flag.BoolVar(&printVersion, "version", false, "Print ZLint version and exit")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "ZLint version %s\n\n", version)
+		fmt.Fprintf(os.Stderr, "Usage: %s [flags]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+}
+
+func main() {
+	flag.Parse()
+	if printVersion {
+		fmt.Printf("ZLint version %s\n", version)
+		return
+	}
+
+	if err := lint.RegisterRuleLintsFromFiles(strings.Split(lintFiles, ",")); err != nil {
+		log.Fatalf("unable to load lints: %v", err)
+	}
+	for _, path := range strings.Split(profileFiles, ",") {
+		if path = strings.TrimSpace(path); path == "" {
+			continue
+		}
+		profiles, err := lint.NewProfilesFromFile(path)
+		if err != nil {
+			log.Fatalf("unable to load profiles: %v", err)
+		}
+		for _, p := range profiles {
+			lint.RegisterProfile(p)
+		}
+	}
+	configuration, err := lint.NewConfigFromFile(config)
+	if err != nil {
+		log.Fatalf("unable to load configuration: %v", err)
+	}
+	registry := lint.GlobalRegistry()
+	registry.SetConfiguration(configuration)
+
+	handler := newServer(registry, zlint.LintOptions{Workers: workers, Timeout: lintTimeout}, maxBodyBytes)
+	srv := &http.Server{
+		Addr:              listenAddr,
+		Handler:           handler,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	log.Infof("serving the ZLint HTTP API on %s", listenAddr)
+	if err := srv.ListenAndServe(); err != nil {
+		log.Fatal(err)
+	}
+}
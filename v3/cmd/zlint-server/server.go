@@ -0,0 +1,321 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package main
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3"
+	"github.com/zmap/zlint/v3/lint"
+)
+
+// lintRequest is the body of a request to one of the lint endpoints.
+type lintRequest struct {
+	// Input is the object to lint, either PEM encoded or as base64 encoded
+	// DER.
+	Input string `json:"input"`
+	// Issuer is the issuer of the certificate being linted, in the same
+	// encoding as Input. It enables lints that require the issuer and is
+	// ignored when linting CRLs.
+	Issuer string `json:"issuer,omitempty"`
+	// NameFilter, IncludeNames, ExcludeNames, IncludeSources,
+	// ExcludeSources, IncludeTags, ExcludeTags, Filter and Profile filter the
+	// lints that are run, as the command line flags of the same names do.
+	NameFilter     string   `json:"name_filter,omitempty"`
+	IncludeNames   []string `json:"include_names,omitempty"`
+	ExcludeNames   []string `json:"exclude_names,omitempty"`
+	IncludeSources []string `json:"include_sources,omitempty"`
+	ExcludeSources []string `json:"exclude_sources,omitempty"`
+	IncludeTags    []string `json:"include_tags,omitempty"`
+	ExcludeTags    []string `json:"exclude_tags,omitempty"`
+	Filter         string   `json:"filter,omitempty"`
+	Profile        string   `json:"profile,omitempty"`
+	// Config is a TOML configuration for the lints, used in place of the
+	// configuration that the server was started with.
+	Config string `json:"config,omitempty"`
+	// Enriched requests that the response include the metadata of each lint
+	// and the identity of a linted certificate (see zlint.EnrichedResultSet).
+	Enriched bool `json:"enriched,omitempty"`
+}
+
+// errorResponse is the body of a response to a request that failed.
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// configuredRegistry overrides the configuration of a registry for a single
+// request, without modifying the registry that it wraps.
+type configuredRegistry struct {
+	lint.Registry
+	config lint.Configuration
+}
+
+func (r *configuredRegistry) SetConfiguration(config lint.Configuration) {
+	r.config = config
+}
+
+func (r *configuredRegistry) GetConfiguration() lint.Configuration {
+	return r.config
+}
+
+// server serves the HTTP API, linting every request with lints drawn from a
+// single shared registry.
+type server struct {
+	registry lint.Registry
+	opts     zlint.LintOptions
+	// maxBodyBytes limits the size of request bodies.
+	maxBodyBytes int64
+}
+
+// newServer returns an http.Handler serving the HTTP API, which lints with
+// the registry as directed by opts.
+func newServer(registry lint.Registry, opts zlint.LintOptions, maxBodyBytes int64) http.Handler {
+	s := &server{registry: registry, opts: opts, maxBodyBytes: maxBodyBytes}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", s.handleHealth)
+	mux.HandleFunc("/v1/lints", s.handleLints)
+	mux.HandleFunc("/v1/profiles", s.handleProfiles)
+	mux.HandleFunc("/v1/lint/certificate", s.handleLintCertificate)
+	mux.HandleFunc("/v1/lint/crl", s.handleLintRevocationList)
+	return mux
+}
+
+func (s *server) handleHealth(w http.ResponseWriter, r *http.Request) {
+	if !allowMethod(w, r, http.MethodGet) {
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"status":  "ok",
+		"version": version,
+		"lints":   len(s.registry.Names()),
+	})
+}
+
+// handleLints lists the metadata of every lint within the registry.
+func (s *server) handleLints(w http.ResponseWriter, r *http.Request) {
+	if !allowMethod(w, r, http.MethodGet) {
+		return
+	}
+	lints := []interface{}{}
+	for _, l := range s.registry.CertificateLints().Lints() {
+		lints = append(lints, l)
+	}
+	for _, l := range s.registry.RevocationListLints().Lints() {
+		lints = append(lints, l)
+	}
+	for _, l := range s.registry.ChainLints().Lints() {
+		lints = append(lints, l)
+	}
+	for _, l := range s.registry.OCSPResponseLints().Lints() {
+		lints = append(lints, l)
+	}
+	for _, l := range s.registry.CertificateRequestLints().Lints() {
+		lints = append(lints, l)
+	}
+	for _, l := range s.registry.PrecertificatePairLints().Lints() {
+		lints = append(lints, l)
+	}
+	writeJSON(w, http.StatusOK, lints)
+}
+
+func (s *server) handleProfiles(w http.ResponseWriter, r *http.Request) {
+	if !allowMethod(w, r, http.MethodGet) {
+		return
+	}
+	writeJSON(w, http.StatusOK, lint.AllProfiles())
+}
+
+func (s *server) handleLintCertificate(w http.ResponseWriter, r *http.Request) {
+	req, registry, ok := s.readLintRequest(w, r)
+	if !ok {
+		return
+	}
+	c, err := parseCertificate(req.Input)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("unable to parse certificate: %w", err))
+		return
+	}
+	var res *zlint.ResultSet
+	if req.Issuer != "" {
+		issuer, err := parseCertificate(req.Issuer)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("unable to parse issuer: %w", err))
+			return
+		}
+		res = zlint.LintChainWithOptions(r.Context(), c, []*x509.Certificate{issuer}, nil, registry, s.opts)[0]
+	} else {
+		res = zlint.LintCertificateWithOptions(r.Context(), c, registry, s.opts)
+	}
+	if req.Enriched {
+		writeJSON(w, http.StatusOK, res.Enrich(c))
+		return
+	}
+	writeJSON(w, http.StatusOK, res)
+}
+
+func (s *server) handleLintRevocationList(w http.ResponseWriter, r *http.Request) {
+	req, registry, ok := s.readLintRequest(w, r)
+	if !ok {
+		return
+	}
+	der, err := decodeInput(req.Input)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	crl, err := x509.ParseRevocationList(der)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("unable to parse certificate revocation list: %w", err))
+		return
+	}
+	res := zlint.LintRevocationListWithOptions(r.Context(), crl, registry, s.opts)
+	if req.Enriched {
+		writeJSON(w, http.StatusOK, res.Enrich(nil))
+		return
+	}
+	writeJSON(w, http.StatusOK, res)
+}
+
+// readLintRequest decodes the body of a request to one of the lint endpoints
+// and builds the registry that it asks for. If the request is invalid then an
+// error is written to w and ok is false.
+func (s *server) readLintRequest(w http.ResponseWriter, r *http.Request) (req lintRequest, registry lint.Registry, ok bool) {
+	if !allowMethod(w, r, http.MethodPost) {
+		return req, nil, false
+	}
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, s.maxBodyBytes))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("invalid request: %w", err))
+		return req, nil, false
+	}
+	registry, err := s.requestRegistry(req)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return req, nil, false
+	}
+	return req, registry, true
+}
+
+// requestRegistry filters the shared registry as directed by the request and
+// applies its configuration. The shared registry itself is never modified.
+func (s *server) requestRegistry(req lintRequest) (lint.Registry, error) {
+	var opts lint.FilterOptions
+	if req.NameFilter != "" {
+		re, err := regexp.Compile(req.NameFilter)
+		if err != nil {
+			return nil, fmt.Errorf("bad name_filter: %w", err)
+		}
+		opts.NameFilter = re
+	}
+	opts.IncludeNames = req.IncludeNames
+	opts.ExcludeNames = req.ExcludeNames
+	opts.IncludeTags = req.IncludeTags
+	opts.ExcludeTags = req.ExcludeTags
+	if req.Filter != "" {
+		expr, err := lint.ParseFilterExpression(req.Filter)
+		if err != nil {
+			return nil, fmt.Errorf("bad filter: %w", err)
+		}
+		opts.Expression = expr
+	}
+	if err := opts.IncludeSources.FromString(strings.Join(req.IncludeSources, ",")); err != nil {
+		return nil, fmt.Errorf("bad include_sources: %w", err)
+	}
+	if err := opts.ExcludeSources.FromString(strings.Join(req.ExcludeSources, ",")); err != nil {
+		return nil, fmt.Errorf("bad exclude_sources: %w", err)
+	}
+	if req.Profile != "" {
+		p, ok := lint.GetProfile(req.Profile)
+		if !ok {
+			return nil, fmt.Errorf("lint profile name does not exist: %v", req.Profile)
+		}
+		opts.AddProfile(p)
+	}
+	registry, err := s.registry.Filter(opts)
+	if err != nil {
+		return nil, err
+	}
+	if req.Config != "" {
+		config, err := lint.NewConfigFromString(req.Config)
+		if err != nil {
+			return nil, fmt.Errorf("bad config: %w", err)
+		}
+		registry = &configuredRegistry{Registry: registry, config: config}
+	}
+	return registry, nil
+}
+
+// parseCertificate parses a PEM or base64 encoded certificate.
+func parseCertificate(input string) (*x509.Certificate, error) {
+	der, err := decodeInput(input)
+	if err != nil {
+		return nil, err
+	}
+	return x509.ParseCertificate(der)
+}
+
+// decodeInput returns the DER encoding of a PEM or base64 encoded object.
+func decodeInput(input string) ([]byte, error) {
+	if input == "" {
+		return nil, errors.New("no input was provided")
+	}
+	if strings.Contains(input, "-----BEGIN") {
+		p, _ := pem.Decode([]byte(input))
+		if p == nil {
+			return nil, errors.New("unable to parse PEM")
+		}
+		return p.Bytes, nil
+	}
+	der, err := base64.StdEncoding.DecodeString(strings.TrimSpace(input))
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse base64: %w", err)
+	}
+	return der, nil
+}
+
+// allowMethod writes an error to w and returns false unless r uses the given
+// method.
+func allowMethod(w http.ResponseWriter, r *http.Request, method string) bool {
+	if r.Method == method {
+		return true
+	}
+	w.Header().Set("Allow", method)
+	writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s is not allowed", r.Method))
+	return false
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, errorResponse{Error: err.Error()})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		log.Errorf("unable to write response: %v", err)
+	}
+}
@@ -0,0 +1,265 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/zmap/zlint/v3"
+	"github.com/zmap/zlint/v3/lint"
+)
+
+func readTestData(t *testing.T, name string) string {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("..", "..", "testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func TestServer(t *testing.T) {
+	cert := readTestData(t, "rsaFermatFactorizationSusceptible.pem")
+	crl := readTestData(t, "crlHasNextUpdate.pem")
+	const fermat = "e_rsa_fermat_factorization"
+	waiver := `
+[[Waivers]]
+lint = "` + fermat + `"
+severity = "warn"
+justification = "test"
+expires = 2999-01-01T00:00:00Z
+`
+	testCases := []struct {
+		name       string
+		method     string
+		path       string
+		body       interface{}
+		wantStatus int
+		// wantLints, if not nil, are the expected results of the lints named
+		// within it.
+		wantLints map[string]lint.LintStatus
+		// wantCount, if not zero, is the expected number of results.
+		wantCount int
+		wantError string
+	}{
+		{
+			name:       "certificate",
+			method:     http.MethodPost,
+			path:       "/v1/lint/certificate",
+			body:       lintRequest{Input: cert},
+			wantStatus: http.StatusOK,
+			wantLints:  map[string]lint.LintStatus{fermat: lint.Error},
+		},
+		{
+			name:       "certificate filtered by name",
+			method:     http.MethodPost,
+			path:       "/v1/lint/certificate",
+			body:       lintRequest{Input: cert, IncludeNames: []string{fermat}},
+			wantStatus: http.StatusOK,
+			wantLints:  map[string]lint.LintStatus{fermat: lint.Error},
+			wantCount:  1,
+		},
+		{
+			name:       "certificate with configuration",
+			method:     http.MethodPost,
+			path:       "/v1/lint/certificate",
+			body:       lintRequest{Input: cert, IncludeNames: []string{fermat}, Config: waiver},
+			wantStatus: http.StatusOK,
+			wantLints:  map[string]lint.LintStatus{fermat: lint.Warn},
+		},
+		{
+			name:       "certificate excluding the source",
+			method:     http.MethodPost,
+			path:       "/v1/lint/certificate",
+			body:       lintRequest{Input: cert, ExcludeSources: []string{string(lint.Community)}},
+			wantStatus: http.StatusOK,
+			wantLints:  map[string]lint.LintStatus{},
+		},
+		{
+			name:       "certificate filtered by expression",
+			method:     http.MethodPost,
+			path:       "/v1/lint/certificate",
+			body:       lintRequest{Input: cert, IncludeTags: []string{"key"}, Filter: "name =~ fermat && source == Community"},
+			wantStatus: http.StatusOK,
+			wantLints:  map[string]lint.LintStatus{fermat: lint.Error},
+			wantCount:  1,
+		},
+		{
+			name:       "CRL",
+			method:     http.MethodPost,
+			path:       "/v1/lint/crl",
+			body:       lintRequest{Input: crl},
+			wantStatus: http.StatusOK,
+			wantLints:  map[string]lint.LintStatus{"e_crl_has_next_update": lint.Pass},
+		},
+		{
+			name:       "unknown lint",
+			method:     http.MethodPost,
+			path:       "/v1/lint/certificate",
+			body:       lintRequest{Input: cert, IncludeNames: []string{"e_does_not_exist"}},
+			wantStatus: http.StatusBadRequest,
+			wantError:  "e_does_not_exist",
+		},
+		{
+			name:       "unknown profile",
+			method:     http.MethodPost,
+			path:       "/v1/lint/certificate",
+			body:       lintRequest{Input: cert, Profile: "does_not_exist"},
+			wantStatus: http.StatusBadRequest,
+			wantError:  "does_not_exist",
+		},
+		{
+			name:       "bad filter expression",
+			method:     http.MethodPost,
+			path:       "/v1/lint/certificate",
+			body:       lintRequest{Input: cert, Filter: "tag = key"},
+			wantStatus: http.StatusBadRequest,
+			wantError:  "bad filter",
+		},
+		{
+			name:       "bad configuration",
+			method:     http.MethodPost,
+			path:       "/v1/lint/certificate",
+			body:       lintRequest{Input: cert, Config: "[[Waivers]]\nlint = 1"},
+			wantStatus: http.StatusBadRequest,
+			wantError:  "bad config",
+		},
+		{
+			name:       "unknown field",
+			method:     http.MethodPost,
+			path:       "/v1/lint/certificate",
+			body:       map[string]string{"certificate": cert},
+			wantStatus: http.StatusBadRequest,
+			wantError:  "unknown field",
+		},
+		{
+			name:       "CRL given as a certificate",
+			method:     http.MethodPost,
+			path:       "/v1/lint/certificate",
+			body:       lintRequest{Input: crl},
+			wantStatus: http.StatusBadRequest,
+			wantError:  "unable to parse certificate",
+		},
+		{
+			name:       "wrong method",
+			method:     http.MethodGet,
+			path:       "/v1/lint/certificate",
+			wantStatus: http.StatusMethodNotAllowed,
+			wantError:  "GET",
+		},
+	}
+	srv := httptest.NewServer(newServer(lint.GlobalRegistry(), zlint.LintOptions{}, 1<<20))
+	defer srv.Close()
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var body bytes.Buffer
+			if tc.body != nil {
+				if err := json.NewEncoder(&body).Encode(tc.body); err != nil {
+					t.Fatal(err)
+				}
+			}
+			req, err := http.NewRequest(tc.method, srv.URL+tc.path, &body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+			if resp.StatusCode != tc.wantStatus {
+				t.Fatalf("expected status %d, got %d", tc.wantStatus, resp.StatusCode)
+			}
+			if tc.wantError != "" {
+				var got errorResponse
+				if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+					t.Fatal(err)
+				}
+				if !strings.Contains(got.Error, tc.wantError) {
+					t.Errorf("expected an error containing %q, got %q", tc.wantError, got.Error)
+				}
+				return
+			}
+			var got zlint.ResultSet
+			if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+				t.Fatal(err)
+			}
+			if tc.wantCount != 0 && len(got.Results) != tc.wantCount {
+				t.Errorf("expected %d results, got %d", tc.wantCount, len(got.Results))
+			}
+			for name, want := range tc.wantLints {
+				if res, ok := got.Results[name]; !ok || res.Status != want {
+					t.Errorf("expected %s to be %s, got %v", name, want, got.Results[name])
+				}
+			}
+			if tc.wantLints != nil && len(tc.wantLints) == 0 {
+				if _, ok := got.Results[fermat]; ok {
+					t.Errorf("expected %s to be excluded", fermat)
+				}
+			}
+		})
+	}
+	if waivers := lint.GlobalRegistry().GetConfiguration().Waivers(); len(waivers) != 0 {
+		t.Errorf("expected the configuration of a request not to modify the shared registry, got %v", waivers)
+	}
+}
+
+func TestServerListings(t *testing.T) {
+	srv := httptest.NewServer(newServer(lint.GlobalRegistry(), zlint.LintOptions{}, 1<<20))
+	defer srv.Close()
+	get := func(path string, v interface{}) {
+		t.Helper()
+		resp, err := http.Get(srv.URL + path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("expected %s to succeed, got status %d", path, resp.StatusCode)
+		}
+		if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var health struct {
+		Status string `json:"status"`
+		Lints  int    `json:"lints"`
+	}
+	get("/healthz", &health)
+	if health.Status != "ok" || health.Lints != len(lint.GlobalRegistry().Names()) {
+		t.Errorf("expected a healthy server with every lint, got %+v", health)
+	}
+
+	var lints []lint.LintMetadata
+	get("/v1/lints", &lints)
+	if len(lints) != len(lint.GlobalRegistry().Names()) {
+		t.Errorf("expected %d lints, got %d", len(lint.GlobalRegistry().Names()), len(lints))
+	}
+
+	var profiles []lint.Profile
+	get("/v1/profiles", &profiles)
+	if len(profiles) != len(lint.AllProfiles()) {
+		t.Errorf("expected %d profiles, got %d", len(lint.AllProfiles()), len(profiles))
+	}
+}
@@ -0,0 +1,170 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/zmap/zcrypto/cryptobyte"
+	"github.com/zmap/zcrypto/encoding/asn1"
+	"github.com/zmap/zlint/v3"
+	"github.com/zmap/zlint/v3/util"
+)
+
+// universalTagNames names the universal ASN.1 types as openssl asn1parse does.
+var universalTagNames = map[uint8]string{
+	1:  "BOOLEAN",
+	2:  "INTEGER",
+	3:  "BIT STRING",
+	4:  "OCTET STRING",
+	5:  "NULL",
+	6:  "OBJECT",
+	10: "ENUMERATED",
+	12: "UTF8STRING",
+	16: "SEQUENCE",
+	17: "SET",
+	19: "PRINTABLESTRING",
+	20: "T61STRING",
+	22: "IA5STRING",
+	23: "UTCTIME",
+	24: "GENERALIZEDTIME",
+	26: "VISIBLESTRING",
+	28: "UNIVERSALSTRING",
+	30: "BMPSTRING",
+}
+
+// writeASN1Dump writes a dump of the DER encoding der in the style of openssl
+// asn1parse, giving the offset, depth, header length and length of each
+// element followed by its ASN.1 path. Each element located by a finding among
+// the results is marked, and followed by the name of the lint and the
+// finding. Certificates are indexed with util.NewCertificateDERIndex such that
+// their paths name the fields of the certificate.
+func writeASN1Dump(w io.Writer, der []byte, results *zlint.ResultSet) error {
+	idx, err := util.NewCertificateDERIndex(der)
+	if err != nil {
+		if idx, err = util.NewDERIndex(der); err != nil {
+			return err
+		}
+	}
+	type mark struct {
+		lint    string
+		finding string
+	}
+	marks := map[util.DERLocation][]mark{}
+	if results != nil {
+		for name, res := range results.Results {
+			for _, f := range res.Findings {
+				if f.Location != nil {
+					marks[*f.Location] = append(marks[*f.Location], mark{name, fmt.Sprintf("%s: %s", f.Status, f)})
+				}
+			}
+		}
+	}
+	var dump func(n *util.DERNode, depth int) error
+	dump = func(n *util.DERNode, depth int) error {
+		nodeMarks := marks[n.DERLocation]
+		prefix := " "
+		if len(nodeMarks) > 0 {
+			prefix = "*"
+		}
+		form := "prim"
+		if n.Tag == n.Tag.Constructed() {
+			form = "cons"
+		}
+		line := fmt.Sprintf("%s%5d:d=%-2d hl=%d l=%4d %s: %-18s", prefix, n.Start, depth, n.HeaderLength, n.End-n.Start-n.HeaderLength, form, tagName(uint8(n.Tag)))
+		if form == "prim" {
+			if value := primitiveValue(n, idx.Bytes(n)); value != "" {
+				line += ":" + value
+			}
+		}
+		if n.ASN1Path != "" {
+			line += "  " + n.ASN1Path
+		}
+		if _, err := fmt.Fprintln(w, strings.TrimRight(line, " ")); err != nil {
+			return err
+		}
+		sort.Slice(nodeMarks, func(i, j int) bool { return nodeMarks[i].lint < nodeMarks[j].lint })
+		for _, m := range nodeMarks {
+			if _, err := fmt.Fprintf(w, "       ^ %s %s\n", m.lint, m.finding); err != nil {
+				return err
+			}
+		}
+		for _, child := range n.Children {
+			if err := dump(child, depth+1); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return dump(idx.Root, 0)
+}
+
+// tagName names the tag as openssl asn1parse does, e.g. "SEQUENCE" or
+// "cont [ 3 ]".
+func tagName(tag uint8) string {
+	number := tag & 0x1f
+	switch tag & 0xc0 {
+	case 0x40:
+		return fmt.Sprintf("appl [ %d ]", number)
+	case 0x80:
+		return fmt.Sprintf("cont [ %d ]", number)
+	case 0xc0:
+		return fmt.Sprintf("priv [ %d ]", number)
+	}
+	if name, ok := universalTagNames[number]; ok {
+		return name
+	}
+	return fmt.Sprintf("[ %d ]", number)
+}
+
+// primitiveValue describes the contents of the primitive element n, encoded
+// as element, if it is of a type whose value is worth showing.
+func primitiveValue(n *util.DERNode, element []byte) string {
+	contents := element[n.HeaderLength:]
+	switch uint8(n.Tag) {
+	case 1, 2, 10:
+		return fmt.Sprintf("%X", contents)
+	case 6:
+		s := cryptobyte.String(element)
+		var oid asn1.ObjectIdentifier
+		if s.ReadASN1ObjectIdentifier(&oid) {
+			return oid.String()
+		}
+		return ""
+	case 12, 19, 20, 22, 23, 24, 26:
+		return printable(contents)
+	}
+	// Context specific primitives, such as the dNSName of a GeneralName,
+	// are shown if they are text.
+	if n.Tag&0xc0 == 0x80 && printable(contents) == string(contents) {
+		return string(contents)
+	}
+	return ""
+}
+
+// printable replaces each byte of s that is not printable ASCII with a '.'.
+func printable(s []byte) string {
+	out := make([]byte, len(s))
+	for i, c := range s {
+		if c < 0x20 || c > 0x7e {
+			c = '.'
+		}
+		out[i] = c
+	}
+	return string(out)
+}
@@ -0,0 +1,60 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/zmap/zlint/v3"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+)
+
+func TestWriteASN1Dump(t *testing.T) {
+	der := newTestCertificate(t, "example.com", nil, false).cert.Raw
+	idx, err := util.NewCertificateDERIndex(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	results := &zlint.ResultSet{Results: map[string]*lint.LintResult{
+		"e_some_lint": lint.NewResultFromFindings([]lint.Finding{{
+			Path:     "subject[0][0].value",
+			Value:    "example.com",
+			Status:   lint.Error,
+			Location: idx.Locate("tbsCertificate.subject[0][0].value"),
+		}}),
+	}}
+	var buf bytes.Buffer
+	if err := writeASN1Dump(&buf, der, results); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(buf.String(), "\n")
+	if !strings.HasPrefix(lines[0], "     0:d=0 ") || !strings.Contains(lines[0], "cons: SEQUENCE") {
+		t.Errorf("expected the certificate SEQUENCE at offset 0, got %q", lines[0])
+	}
+	var marked []string
+	for i, line := range lines {
+		if strings.HasPrefix(line, "*") {
+			marked = append(marked, line, lines[i+1])
+		}
+	}
+	if len(marked) != 2 ||
+		!strings.Contains(marked[0], ":example.com  tbsCertificate.subject[0][0].value") ||
+		!strings.Contains(marked[1], `^ e_some_lint error: subject[0][0].value "example.com"`) {
+		t.Errorf("expected only the subject common name to be marked, got %q", marked)
+	}
+}
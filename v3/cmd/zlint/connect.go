@@ -0,0 +1,261 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	ztls "github.com/zmap/zcrypto/tls"
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3"
+	"github.com/zmap/zlint/v3/lint"
+)
+
+// presentedChain holds what a TLS server presented during a handshake.
+type presentedChain struct {
+	// addr is the address that was connected to.
+	addr string
+	// serverName is the name that was sent using SNI, if any.
+	serverName string
+	// certificates are the DER encoded certificates in the order served.
+	certificates [][]byte
+	// ocspResponse is the stapled OCSP response, if any.
+	ocspResponse []byte
+	// scts are the signed certificate timestamps delivered using the TLS
+	// extension, if any.
+	scts [][]byte
+}
+
+// fetchPresentedChain performs a TLS handshake with the server at addr and
+// returns what it presented. The server name sent using SNI defaults to the
+// host of addr, unless addr is an IP address. The presented chain is not
+// verified, since it is to be linted.
+//
+// crypto/tls abandons the handshake if any certificate fails to parse, even
+// without verification. In that case the handshake is repeated with
+// zcrypto/tls, which records the certificates as served before abandoning it,
+// though no stapled OCSP response is received and only TLS 1.2 and below are
+// supported.
+func fetchPresentedChain(ctx context.Context, addr string, serverName string, timeout time.Duration) (*presentedChain, error) {
+	if serverName == "" {
+		host, _, err := net.SplitHostPort(addr)
+		if err != nil {
+			return nil, err
+		}
+		if net.ParseIP(host) == nil {
+			serverName = host
+		}
+	}
+	dialer := &tls.Dialer{
+		NetDialer: &net.Dialer{Timeout: timeout},
+		Config: &tls.Config{
+			ServerName: serverName,
+			//nolint:gosec // The chain is linted rather than trusted.
+			InsecureSkipVerify: true,
+		},
+	}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil && strings.Contains(err.Error(), "failed to parse certificate") {
+		return fetchUnparsableChain(ctx, addr, serverName, timeout)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	state := conn.(*tls.Conn).ConnectionState()
+	chain := &presentedChain{
+		addr:         addr,
+		serverName:   serverName,
+		ocspResponse: state.OCSPResponse,
+		scts:         state.SignedCertificateTimestamps,
+	}
+	for _, c := range state.PeerCertificates {
+		chain.certificates = append(chain.certificates, c.Raw)
+	}
+	return chain, nil
+}
+
+// fetchUnparsableChain performs a TLS handshake with the server at addr using
+// zcrypto/tls, abandoning it once the certificates have been received, and
+// returns the certificates and SCTs that the server presented.
+func fetchUnparsableChain(ctx context.Context, addr string, serverName string, timeout time.Duration) (*presentedChain, error) {
+	netConn, err := (&net.Dialer{Timeout: timeout}).DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	defer netConn.Close()
+	deadline, ok := ctx.Deadline()
+	if timeout > 0 && (!ok || time.Now().Add(timeout).Before(deadline)) {
+		deadline, ok = time.Now().Add(timeout), true
+	}
+	if ok {
+		_ = netConn.SetDeadline(deadline)
+	}
+	conn := ztls.Client(netConn, &ztls.Config{
+		ServerName:                    serverName,
+		InsecureSkipVerify:            true,
+		CertsOnly:                     true,
+		SignedCertificateTimestampExt: true,
+	})
+	if err := conn.Handshake(); !errors.Is(err, ztls.ErrCertsOnly) {
+		if err == nil {
+			err = errors.New("the server presented no certificates")
+		}
+		return nil, err
+	}
+	handshake := conn.GetHandshakeLog()
+	chain := &presentedChain{addr: addr, serverName: serverName}
+	if handshake.ServerCertificates != nil {
+		chain.certificates = append(chain.certificates, handshake.ServerCertificates.Certificate.Raw)
+		for _, c := range handshake.ServerCertificates.Chain {
+			chain.certificates = append(chain.certificates, c.Raw)
+		}
+	}
+	if handshake.ServerHello != nil {
+		for _, sct := range handshake.ServerHello.SignedCertificateTimestamps {
+			chain.scts = append(chain.scts, sct.Raw)
+		}
+	}
+	return chain, nil
+}
+
+// connectReport describes a TLS handshake made for the -connect flag. It is
+// printed after the results of linting the objects that the server presented.
+type connectReport struct {
+	Connect      string `json:"connect"`
+	ServerName   string `json:"server_name,omitempty"`
+	Certificates int    `json:"certificates"`
+	OCSPStapled  bool   `json:"ocsp_stapled"`
+	// SCTs are the signed certificate timestamps delivered using the TLS
+	// extension, base64 encoded.
+	SCTs [][]byte `json:"scts,omitempty"`
+	// ChainOrderProblems describe where the certificates were not served in
+	// the order of the chain, from the leaf towards the root.
+	ChainOrderProblems []string `json:"chain_order_problems"`
+}
+
+// lintPresentedChain lints each of the objects within the presented chain,
+// calling fn with each object and its results. Each certificate is linted
+// along with the chain that follows it, such that the certificate served
+// after it is treated as its issuer. If the last certificate is self-signed it
+// is treated as the root. If any certificate fails to parse the served order
+// can not be relied upon, so the others are linted on their own. A stapled
+// OCSP response follows the certificates and is linted with the issuer of the
+// leaf.
+func lintPresentedChain(ctx context.Context, chain *presentedChain, registry lint.Registry, opts zlint.LintOptions, fn func(inputObject, *x509.Certificate, *zlint.ResultSet)) connectReport {
+	report := connectReport{
+		Connect:            chain.addr,
+		ServerName:         chain.serverName,
+		Certificates:       len(chain.certificates),
+		OCSPStapled:        len(chain.ocspResponse) > 0,
+		SCTs:               chain.scts,
+		ChainOrderProblems: []string{},
+	}
+	certs := make([]*x509.Certificate, len(chain.certificates))
+	objs := make([]inputObject, len(chain.certificates))
+	unparsed := []int{}
+	for i, der := range chain.certificates {
+		objs[i] = inputObject{file: chain.addr, index: i, pemType: "CERTIFICATE", der: der}
+		c, err := x509.ParseCertificate(der)
+		if err != nil {
+			objs[i].err = fmt.Errorf("unable to parse certificate: %w", err)
+			unparsed = append(unparsed, i)
+			continue
+		}
+		certs[i] = c
+	}
+	switch {
+	case len(certs) == 0:
+	case len(unparsed) > 0:
+		for i, c := range certs {
+			if c == nil {
+				fn(objs[i], nil, nil)
+				continue
+			}
+			fn(objs[i], c, zlint.LintCertificateWithOptions(ctx, c, registry, opts))
+		}
+		for _, i := range unparsed {
+			report.ChainOrderProblems = append(report.ChainOrderProblems, fmt.Sprintf("certificate %d could not be parsed, so the chain was not linted", i))
+		}
+	default:
+		intermediates, root := certs[1:], (*x509.Certificate)(nil)
+		if last := certs[len(certs)-1]; len(certs) > 1 && bytes.Equal(last.RawIssuer, last.RawSubject) {
+			intermediates, root = certs[1:len(certs)-1], last
+		}
+		for i, res := range zlint.LintChainWithOptions(ctx, certs[0], intermediates, root, registry, opts) {
+			fn(objs[i], certs[i], res)
+		}
+		report.ChainOrderProblems = chainOrderProblems(certs)
+	}
+	if report.OCSPStapled {
+		obj := inputObject{file: chain.addr, index: len(chain.certificates), pemType: "OCSP RESPONSE", der: chain.ocspResponse}
+		var issuer *issuerChain
+		if len(certs) > 1 && certs[0] != nil && certs[1] != nil {
+			issuer = &issuerChain{intermediates: certs[1:2]}
+		}
+		_, res, err := lintObject(obj, registry, issuer, nil)
+		obj.err = err
+		fn(obj, nil, res)
+	}
+	return report
+}
+
+// chainOrderProblems describes where the certificates, in the order served,
+// do not form a chain from the leaf towards the root. Certificates are
+// numbered from zero in the order served.
+func chainOrderProblems(certs []*x509.Certificate) []string {
+	problems := []string{}
+	issuedBy := func(c, issuer *x509.Certificate) bool {
+		return bytes.Equal(c.RawIssuer, issuer.RawSubject) && c.CheckSignatureFrom(issuer) == nil
+	}
+	for i, c := range certs {
+		for j := 0; j < i; j++ {
+			if bytes.Equal(c.Raw, certs[j].Raw) {
+				problems = append(problems, fmt.Sprintf("certificate %d is a duplicate of certificate %d", i, j))
+				break
+			}
+		}
+		if i+1 == len(certs) {
+			break
+		}
+		if bytes.Equal(c.RawIssuer, c.RawSubject) {
+			problems = append(problems, fmt.Sprintf("certificate %d is self-signed but is followed by further certificates", i))
+			continue
+		}
+		if issuedBy(c, certs[i+1]) {
+			continue
+		}
+		issuer := -1
+		for j, candidate := range certs {
+			if j != i && issuedBy(c, candidate) {
+				issuer = j
+				break
+			}
+		}
+		if issuer >= 0 {
+			problems = append(problems, fmt.Sprintf("certificate %d is issued by certificate %d rather than the certificate that follows it", i, issuer))
+		} else {
+			problems = append(problems, fmt.Sprintf("certificate %d is not issued by the certificate that follows it", i))
+		}
+	}
+	return problems
+}
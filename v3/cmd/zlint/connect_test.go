@@ -0,0 +1,330 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	stdx509 "crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zcrypto/x509/revocation/ocsp"
+	"github.com/zmap/zlint/v3"
+	"github.com/zmap/zlint/v3/lint"
+)
+
+// testIssuer is a certificate along with its private key.
+type testIssuer struct {
+	cert *stdx509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+// newTestCertificate creates a certificate for cn, issued by issuer or
+// self-signed if issuer is nil.
+func newTestCertificate(t *testing.T, cn string, issuer *testIssuer, isCA bool) *testIssuer {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &stdx509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: cn},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		BasicConstraintsValid: true,
+		IsCA:                  isCA,
+		KeyUsage:              stdx509.KeyUsageDigitalSignature,
+	}
+	if isCA {
+		template.KeyUsage |= stdx509.KeyUsageCertSign
+	} else {
+		template.DNSNames = []string{cn}
+		template.ExtKeyUsage = []stdx509.ExtKeyUsage{stdx509.ExtKeyUsageServerAuth}
+	}
+	parent, signer := template, key
+	if issuer != nil {
+		parent, signer = issuer.cert, issuer.key
+	}
+	der, err := stdx509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, signer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := stdx509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testIssuer{cert: cert, key: key}
+}
+
+func TestLintPresentedChain(t *testing.T) {
+	root := newTestCertificate(t, "Test Root", nil, true)
+	intermediate := newTestCertificate(t, "Test Intermediate", root, true)
+	leaf := newTestCertificate(t, "example.com", intermediate, false)
+
+	testCases := []struct {
+		name         string
+		served       []*testIssuer
+		staple       []byte
+		scts         [][]byte
+		wantProblems []string
+	}{
+		{
+			name:         "in order",
+			served:       []*testIssuer{leaf, intermediate},
+			scts:         [][]byte{{1, 2, 3}},
+			wantProblems: []string{},
+		},
+		{
+			name:         "in order with root",
+			served:       []*testIssuer{leaf, intermediate, root},
+			wantProblems: []string{},
+		},
+		{
+			name:   "out of order",
+			served: []*testIssuer{leaf, root, intermediate},
+			wantProblems: []string{
+				"certificate 0 is issued by certificate 2 rather than the certificate that follows it",
+				"certificate 1 is self-signed but is followed by further certificates",
+			},
+		},
+		{
+			name:   "duplicate and missing issuer",
+			served: []*testIssuer{leaf, leaf},
+			wantProblems: []string{
+				"certificate 0 is not issued by the certificate that follows it",
+				"certificate 1 is a duplicate of certificate 0",
+			},
+		},
+		{
+			name:         "stapled OCSP response",
+			served:       []*testIssuer{leaf},
+			staple:       []byte{0x30, 0x00},
+			wantProblems: []string{},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			tlsCert := tls.Certificate{
+				PrivateKey:                  leaf.key,
+				OCSPStaple:                  tc.staple,
+				SignedCertificateTimestamps: tc.scts,
+			}
+			for _, c := range tc.served {
+				tlsCert.Certificate = append(tlsCert.Certificate, c.cert.Raw)
+			}
+			srv := httptest.NewUnstartedServer(http.NotFoundHandler())
+			srv.TLS = &tls.Config{Certificates: []tls.Certificate{tlsCert}}
+			srv.StartTLS()
+			defer srv.Close()
+
+			chain, err := fetchPresentedChain(context.Background(), srv.Listener.Addr().String(), "example.com", 5*time.Second)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if chain.serverName != "example.com" || !reflect.DeepEqual(chain.scts, tc.scts) {
+				t.Errorf("expected SNI of example.com and SCTs %v, got %q and %v", tc.scts, chain.serverName, chain.scts)
+			}
+
+			var objs []inputObject
+			var certs []*x509.Certificate
+			report := lintPresentedChain(context.Background(), chain, lint.GlobalRegistry(), zlint.LintOptions{}, func(obj inputObject, c *x509.Certificate, res *zlint.ResultSet) {
+				if obj.err == nil && res == nil {
+					t.Errorf("expected results for %s", obj.name())
+				}
+				objs = append(objs, obj)
+				certs = append(certs, c)
+			})
+			if !reflect.DeepEqual(report.ChainOrderProblems, tc.wantProblems) {
+				t.Errorf("expected chain order problems %q, got %q", tc.wantProblems, report.ChainOrderProblems)
+			}
+			wantObjs := len(tc.served)
+			if tc.staple != nil {
+				wantObjs++
+			}
+			if len(objs) != wantObjs || report.Certificates != len(tc.served) || report.OCSPStapled != (tc.staple != nil) {
+				t.Fatalf("expected %d objects, got %d with report %+v", wantObjs, len(objs), report)
+			}
+			for i, c := range tc.served {
+				if objs[i].index != i || certs[i] == nil || certs[i].Subject.CommonName != c.cert.Subject.CommonName {
+					t.Errorf("expected object %d to be %s, got %+v", i, c.cert.Subject.CommonName, objs[i])
+				}
+			}
+			if tc.staple != nil {
+				if ocsp := objs[len(objs)-1]; ocsp.pemType != "OCSP RESPONSE" || ocsp.err == nil {
+					t.Errorf("expected the malformed stapled OCSP response to fail to parse, got %+v", ocsp)
+				}
+			}
+		})
+	}
+}
+
+func TestFetchPresentedChainMalformedLeaf(t *testing.T) {
+	root := newTestCertificate(t, "Test Root", nil, true)
+	intermediate := newTestCertificate(t, "Test Intermediate", root, true)
+	leaf := newTestCertificate(t, "example.com", intermediate, false)
+	malformed := []byte{0x30, 0x03, 0x02, 0x01, 0x01}
+
+	// httptest parses the served leaf, so the malformed one is served by a
+	// plain TLS listener instead.
+	ln, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{Certificates: []tls.Certificate{{
+		Certificate: [][]byte{malformed, intermediate.cert.Raw},
+		PrivateKey:  leaf.key,
+	}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			_ = conn.(*tls.Conn).Handshake()
+			conn.Close()
+		}
+	}()
+
+	chain, err := fetchPresentedChain(context.Background(), ln.Addr().String(), "example.com", 5*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(chain.certificates) != 2 || !bytes.Equal(chain.certificates[0], malformed) || !bytes.Equal(chain.certificates[1], intermediate.cert.Raw) {
+		t.Fatalf("expected the malformed leaf followed by the intermediate, got %d certificates", len(chain.certificates))
+	}
+
+	var objs []inputObject
+	report := lintPresentedChain(context.Background(), chain, lint.GlobalRegistry(), zlint.LintOptions{}, func(obj inputObject, _ *x509.Certificate, _ *zlint.ResultSet) {
+		objs = append(objs, obj)
+	})
+	want := []string{"certificate 0 could not be parsed, so the chain was not linted"}
+	if !reflect.DeepEqual(report.ChainOrderProblems, want) {
+		t.Errorf("expected chain order problems %q, got %q", want, report.ChainOrderProblems)
+	}
+	if len(objs) != 2 || objs[0].err == nil || objs[1].err != nil {
+		t.Errorf("expected only the leaf to fail to parse, got %+v", objs)
+	}
+}
+
+func TestLintPresentedChainUnparsedCertificate(t *testing.T) {
+	root := newTestCertificate(t, "Test Root", nil, true)
+	intermediate := newTestCertificate(t, "Test Intermediate", root, true)
+	chain := &presentedChain{
+		addr:         "example.com:443",
+		certificates: [][]byte{{0x30, 0x00}, intermediate.cert.Raw, root.cert.Raw},
+	}
+
+	var objs []inputObject
+	var certs []*x509.Certificate
+	report := lintPresentedChain(context.Background(), chain, lint.GlobalRegistry(), zlint.LintOptions{}, func(obj inputObject, c *x509.Certificate, res *zlint.ResultSet) {
+		objs = append(objs, obj)
+		certs = append(certs, c)
+	})
+	want := []string{"certificate 0 could not be parsed, so the chain was not linted"}
+	if !reflect.DeepEqual(report.ChainOrderProblems, want) {
+		t.Errorf("expected chain order problems %q, got %q", want, report.ChainOrderProblems)
+	}
+	if len(objs) != 3 {
+		t.Fatalf("expected 3 objects, got %d", len(objs))
+	}
+	if objs[0].err == nil || certs[0] != nil {
+		t.Errorf("expected certificate 0 to fail to parse, got %+v", objs[0])
+	}
+	for i, cn := range []string{"Test Intermediate", "Test Root"} {
+		if obj, c := objs[i+1], certs[i+1]; obj.index != i+1 || c == nil || c.Subject.CommonName != cn {
+			t.Errorf("expected object %d to be %s, got %+v", i+1, cn, obj)
+		}
+	}
+}
+
+func TestLintPresentedChainStapledOCSPResponse(t *testing.T) {
+	root := newTestCertificate(t, "Test Root", nil, true)
+	intermediate := newTestCertificate(t, "Test Intermediate", root, true)
+	leaf := newTestCertificate(t, "example.com", intermediate, false)
+
+	testCases := []struct {
+		name   string
+		signer *testIssuer
+		want   lint.LintStatus
+	}{
+		{
+			name:   "signed by the issuer",
+			signer: intermediate,
+			want:   lint.Pass,
+		},
+		{
+			name:   "signed by an unknown responder",
+			signer: root,
+			want:   lint.NA,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			signer, err := x509.ParseCertificate(tc.signer.cert.Raw)
+			if err != nil {
+				t.Fatal(err)
+			}
+			staple, err := ocsp.CreateResponse(signer, signer, ocsp.Response{
+				Status:       ocsp.Good,
+				SerialNumber: leaf.cert.SerialNumber,
+				ThisUpdate:   time.Now().Add(-time.Hour),
+				NextUpdate:   time.Now().Add(24 * time.Hour),
+			}, tc.signer.key)
+			if err != nil {
+				t.Fatal(err)
+			}
+			chain := &presentedChain{
+				addr:         "example.com:443",
+				certificates: [][]byte{leaf.cert.Raw, intermediate.cert.Raw},
+				ocspResponse: staple,
+			}
+
+			var res *zlint.ResultSet
+			lintPresentedChain(context.Background(), chain, lint.GlobalRegistry(), zlint.LintOptions{}, func(obj inputObject, _ *x509.Certificate, r *zlint.ResultSet) {
+				if obj.pemType != "OCSP RESPONSE" {
+					return
+				}
+				if obj.err != nil {
+					t.Fatal(obj.err)
+				}
+				res = r
+			})
+			if res == nil {
+				t.Fatal("expected results for the stapled OCSP response")
+			}
+			if got := res.Results["e_ocsp_responder_id_mismatch_signer"].Status; got != tc.want {
+				t.Errorf("expected e_ocsp_responder_id_mismatch_signer to be %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
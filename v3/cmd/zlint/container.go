@@ -0,0 +1,206 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"crypto"
+	stdx509 "crypto/x509"
+	stdasn1 "encoding/asn1"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/cryptobyte"
+	cbasn1 "golang.org/x/crypto/cryptobyte/asn1"
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+// oidSignedData identifies the SignedData content type of PKCS#7 (RFC 2315).
+var oidSignedData = stdasn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 2}
+
+// readPKCS7Objects calls fn for every certificate and CRL within a PKCS#7
+// SignedData structure, such as a .p7b or .p7c bundle. The structure may be
+// DER or PEM encoded. Objects are indexed in the order that they appear,
+// certificates first.
+func readPKCS7Objects(data []byte, file string, fn func(inputObject)) {
+	if bytes.Contains(data, pemBegin) {
+		p, _ := pem.Decode(data)
+		if p == nil {
+			fn(inputObject{file: file, err: errors.New("unable to parse PEM")})
+			return
+		}
+		data = p.Bytes
+	}
+	certs, crls, err := parsePKCS7(data)
+	if err != nil {
+		fn(inputObject{file: file, err: err})
+		return
+	}
+	if len(certs) == 0 && len(crls) == 0 {
+		fn(inputObject{file: file, err: errors.New("no certificates or CRLs found in PKCS#7 structure")})
+		return
+	}
+	index := 0
+	for _, der := range certs {
+		fn(inputObject{file: file, index: index, pemType: "CERTIFICATE", der: der})
+		index++
+	}
+	for _, der := range crls {
+		fn(inputObject{file: file, index: index, pemType: "X509 CRL", der: der})
+		index++
+	}
+}
+
+// parsePKCS7 returns the DER encoded certificates and CRLs within a DER
+// encoded PKCS#7 ContentInfo holding SignedData. Entries of the certificates
+// and crls fields that are not X.509 certificates or CRLs, such as attribute
+// certificates, are ignored.
+func parsePKCS7(der []byte) (certs [][]byte, crls [][]byte, err error) {
+	input := cryptobyte.String(der)
+	var contentInfo, content, signedData cryptobyte.String
+	var contentType stdasn1.ObjectIdentifier
+	if !input.ReadASN1(&contentInfo, cbasn1.SEQUENCE) ||
+		!contentInfo.ReadASN1ObjectIdentifier(&contentType) {
+		return nil, nil, errors.New("unable to parse PKCS#7 structure")
+	}
+	if !contentType.Equal(oidSignedData) {
+		return nil, nil, fmt.Errorf("unsupported PKCS#7 content type %s", contentType)
+	}
+	if !contentInfo.ReadASN1(&content, cbasn1.Tag(0).Constructed().ContextSpecific()) ||
+		!content.ReadASN1(&signedData, cbasn1.SEQUENCE) ||
+		!signedData.SkipASN1(cbasn1.INTEGER) ||
+		!signedData.SkipASN1(cbasn1.SET) ||
+		!signedData.SkipASN1(cbasn1.SEQUENCE) {
+		return nil, nil, errors.New("unable to parse PKCS#7 SignedData")
+	}
+	readSet := func(tag cbasn1.Tag) ([][]byte, error) {
+		var set cryptobyte.String
+		var present bool
+		if !signedData.ReadOptionalASN1(&set, &present, tag) {
+			return nil, errors.New("unable to parse PKCS#7 SignedData")
+		}
+		var elements [][]byte
+		for !set.Empty() {
+			var element cryptobyte.String
+			var elementTag cbasn1.Tag
+			if !set.ReadAnyASN1Element(&element, &elementTag) {
+				return nil, errors.New("unable to parse PKCS#7 SignedData")
+			}
+			if elementTag == cbasn1.SEQUENCE {
+				elements = append(elements, element)
+			}
+		}
+		return elements, nil
+	}
+	if certs, err = readSet(cbasn1.Tag(0).Constructed().ContextSpecific()); err != nil {
+		return nil, nil, err
+	}
+	if crls, err = readSet(cbasn1.Tag(1).Constructed().ContextSpecific()); err != nil {
+		return nil, nil, err
+	}
+	return certs, crls, nil
+}
+
+// readPKCS12Objects calls fn for every certificate within a PKCS#12 archive,
+// such as a .pfx or .p12 file, decrypted with password. If checkKeys is set
+// then each private key within the archive is checked against the public key
+// of its certificate, and a key that does not match is passed to fn as an
+// object with an error. Certificates are indexed in the order that they
+// appear, followed by any such keys.
+func readPKCS12Objects(data []byte, file string, password string, checkKeys bool, fn func(inputObject)) {
+	// ToPEM is deprecated for labelling PKCS#1 and SEC 1 keys as "PRIVATE
+	// KEY", which parsePKCS12PrivateKey expects, but unlike the alternatives
+	// it returns the certificates without parsing them.
+	blocks, err := pkcs12.ToPEM(data, password) //nolint:staticcheck
+	if err != nil {
+		fn(inputObject{file: file, err: fmt.Errorf("unable to parse PKCS#12 archive: %w", err)})
+		return
+	}
+	index := 0
+	for _, block := range blocks {
+		if block.Type == "CERTIFICATE" {
+			fn(inputObject{file: file, index: index, pemType: block.Type, der: block.Bytes})
+			index++
+		}
+	}
+	if index == 0 {
+		fn(inputObject{file: file, err: errors.New("no certificates found in PKCS#12 archive")})
+	}
+	if !checkKeys {
+		return
+	}
+	for _, block := range blocks {
+		if block.Type != "PRIVATE KEY" {
+			continue
+		}
+		if err := checkPrivateKey(block, blocks); err != nil {
+			fn(inputObject{file: file, index: index, pemType: block.Type, err: err})
+			index++
+		}
+	}
+}
+
+// checkPrivateKey checks that the private key within key, as decoded by
+// pkcs12.ToPEM, matches the public key of its certificate among blocks. The
+// certificate of a key is the one sharing its localKeyId attribute or, if
+// there is none, any certificate in the archive.
+func checkPrivateKey(key *pem.Block, blocks []*pem.Block) error {
+	priv, err := parsePKCS12PrivateKey(key.Bytes)
+	if err != nil {
+		return err
+	}
+	spki, err := stdx509.MarshalPKIXPublicKey(priv.Public())
+	if err != nil {
+		return fmt.Errorf("unable to encode the public key of the private key: %w", err)
+	}
+	keyID := key.Headers["localKeyId"]
+	var candidates []*stdx509.Certificate
+	for _, block := range blocks {
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		c, err := stdx509.ParseCertificate(block.Bytes)
+		if err != nil {
+			continue
+		}
+		if keyID != "" && block.Headers["localKeyId"] == keyID {
+			candidates = []*stdx509.Certificate{c}
+			break
+		}
+		candidates = append(candidates, c)
+	}
+	for _, c := range candidates {
+		if bytes.Equal(c.RawSubjectPublicKeyInfo, spki) {
+			return nil
+		}
+	}
+	if len(candidates) == 1 {
+		return fmt.Errorf("private key does not match the public key of the certificate %q", candidates[0].Subject)
+	}
+	return errors.New("private key does not match the public key of any certificate in the PKCS#12 archive")
+}
+
+// parsePKCS12PrivateKey parses a private key as encoded by pkcs12.ToPEM,
+// which is PKCS#1 for RSA keys and SEC 1 for ECDSA keys.
+func parsePKCS12PrivateKey(der []byte) (crypto.Signer, error) {
+	if key, err := stdx509.ParsePKCS1PrivateKey(der); err == nil {
+		return key, nil
+	}
+	if key, err := stdx509.ParseECPrivateKey(der); err == nil {
+		return key, nil
+	}
+	return nil, errors.New("unable to parse the private key in the PKCS#12 archive")
+}
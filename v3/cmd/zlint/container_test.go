@@ -0,0 +1,302 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"crypto/rand"
+	stdx509 "crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/cryptobyte"
+	cbasn1 "golang.org/x/crypto/cryptobyte/asn1"
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+// testPFX is a PKCS#12 archive holding a self-signed certificate for
+// example.com and its P-256 private key, encrypted with the password "zlint"
+// using 3DES. It was created with:
+//
+//	openssl pkcs12 -export -inkey key.pem -in cert.pem -passout pass:zlint \
+//	  -keypbe PBE-SHA1-3DES -certpbe PBE-SHA1-3DES -macalg sha1
+const testPFX = `MIIDmgIBAzCCA2AGCSqGSIb3DQEHAaCCA1EEggNNMIIDSTCCAj8GCSqGSIb3DQEHBqCCAjAwggIs
+AgEAMIICJQYJKoZIhvcNAQcBMBwGCiqGSIb3DQEMAQMwDgQI4wpYkA1NRQwCAggAgIIB+J5i7Q+u
+qS8QY/wt0tTMOERUCrBg0vl9KHLdzr52Otal7UfmYz8xJKIBpqXFDP1OSF0dtvFVI1HRWoJJExa1
+Kz6gChSg/utACxwPh6rIVzsX8Iz92LqiK4zUlBJYt0p3dtF9fbHpaxnVoL27+DHJx4zkpRLWYYLP
+3GsTBLLvTmOREPvBSsZKPrb9Qen0mWF2hxzJfkkfSu0lUjknZmyxhcYIhoc0hYusfObEcTrMHRhd
+0l591lk4uYk8l8a0jxUYldb/OqiJkHM1aToExT875dbwNDPxuEpNeEvHg30UmOyTywtUZbrLc0+/
+LFqSg2leni36/5kX+RS0xXkpTpPGk2muqzIZFaJEg9L87/4V5tbUzZ0nGk1L+fPvaQ/dsvYsFonH
+wbfrqbP/G3YHO9kLKP2OVgx3mOknJLwCnr6IMrcXLe4esqGb688GFFBBOszjtCMwJfax6bxhJ/Hk
+NopuOSbniOSoOQi64ikMAdBACKy39lOg/QQuSk7rlN0++WwX2NSShrFWJqvX9qSbf0tPPztfKon7
+TqhmosWfqPXLmWjFRMk7AhRCQHQYrM5+xYAoSSPjULOkUxgAywVUSbPPBt57cIcKNY/R5i0CXY5+
+Iobkx75s1JmgKW+lkj5+aIhlN2bmi7c7+yorZhR2jotm524+vzwHEgC+8zCCAQIGCSqGSIb3DQEH
+AaCB9ASB8TCB7jCB6wYLKoZIhvcNAQwKAQKggbQwgbEwHAYKKoZIhvcNAQwBAzAOBAgmSaiTjHYr
+CgICCAAEgZDqjmSRP4FaeErWXLhsWlRzIEbDWENftxcviwOvRJaUIRgphjx4yr7XfaPTfqjIe6iI
+OxI0MBtS1ZgB6cTBR49hvSMJX4cLxVZqrid+x9Qzbechmcs4j0IaGPBrzkWnS8U4Iki5xEolFVyq
+e6+dBFTncs0ehPkkFO4buuoqIeWGk3TVWARTpA0oSK/EICPt/v4xJTAjBgkqhkiG9w0BCRUxFgQU
+uPvIac2HpHjO0k/k1zguobA2TA8wMTAhMAkGBSsOAwIaBQAEFImZipJbqLc7g84uaZ7zn2fhSIWW
+BAilqhu+TVzhWwICCAA=`
+
+// pkcs7Bundle encodes the certificates and CRLs as a degenerate PKCS#7
+// SignedData structure, as produced by openssl crl2pkcs7.
+func pkcs7Bundle(certs [][]byte, crls [][]byte) []byte {
+	var b cryptobyte.Builder
+	b.AddASN1(cbasn1.SEQUENCE, func(b *cryptobyte.Builder) {
+		b.AddASN1ObjectIdentifier(oidSignedData)
+		b.AddASN1(cbasn1.Tag(0).Constructed().ContextSpecific(), func(b *cryptobyte.Builder) {
+			b.AddASN1(cbasn1.SEQUENCE, func(b *cryptobyte.Builder) {
+				b.AddASN1Int64(1)
+				b.AddASN1(cbasn1.SET, func(b *cryptobyte.Builder) {})
+				b.AddASN1(cbasn1.SEQUENCE, func(b *cryptobyte.Builder) {
+					b.AddASN1ObjectIdentifier([]int{1, 2, 840, 113549, 1, 7, 1})
+				})
+				for i, set := range [][][]byte{certs, crls} {
+					if len(set) == 0 {
+						continue
+					}
+					set := set
+					b.AddASN1(cbasn1.Tag(i).Constructed().ContextSpecific(), func(b *cryptobyte.Builder) {
+						for _, der := range set {
+							b.AddBytes(der)
+						}
+					})
+				}
+				b.AddASN1(cbasn1.SET, func(b *cryptobyte.Builder) {})
+			})
+		})
+	})
+	return b.BytesOrPanic()
+}
+
+func TestReadPKCS7Objects(t *testing.T) {
+	root := newTestCertificate(t, "Root", nil, true)
+	leaf := newTestCertificate(t, "example.com", root, false)
+	crlIssuer := *root.cert
+	crlIssuer.KeyUsage |= stdx509.KeyUsageCRLSign
+	crl, err := stdx509.CreateRevocationList(rand.Reader, &stdx509.RevocationList{
+		Number:     big.NewInt(1),
+		ThisUpdate: time.Now(),
+		NextUpdate: time.Now().Add(time.Hour),
+	}, &crlIssuer, root.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bundle := pkcs7Bundle([][]byte{leaf.cert.Raw, root.cert.Raw}, [][]byte{crl})
+
+	testCases := []struct {
+		name    string
+		data    []byte
+		want    []string
+		wantErr string
+	}{
+		{
+			name: "DER",
+			data: bundle,
+			want: []string{"CERTIFICATE", "CERTIFICATE", "X509 CRL"},
+		},
+		{
+			name: "PEM",
+			data: pem.EncodeToMemory(&pem.Block{Type: "PKCS7", Bytes: bundle}),
+			want: []string{"CERTIFICATE", "CERTIFICATE", "X509 CRL"},
+		},
+		{
+			name: "certificates only",
+			data: pkcs7Bundle([][]byte{leaf.cert.Raw}, nil),
+			want: []string{"CERTIFICATE"},
+		},
+		{
+			name:    "empty",
+			data:    pkcs7Bundle(nil, nil),
+			wantErr: "no certificates or CRLs",
+		},
+		{
+			name:    "not PKCS#7",
+			data:    leaf.cert.Raw,
+			wantErr: "unable to parse PKCS#7 structure",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var got []string
+			readObjects(bytes.NewReader(tc.data), "bundle.p7b", "pkcs7", func(obj inputObject) {
+				if obj.err != nil {
+					if tc.wantErr == "" || !strings.Contains(obj.err.Error(), tc.wantErr) {
+						t.Errorf("expected an error containing %q, got %v", tc.wantErr, obj.err)
+					}
+					return
+				}
+				if obj.index != len(got) {
+					t.Errorf("expected object %d to have index %d, got %d", len(got), len(got), obj.index)
+				}
+				if _, _, err := lintObject(obj, nil, nil, nil); err != nil {
+					t.Errorf("unable to lint object %d: %v", obj.index, err)
+				}
+				got = append(got, obj.pemType)
+			})
+			if strings.Join(got, ",") != strings.Join(tc.want, ",") {
+				t.Errorf("expected objects %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestReadPKCS12Objects(t *testing.T) {
+	legacy, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(testPFX, "\n", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// modern is a PKCS#12 archive using PBES2 with AES-256, as written by
+	// default since OpenSSL 3.0, holding a certificate and its issuer along
+	// with a key that matches neither.
+	root := newTestCertificate(t, "Test Root", nil, true)
+	leaf := newTestCertificate(t, "example.com", root, false)
+	other := newTestCertificate(t, "other.example.com", nil, false)
+	modern, err := pkcs12.Modern2023.Encode(other.key, leaf.cert, []*stdx509.Certificate{root.cert}, "zlint")
+	if err != nil {
+		t.Fatal(err)
+	}
+	testCases := []struct {
+		name      string
+		pfx       []byte
+		password  string
+		checkKeys bool
+		wantCerts []int
+		wantErr   string
+		wantErrAt int
+	}{
+		{
+			name:      "certificates",
+			pfx:       legacy,
+			password:  "zlint",
+			wantCerts: []int{0},
+		},
+		{
+			name:      "matching key",
+			pfx:       legacy,
+			password:  "zlint",
+			checkKeys: true,
+			wantCerts: []int{0},
+		},
+		{
+			name:     "incorrect password",
+			pfx:      legacy,
+			password: "wrong",
+			wantErr:  "password incorrect",
+		},
+		{
+			name:      "AES-256",
+			pfx:       modern,
+			password:  "zlint",
+			wantCerts: []int{0, 1},
+		},
+		{
+			name:      "mismatched key",
+			pfx:       modern,
+			password:  "zlint",
+			checkKeys: true,
+			wantCerts: []int{0, 1},
+			wantErr:   "does not match",
+			wantErrAt: 2,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var certs []int
+			readPKCS12Objects(tc.pfx, "test.pfx", tc.password, tc.checkKeys, func(obj inputObject) {
+				switch {
+				case obj.err != nil:
+					if tc.wantErr == "" || !strings.Contains(obj.err.Error(), tc.wantErr) || obj.index != tc.wantErrAt {
+						t.Errorf("expected an error containing %q at index %d, got %v at index %d", tc.wantErr, tc.wantErrAt, obj.err, obj.index)
+					}
+				case obj.pemType == "CERTIFICATE":
+					certs = append(certs, obj.index)
+				}
+			})
+			if !reflect.DeepEqual(certs, tc.wantCerts) {
+				t.Errorf("expected certificates at indices %v, got %v", tc.wantCerts, certs)
+			}
+		})
+	}
+}
+
+func TestCheckPrivateKey(t *testing.T) {
+	a := newTestCertificate(t, "a.example.com", nil, false)
+	b := newTestCertificate(t, "b.example.com", nil, false)
+	keyOf := func(issuer *testIssuer, keyID string) *pem.Block {
+		der, err := stdx509.MarshalECPrivateKey(issuer.key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &pem.Block{Type: "PRIVATE KEY", Headers: map[string]string{"localKeyId": keyID}, Bytes: der}
+	}
+	certOf := func(issuer *testIssuer, keyID string) *pem.Block {
+		return &pem.Block{Type: "CERTIFICATE", Headers: map[string]string{"localKeyId": keyID}, Bytes: issuer.cert.Raw}
+	}
+	testCases := []struct {
+		name    string
+		key     *pem.Block
+		certs   []*pem.Block
+		wantErr string
+	}{
+		{
+			name:  "matching localKeyId",
+			key:   keyOf(a, "01"),
+			certs: []*pem.Block{certOf(b, "02"), certOf(a, "01")},
+		},
+		{
+			name:    "mismatched localKeyId",
+			key:     keyOf(a, "01"),
+			certs:   []*pem.Block{certOf(a, "02"), certOf(b, "01")},
+			wantErr: `certificate "CN=b.example.com"`,
+		},
+		{
+			name:  "no localKeyId",
+			key:   keyOf(b, ""),
+			certs: []*pem.Block{certOf(a, ""), certOf(b, "")},
+		},
+		{
+			name:    "no matching certificate",
+			key:     keyOf(b, ""),
+			certs:   []*pem.Block{certOf(a, ""), certOf(a, "")},
+			wantErr: "any certificate",
+		},
+		{
+			name:    "malformed key",
+			key:     &pem.Block{Type: "PRIVATE KEY", Bytes: []byte{1, 2, 3}},
+			certs:   []*pem.Block{certOf(a, "")},
+			wantErr: "unable to parse the private key",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkPrivateKey(tc.key, append(tc.certs, tc.key))
+			switch {
+			case tc.wantErr == "" && err != nil:
+				t.Errorf("unexpected error: %v", err)
+			case tc.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tc.wantErr)):
+				t.Errorf("expected an error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
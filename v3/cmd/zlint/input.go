@@ -0,0 +1,236 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3/ct"
+)
+
+// An inputObject is a single object read from an input, or the error that
+// was encountered while reading it.
+type inputObject struct {
+	// file names the input that the object was read from.
+	file string
+	// index is the position of the object within the input, counting from
+	// zero. Objects read from DER or base64 inputs always have an index of 0,
+	// whereas the index of a CT log entry is its index within the log.
+	index int
+	// pemType is the type of the PEM block that the object was read from, or
+	// the type detected from its DER encoding.
+	pemType string
+	der     []byte
+	// issuer is the issuer of a certificate read from a CT log entry, if the
+	// entry included its chain.
+	issuer *x509.Certificate
+	// rebuiltPrecert is set for a precertificate rebuilt from a CT log entry,
+	// whose signature never verifies (see ct.RebuildPrecertificate).
+	rebuiltPrecert bool
+	err            error
+}
+
+// name identifies the object for a reader. The first object of an input is
+// named after the input alone, whereas later objects are suffixed by their
+// index.
+func (o inputObject) name() string {
+	if o.index == 0 {
+		return o.file
+	}
+	return fmt.Sprintf("%s#%d", o.file, o.index)
+}
+
+// walkInputs calls fn for every object within the inputs at paths. A path of
+// "-" reads from stdin, and directories are walked recursively in lexical
+// order. Errors encountered while opening or reading an input are passed to fn
+// as an object with that error.
+func walkInputs(paths []string, inform string, fn func(inputObject)) {
+	for _, path := range paths {
+		if path == "-" {
+			readObjects(os.Stdin, "-", inform, fn)
+			continue
+		}
+		err := filepath.WalkDir(path, func(file string, d fs.DirEntry, err error) error {
+			if err != nil {
+				fn(inputObject{file: file, err: err})
+				return nil
+			}
+			if d.IsDir() || !d.Type().IsRegular() {
+				return nil
+			}
+			readFile(file, inform, fn)
+			return nil
+		})
+		if err != nil {
+			fn(inputObject{file: path, err: err})
+		}
+	}
+}
+
+// readFile calls fn for every object within the file at path. Files with a
+// ".der", ".pem", ".p7b", ".p7c", ".pfx" or ".p12" extension are read in the
+// format that it implies regardless of inform.
+func readFile(path string, inform string, fn func(inputObject)) {
+	f, err := os.Open(path)
+	if err != nil {
+		fn(inputObject{file: path, err: err})
+		return
+	}
+	defer f.Close()
+	switch {
+	case strings.HasSuffix(path, ".der"):
+		inform = "der"
+	case strings.HasSuffix(path, ".pem"):
+		inform = "pem"
+	case strings.HasSuffix(path, ".p7b"), strings.HasSuffix(path, ".p7c"):
+		inform = "pkcs7"
+	case strings.HasSuffix(path, ".pfx"), strings.HasSuffix(path, ".p12"):
+		inform = "pkcs12"
+	}
+	readObjects(f, path, inform, fn)
+}
+
+// readObjects calls fn for every object read from r in the given format. PEM
+// input is read as a stream, such that each block is passed to fn as soon as
+// it has been read, and text outside of the blocks is ignored. DER and base64
+// input hold a single object. PKCS#7 and PKCS#12 input hold the certificates
+// and CRLs within the container. CT input is a get-entries response, whose
+// entries are numbered from the -ctStartIndex flag.
+func readObjects(r io.Reader, file string, inform string, fn func(inputObject)) {
+	switch inform {
+	case "pem":
+		readPEMObjects(r, file, fn)
+	case "der", "base64":
+		data, err := io.ReadAll(r)
+		if err != nil {
+			fn(inputObject{file: file, err: err})
+			return
+		}
+		if inform == "base64" {
+			data, err = base64.StdEncoding.DecodeString(string(bytes.TrimSpace(data)))
+			if err != nil {
+				fn(inputObject{file: file, err: fmt.Errorf("unable to parse base64: %w", err)})
+				return
+			}
+		}
+		fn(inputObject{file: file, pemType: detectDERType(data), der: data})
+	case "pkcs7", "pkcs12":
+		data, err := io.ReadAll(r)
+		if err != nil {
+			fn(inputObject{file: file, err: err})
+			return
+		}
+		if inform == "pkcs7" {
+			readPKCS7Objects(data, file, fn)
+		} else {
+			readPKCS12Objects(data, file, pkcs12Password, checkKeys, fn)
+		}
+	case "ct":
+		err := ct.DecodeEntries(r, ctStartIndex, func(index int64, e *ct.Entry, err error) {
+			if err != nil {
+				fn(inputObject{file: file, index: int(index), err: err})
+				return
+			}
+			fn(inputObject{
+				file:           file,
+				index:          int(index),
+				pemType:        "CERTIFICATE",
+				der:            e.Certificate.Raw,
+				issuer:         e.Issuer(),
+				rebuiltPrecert: e.Type == ct.PrecertEntry,
+			})
+		})
+		if err != nil {
+			fn(inputObject{file: file, err: err})
+		}
+	default:
+		fn(inputObject{file: file, err: fmt.Errorf("unknown input format %s", inform)})
+	}
+}
+
+var (
+	pemBegin = []byte("-----BEGIN ")
+	pemEnd   = []byte("-----END ")
+	pemDash  = []byte("-----")
+)
+
+// readPEMObjects calls fn for every PEM block read from r. Blocks need not
+// begin or end on a line of their own, so that files which were concatenated
+// without trailing newlines are read correctly.
+func readPEMObjects(r io.Reader, file string, fn func(inputObject)) {
+	br := bufio.NewReader(r)
+	var block []byte
+	index := 0
+	for {
+		line, err := br.ReadBytes('\n')
+		for len(line) > 0 {
+			if block == nil {
+				i := bytes.Index(line, pemBegin)
+				if i < 0 {
+					break
+				}
+				block, line = []byte{}, line[i:]
+			}
+			i := bytes.Index(line, pemEnd)
+			if i < 0 {
+				block = append(block, line...)
+				break
+			}
+			j := bytes.Index(line[i+len(pemEnd):], pemDash)
+			if j < 0 {
+				block = append(block, line...)
+				break
+			}
+			n := i + len(pemEnd) + j + len(pemDash)
+			block = append(append(block, line[:n]...), '\n')
+			fn(decodePEMObject(file, index, block))
+			index++
+			block, line = nil, line[n:]
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			fn(inputObject{file: file, index: index, err: err})
+			return
+		}
+	}
+	switch {
+	case block != nil:
+		fn(inputObject{file: file, index: index, err: errors.New("unable to parse PEM: unterminated block")})
+	case index == 0:
+		fn(inputObject{file: file, err: errors.New("unable to parse PEM: no PEM blocks found")})
+	}
+}
+
+// decodePEMObject decodes a single PEM block read from file.
+func decodePEMObject(file string, index int, block []byte) inputObject {
+	p, _ := pem.Decode(block)
+	if p == nil {
+		return inputObject{file: file, index: index, err: errors.New("unable to parse PEM")}
+	}
+	return inputObject{file: file, index: index, pemType: p.Type, der: p.Bytes}
+}
@@ -16,19 +16,21 @@ package main
 
 import (
 	"bytes"
-	"encoding/base64"
+	"context"
 	"encoding/json"
 	"encoding/pem"
+	"errors"
 	"flag"
 	"fmt"
-	"io"
 	"os"
 	"regexp"
 	"sort"
 	"strings"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zcrypto/x509/revocation/ocsp"
 	"github.com/zmap/zlint/v3"
 	"github.com/zmap/zlint/v3/formattedoutput"
 	"github.com/zmap/zlint/v3/lint"
@@ -43,16 +45,40 @@ var ( // flags
 	summary         bool
 	longSummary     bool
 	prettyprint     bool
+	enriched        bool
+	jsonLines       bool
+	asn1Dump        bool
+	failOn          lint.LintStatus
+	connectAddr     string
+	serverName      string
 	format          string
+	ctStartIndex    int64
+	pkcs12Password  string
+	checkKeys       bool
 	nameFilter      string
 	includeNames    string
 	excludeNames    string
 	includeSources  string
 	excludeSources  string
+	includeTags     string
+	excludeTags     string
+	filterExpr      string
 	profile         string
+	profileFiles    string
+	lintFiles       string
 	printVersion    bool
 	config          string
 	exampleConfig   bool
+	issuerPath      string
+	chainPath       string
+	precertPath     string
+	workers         int
+	lintTimeout     time.Duration
+	asOf            time.Time
+	preview         bool
+	baselinePath    string
+	outputFormat    string
+	writeBaseline   string
 
 	// version is replaced by GoReleaser or `make` using an LDFlags option at
 	// build time. Here we supply a default value for folks that `go install` or
@@ -66,33 +92,67 @@ func init() {
 	flag.BoolVar(&listProfiles, "list-profiles", false, "Print profiles in JSON format, one per line")
 	flag.BoolVar(&summary, "summary", false, "Prints a short human-readable summary report")
 	flag.BoolVar(&longSummary, "longSummary", false, "Prints a human-readable summary report with details")
-	flag.StringVar(&format, "format", "pem", "One of {pem, der, base64}")
+	flag.StringVar(&format, "format", "pem", "One of {pem, der, base64, pkcs7, pkcs12, ct}. The pkcs7 and pkcs12 formats lint every certificate and CRL within the container. The ct format reads the JSON response of the get-entries endpoint of a Certificate Transparency log")
+	flag.StringVar(&pkcs12Password, "pkcs12Password", "", "The password of PKCS#12 input. Defaults to the value of the ZLINT_PKCS12_PASSWORD environment variable, which avoids exposing the password in the process list")
+	flag.BoolVar(&checkKeys, "checkKeys", false, "Check that each private key within PKCS#12 input matches the public key of its certificate, and report an error for each that does not")
+	flag.Int64Var(&ctStartIndex, "ctStartIndex", 0, "The index within the log of the first entry of the CT get-entries response given with -format ct")
 	flag.StringVar(&nameFilter, "nameFilter", "", "Only run lints with a name matching the provided regex. (Can not be used with -includeNames/-excludeNames)")
 	flag.StringVar(&includeNames, "includeNames", "", "Comma-separated list of lints to include by name")
 	flag.StringVar(&excludeNames, "excludeNames", "", "Comma-separated list of lints to exclude by name")
 	flag.StringVar(&includeSources, "includeSources", "", "Comma-separated list of lint sources to include")
 	flag.StringVar(&excludeSources, "excludeSources", "", "Comma-separated list of lint sources to exclude")
+	flag.StringVar(&includeTags, "includeTags", "", "Comma-separated list of tags of which lints must have at least one to be included")
+	flag.StringVar(&excludeTags, "excludeTags", "", "Comma-separated list of tags of which lints must have none to be included")
+	flag.StringVar(&filterExpr, "filter", "", "Only run lints satisfying the provided expression over their name, source, tags, type and effective dates, e.g. 'tag == dns && source != Community'")
 	flag.StringVar(&profile, "profile", "", "Name of the linting profile to use. Equivalent to enumerating all of the lints in a given profile using includeNames")
+	flag.StringVar(&lintFiles, "lintFiles", "", "Comma-separated list of JSON or TOML rule files declaring additional certificate lints as expressions over the certificate")
+	flag.StringVar(&profileFiles, "profileFiles", "", "Comma-separated list of JSON or TOML files declaring additional linting profiles for use with -profile and -list-profiles")
 	flag.BoolVar(&printVersion, "version", false, "Print ZLint version and exit")
 	flag.StringVar(&config, "config", "", "A path to valid a TOML file that is to service as the configuration for a single run of ZLint")
+	flag.StringVar(&issuerPath, "issuer", "", "A path to the PEM or DER encoded certificate of the issuer of the certificate(s) being linted. Enables lints that require the issuer (Can not be used with -chain)")
+	flag.StringVar(&chainPath, "chain", "", "A path to a PEM bundle of the certificates which complete the chain of the certificate(s) being linted, starting with the issuer. If the last certificate is self-signed it is treated as the root (Can not be used with -issuer)")
+	flag.StringVar(&precertPath, "precert", "", "A path to the PEM or DER encoded precertificate of the certificate(s) being linted, optionally followed by the certificate that signed it. Only the lints comparing the precertificate with its final certificate are run (Can not be used with -issuer or -chain)")
+	flag.IntVar(&workers, "workers", 1, "The maximum number of lints to run concurrently against each certificate")
+	flag.DurationVar(&lintTimeout, "lintTimeout", 0, "The maximum amount of time any single lint may run for before it is abandoned and reported as fatal (e.g. 500ms). Zero means no limit")
+	flag.Func("asOf", "Evaluate the effective dates of lints against the provided date (YYYY-MM-DD or RFC 3339) instead of the date of the input being linted", parseAsOf)
+	flag.BoolVar(&preview, "preview", false, "Run lints that are not yet effective and report their failures as upcoming instead of NE")
+	flag.StringVar(&baselinePath, "baseline", "", "A path to a baseline previously written with -writeBaseline. Instead of the results, the new, fixed and unchanged findings compared to the baseline are printed, and the exit status is 1 only if there are new findings (Can not be used with -fail-on)")
+	flag.StringVar(&writeBaseline, "writeBaseline", "", "A path to write a baseline of the findings of this run to, for use with -baseline")
 	flag.BoolVar(&exampleConfig, "exampleConfig", false, "Print a complete example of a configuration that is usable via the '-config' flag and exit. All values listed in this example will be set to their default.")
 
+	flag.StringVar(&outputFormat, "output-format", "json", fmt.Sprintf("The format in which to write results. One of {json, %s}. Formats other than json describe all of the inputs in a single document", strings.Join(formattedoutput.FormatterNames(), ", ")))
 	flag.BoolVar(&prettyprint, "pretty", false, "Pretty-print JSON output")
+	flag.BoolVar(&enriched, "enriched", false, "Include the metadata of each lint and the identity of the linted certificate in JSON output")
+	flag.BoolVar(&jsonLines, "jsonl", false, "Print one line of JSON for each object read, naming the file and the index of the object within it, and recording the error if the object could not be linted")
+	flag.BoolVar(&asn1Dump, "asn1dump", false, "Instead of JSON, print a dump of the ASN.1 structure of each object in the style of openssl asn1parse, marking the elements located by findings")
+	flag.StringVar(&connectAddr, "connect", "", "Lint the certificates and stapled OCSP response presented by the TLS server at the provided host:port, instead of reading inputs")
+	flag.StringVar(&serverName, "sni", "", "The server name to send using SNI with -connect. Defaults to the host given to -connect")
+	flag.Func("fail-on", "Exit with status 1 if any result is at least as severe as the provided severity (one of info, warn, error or fatal)", parseFailOn)
 	flag.Usage = func() {
 		fmt.Fprintf(os.Stderr, "ZLint version %s\n\n", version)
 		fmt.Fprintf(os.Stderr, "Usage: %s [flags] file...\n", os.Args[0])
 		flag.PrintDefaults()
 	}
-	flag.Parse()
 	log.SetLevel(log.InfoLevel)
 }
 
 //nolint:cyclop
 func main() {
+	flag.Parse()
 	if printVersion {
 		fmt.Printf("ZLint version %s\n", version)
 		return
 	}
+	if pkcs12Password == "" {
+		pkcs12Password = os.Getenv("ZLINT_PKCS12_PASSWORD")
+	}
+
+	if err := lint.RegisterRuleLintsFromFiles(strings.Split(lintFiles, ",")); err != nil {
+		log.Fatalf("unable to load lints: %v", err)
+	}
+	if err := loadProfiles(); err != nil {
+		log.Fatalf("unable to load profiles: %v", err)
+	}
 
 	// Build a registry of lints using the include/exclude lint name and source
 	// flags.
@@ -136,79 +196,424 @@ func main() {
 		return
 	}
 
-	var inform = strings.ToLower(format)
-	if flag.NArg() < 1 || flag.Arg(0) == "-" {
-		doLint(os.Stdin, inform, registry)
-	} else {
-		for _, filePath := range flag.Args() {
-			var inputFile *os.File
-			var err error
-			inputFile, err = os.Open(filePath)
-			if err != nil {
-				log.Fatalf("unable to open file %s: %s", filePath, err)
+	chain, err := loadChain()
+	if err != nil {
+		log.Fatalf("unable to load issuer chain: %v", err)
+	}
+	precert, err := loadPrecert()
+	if err != nil {
+		log.Fatalf("unable to load precertificate: %v", err)
+	}
+
+	if connectAddr != "" && (flag.NArg() > 0 || chain != nil || precert != nil) {
+		log.Fatal("-connect can not be used with input files, -issuer, -chain or -precert")
+	}
+
+	var formatter formattedoutput.Formatter
+	if outputFormat != "json" {
+		var ok bool
+		formatter, ok = formattedoutput.GetFormatter(outputFormat)
+		if !ok {
+			log.Fatalf("unknown output format %q", outputFormat)
+		}
+		if baselinePath != "" {
+			log.Fatal("-output-format can not be used with -baseline")
+		}
+	}
+	if failOn != lint.Reserved && baselinePath != "" {
+		log.Fatal("-fail-on can not be used with -baseline, whose exit status depends only upon new findings")
+	}
+	if (enriched || jsonLines || asn1Dump) && (formatter != nil || baselinePath != "") {
+		log.Fatal("-enriched, -jsonl and -asn1dump can only be used with JSON output")
+	}
+
+	var previous *zlint.Baseline
+	if baselinePath != "" {
+		previous, err = readBaseline(baselinePath)
+		if err != nil {
+			log.Fatalf("unable to load baseline: %v", err)
+		}
+	}
+	current := zlint.NewBaseline()
+	var reports []formattedoutput.Report
+	failed := false
+	record := func(obj inputObject, cert *x509.Certificate, zlintResult *zlint.ResultSet) {
+		if obj.err != nil {
+			// With -baseline the exit status reflects new findings alone.
+			if previous == nil {
+				failed = true
 			}
-			var fileInform = inform
-			switch {
-			case strings.HasSuffix(filePath, ".der"):
-				fileInform = "der"
-			case strings.HasSuffix(filePath, ".pem"):
-				fileInform = "pem"
+			if jsonLines && formatter == nil && previous == nil {
+				outputJSONLine(obj, nil, nil)
+			} else {
+				log.Errorf("unable to lint %s: %v", obj.name(), obj.err)
+			}
+			return
+		}
+		current.Add(zlint.Fingerprint(obj.der), zlintResult)
+		if failOn != lint.Reserved && exceedsThreshold(zlintResult, failOn) {
+			failed = true
+		}
+		switch {
+		case formatter != nil:
+			reports = append(reports, formattedoutput.Report{Input: obj.name(), Results: zlintResult})
+		case previous != nil:
+		case asn1Dump:
+			outputASN1Dump(obj, zlintResult)
+		case jsonLines:
+			outputJSONLine(obj, cert, zlintResult)
+		default:
+			outputResults(cert, zlintResult)
+		}
+	}
+
+	if connectAddr != "" {
+		chain, err := fetchPresentedChain(context.Background(), connectAddr, serverName, 30*time.Second)
+		if err != nil {
+			log.Fatalf("unable to connect to %s: %v", connectAddr, err)
+		}
+		report := lintPresentedChain(context.Background(), chain, registry, lintOptions(), record)
+		if len(report.ChainOrderProblems) > 0 && failOn != lint.Reserved && failOn <= lint.Error {
+			failed = true
+		}
+		outputConnectReport(report, formatter == nil && previous == nil)
+	} else {
+		paths := flag.Args()
+		if len(paths) == 0 {
+			paths = []string{"-"}
+		}
+		walkInputs(paths, strings.ToLower(format), func(obj inputObject) {
+			var cert *x509.Certificate
+			var zlintResult *zlint.ResultSet
+			if obj.err == nil {
+				objChain := chain
+				if objChain == nil && obj.issuer != nil {
+					objChain = &issuerChain{intermediates: []*x509.Certificate{obj.issuer}}
+				}
+				cert, zlintResult, obj.err = lintObject(obj, registry, objChain, precert)
 			}
+			record(obj, cert, zlintResult)
+		})
+	}
 
-			doLint(inputFile, fileInform, registry)
-			inputFile.Close()
+	if formatter != nil {
+		if err := formatter.Format(os.Stdout, reports); err != nil {
+			log.Fatalf("unable to write %s output: %v", outputFormat, err)
 		}
 	}
+	if writeBaseline != "" {
+		if err := saveBaseline(writeBaseline, current); err != nil {
+			log.Fatalf("unable to write baseline: %v", err)
+		}
+	}
+	if previous != nil {
+		cmp := previous.Compare(current)
+		outputComparison(cmp)
+		if len(cmp.New) > 0 {
+			failed = true
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
 }
 
-//nolint:cyclop
-func doLint(inputFile *os.File, inform string, registry lint.Registry) {
-	fileBytes, err := io.ReadAll(inputFile)
+// readBaseline reads the baseline referenced by the -baseline flag.
+func readBaseline(path string) (*zlint.Baseline, error) {
+	f, err := os.Open(path)
 	if err != nil {
-		log.Fatalf("unable to read file %s: %s", inputFile.Name(), err)
+		return nil, err
+	}
+	defer f.Close()
+	return zlint.ReadBaseline(f)
+}
+
+// saveBaseline writes the baseline to the path given by the -writeBaseline
+// flag.
+func saveBaseline(path string, baseline *zlint.Baseline) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := baseline.Write(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// issuerChain holds the certificates provided with the -issuer or -chain flags.
+type issuerChain struct {
+	intermediates []*x509.Certificate
+	root          *x509.Certificate
+}
+
+// issuer returns the certificate of the issuer, or nil if there is none.
+func (c *issuerChain) issuer() *x509.Certificate {
+	switch {
+	case c == nil:
+		return nil
+	case len(c.intermediates) > 0:
+		return c.intermediates[0]
+	default:
+		return c.root
 	}
+}
 
-	var asn1Data []byte
-	var isCRL bool
-	switch inform {
-	case "pem":
-		p, _ := pem.Decode(fileBytes)
+// loadChain reads the certificates referenced by the -issuer or -chain flags.
+// A nil issuerChain is returned if neither flag was provided.
+func loadChain() (*issuerChain, error) {
+	if issuerPath != "" && chainPath != "" {
+		return nil, errors.New("-issuer and -chain can not be used at the same time")
+	}
+	path := issuerPath
+	if path == "" {
+		path = chainPath
+	}
+	if path == "" {
+		return nil, nil
+	}
+	certs, err := readCertificates(path)
+	if err != nil {
+		return nil, err
+	}
+	if issuerPath != "" && len(certs) != 1 {
+		return nil, fmt.Errorf("expected exactly one certificate in %s, found %d", path, len(certs))
+	}
+	chain := &issuerChain{intermediates: certs}
+	if last := certs[len(certs)-1]; bytes.Equal(last.RawIssuer, last.RawSubject) {
+		chain.root = last
+		chain.intermediates = certs[:len(certs)-1]
+	}
+	return chain, nil
+}
+
+// precertificate holds the certificates provided with the -precert flag.
+type precertificate struct {
+	cert *x509.Certificate
+	// issuer is the certificate that signed cert, or nil if it was not
+	// provided.
+	issuer *x509.Certificate
+}
+
+// loadPrecert reads the precertificate, and the optional certificate that
+// signed it, referenced by the -precert flag. A nil precertificate is
+// returned if the flag was not provided.
+func loadPrecert() (*precertificate, error) {
+	if precertPath == "" {
+		return nil, nil
+	}
+	if issuerPath != "" || chainPath != "" {
+		return nil, errors.New("-precert can not be used with -issuer or -chain")
+	}
+	certs, err := readCertificates(precertPath)
+	if err != nil {
+		return nil, err
+	}
+	switch len(certs) {
+	case 1:
+		return &precertificate{cert: certs[0]}, nil
+	case 2:
+		return &precertificate{cert: certs[0], issuer: certs[1]}, nil
+	}
+	return nil, fmt.Errorf("expected a precertificate and at most its issuer in %s, found %d certificates", precertPath, len(certs))
+}
+
+// readCertificates reads every certificate from the PEM bundle, or the single
+// DER encoded certificate, found at path.
+func readCertificates(path string) ([]*x509.Certificate, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Contains(data, []byte("-----BEGIN")) {
+		c, err := x509.ParseCertificate(data)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse certificate %s: %w", path, err)
+		}
+		return []*x509.Certificate{c}, nil
+	}
+	var certs []*x509.Certificate
+	for {
+		var p *pem.Block
+		p, data = pem.Decode(data)
 		if p == nil {
-			log.Fatal("unable to parse PEM")
+			break
 		}
-		switch p.Type {
-		case "CERTIFICATE":
-		case "X509 CRL":
-			isCRL = true
-		default:
-			log.Fatalf("unknown PEM type (%s)", p.Type)
+		if p.Type != "CERTIFICATE" {
+			continue
 		}
-		asn1Data = p.Bytes
-	case "der":
-		asn1Data = fileBytes
-	case "base64":
-		asn1Data, err = base64.StdEncoding.DecodeString(string(fileBytes))
+		c, err := x509.ParseCertificate(p.Bytes)
 		if err != nil {
-			log.Fatalf("unable to parse base64: %s", err)
+			return nil, fmt.Errorf("unable to parse certificate %s: %w", path, err)
 		}
-	default:
-		log.Fatalf("unknown input format %s", format)
+		certs = append(certs, c)
 	}
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("no certificates found in %s", path)
+	}
+	return certs, nil
+}
+
+// lintObject lints the DER encoded object within obj according to its PEM
+// type, returning the parsed certificate if the object was a certificate, and
+// the results.
+//
+//nolint:cyclop
+func lintObject(obj inputObject, registry lint.Registry, chain *issuerChain, precert *precertificate) (*x509.Certificate, *zlint.ResultSet, error) {
+	der, pemType := obj.der, obj.pemType
+	opts := lintOptions()
+	opts.SkipSignatureLints = obj.rebuiltPrecert
 	var zlintResult *zlint.ResultSet
-	if isCRL {
-		crl, err := x509.ParseRevocationList(asn1Data)
+	var c *x509.Certificate
+	switch pemType {
+	case "CERTIFICATE":
+		var err error
+		c, err = x509.ParseCertificate(der)
 		if err != nil {
-			log.Fatalf("unable to parse certificate revocation list: %s", err)
+			return nil, nil, fmt.Errorf("unable to parse certificate: %w", err)
 		}
-		zlintResult = zlint.LintRevocationList(crl)
-	} else {
-		c, err := x509.ParseCertificate(asn1Data)
+		switch {
+		case precert != nil:
+			zlintResult = zlint.LintPrecertificatePairWithOptions(context.Background(), precert.cert, c, precert.issuer, registry, opts)
+		case chain != nil:
+			zlintResult = zlint.LintChainWithOptions(context.Background(), c, chain.intermediates, chain.root, registry, opts)[0]
+		default:
+			zlintResult = zlint.LintCertificateWithOptions(context.Background(), c, registry, opts)
+		}
+	case "X509 CRL":
+		crl, err := x509.ParseRevocationList(der)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to parse certificate revocation list: %w", err)
+		}
+		zlintResult = zlint.LintRevocationListWithOptions(context.Background(), crl, registry, opts)
+	case "OCSP RESPONSE":
+		resp, err := ocsp.ParseResponse(der, nil)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to parse OCSP response: %w", err)
+		}
+		// A response signed directly by the issuer embeds no certificate, in
+		// which case the issuer is the responder.
+		if issuer := chain.issuer(); resp.Certificate == nil && issuer != nil && resp.CheckSignatureFrom(issuer) == nil {
+			resp.Certificate = issuer
+		}
+		zlintResult = zlint.LintOCSPResponseWithOptions(context.Background(), resp, registry, opts)
+	case "CERTIFICATE REQUEST", "NEW CERTIFICATE REQUEST":
+		csr, err := x509.ParseCertificateRequest(der)
 		if err != nil {
-			log.Fatalf("unable to parse certificate: %s", err)
+			return nil, nil, fmt.Errorf("unable to parse certificate request: %w", err)
 		}
-		zlintResult = zlint.LintCertificateEx(c, registry)
+		zlintResult = zlint.LintCertificateRequestWithOptions(context.Background(), csr, registry, opts)
+	default:
+		return nil, nil, fmt.Errorf("unknown PEM type (%s)", pemType)
+	}
+	return c, zlintResult, nil
+}
+
+// lintOptions returns the options for linting given by the flags.
+func lintOptions() zlint.LintOptions {
+	return zlint.LintOptions{
+		Workers: workers,
+		Timeout: lintTimeout,
+		AsOf:    asOf,
+		Preview: preview,
+	}
+}
+
+// outputConnectReport prints the report of the handshake made for the -connect
+// flag as JSON if asJSON is true. Otherwise, as the output holds no JSON, any
+// chain order problems are logged instead.
+func outputConnectReport(report connectReport, asJSON bool) {
+	if !asJSON {
+		for _, problem := range report.ChainOrderProblems {
+			log.Warnf("%s: %s", report.Connect, problem)
+		}
+		return
+	}
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetEscapeHTML(false)
+	if prettyprint {
+		enc.SetIndent("", " ")
+	}
+	if err := enc.Encode(report); err != nil {
+		log.Fatalf("unable to encode connection JSON: %s", err)
+	}
+}
+
+// jsonLine is a single line of the output requested by the -jsonl flag,
+// describing a single object read from an input.
+type jsonLine struct {
+	File  string `json:"file"`
+	Index int    `json:"index"`
+	// Error is the reason that the object could not be linted, if any.
+	Error       string                     `json:"error,omitempty"`
+	Certificate *zlint.CertificateIdentity `json:"certificate,omitempty"`
+	Lints       interface{}                `json:"lints,omitempty"`
+	// Waived holds the original results of the lints whose findings were
+	// waived by the configuration.
+	Waived map[string]*zlint.WaivedResult `json:"waived,omitempty"`
+}
+
+// outputJSONLine prints the results of linting a single object, or the error
+// that prevented it from being linted, as a single line of JSON. The
+// certificate c is nil if the object was not a certificate.
+func outputJSONLine(obj inputObject, c *x509.Certificate, zlintResult *zlint.ResultSet) {
+	line := jsonLine{File: obj.file, Index: obj.index}
+	switch {
+	case obj.err != nil:
+		line.Error = obj.err.Error()
+	case enriched:
+		e := zlintResult.Enrich(c)
+		line.Certificate = e.Certificate
+		line.Lints = e.Results
+	default:
+		line.Lints = zlintResult.Results
+	}
+	if zlintResult != nil {
+		line.Waived = zlintResult.Waived
+	}
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(line); err != nil {
+		log.Fatalf("unable to encode lints JSON: %s", err)
+	}
+}
+
+// outputASN1Dump prints the dump requested by the -asn1dump flag for a single
+// object, headed by the name of the object.
+func outputASN1Dump(obj inputObject, zlintResult *zlint.ResultSet) {
+	fmt.Printf("%s:\n", obj.name())
+	if err := writeASN1Dump(os.Stdout, obj.der, zlintResult); err != nil {
+		log.Errorf("unable to dump the ASN.1 structure of %s: %v", obj.name(), err)
+	}
+	fmt.Println()
+}
+
+// exceedsThreshold returns true if any of the results is a finding at least as
+// severe as threshold.
+func exceedsThreshold(zlintResult *zlint.ResultSet, threshold lint.LintStatus) bool {
+	for _, res := range zlintResult.Results {
+		switch res.Status {
+		case lint.Notice, lint.Warn, lint.Error, lint.Fatal:
+			if res.Status >= threshold {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// outputResults prints the results of linting a single object in the format
+// requested by the output flags. The certificate c is nil if the object was not
+// a certificate.
+func outputResults(c *x509.Certificate, zlintResult *zlint.ResultSet) {
+	var jsonBytes []byte
+	var err error
+	if enriched {
+		jsonBytes, err = zlintResult.MarshalEnrichedJSON(c)
+	} else {
+		jsonBytes, err = json.Marshal(resultsJSON(zlintResult))
 	}
-	jsonBytes, err := json.Marshal(zlintResult.Results)
 	if err != nil {
 		log.Fatalf("unable to encode lints JSON: %s", err)
 	}
@@ -233,6 +638,65 @@ func doLint(inputFile *os.File, inform string, registry lint.Registry) {
 	os.Stdout.Sync()
 }
 
+// resultsJSON returns the default JSON output for a ResultSet, which maps the
+// name of each lint to its result. Should any findings have been waived then
+// their original results are included under the key "waived", which lacks the
+// n_, w_ or e_ prefix that every lint name carries.
+func resultsJSON(zlintResult *zlint.ResultSet) interface{} {
+	if len(zlintResult.Waived) == 0 {
+		return zlintResult.Results
+	}
+	out := make(map[string]interface{}, len(zlintResult.Results)+1)
+	for name, res := range zlintResult.Results {
+		out[name] = res
+	}
+	out["waived"] = zlintResult.Waived
+	return out
+}
+
+// outputComparison prints the comparison of a run against the baseline given
+// by the -baseline flag as JSON, or as a table of counts if a summary was
+// requested.
+func outputComparison(cmp zlint.BaselineComparison) {
+	if summary || longSummary {
+		formattedoutput.OutputBaselineSummary(cmp, longSummary)
+		os.Stdout.Sync()
+		return
+	}
+	var jsonBytes []byte
+	var err error
+	if prettyprint {
+		jsonBytes, err = json.MarshalIndent(cmp, "", " ")
+	} else {
+		jsonBytes, err = json.Marshal(cmp)
+	}
+	if err != nil {
+		log.Fatalf("unable to encode baseline comparison JSON: %s", err)
+	}
+	os.Stdout.Write(jsonBytes)
+	os.Stdout.Write([]byte{'\n'})
+	os.Stdout.Sync()
+}
+
+// detectDERType returns the PEM type matching the kind of object held by the
+// DER encoded data. Anything that can not be parsed is assumed to be a
+// certificate so that the certificate parser reports the error.
+func detectDERType(der []byte) string {
+	if _, err := x509.ParseCertificate(der); err == nil {
+		return "CERTIFICATE"
+	}
+	if _, err := x509.ParseRevocationList(der); err == nil {
+		return "X509 CRL"
+	}
+	if _, err := ocsp.ParseResponse(der, nil); err == nil {
+		return "OCSP RESPONSE"
+	}
+	if _, err := x509.ParseCertificateRequest(der); err == nil {
+		return "CERTIFICATE REQUEST"
+	}
+	return "CERTIFICATE"
+}
+
 // trimmedList takes a comma separated string argument in raw, splits it by
 // comma, and returns a list of the separated elements after trimming spaces
 // from each element.
@@ -244,6 +708,24 @@ func trimmedList(raw string) []string {
 	return list
 }
 
+// loadProfiles registers the profiles declared within the files given by the
+// -profileFiles flag.
+func loadProfiles() error {
+	if profileFiles == "" {
+		return nil
+	}
+	for _, path := range trimmedList(profileFiles) {
+		profiles, err := lint.NewProfilesFromFile(path)
+		if err != nil {
+			return err
+		}
+		for _, p := range profiles {
+			lint.RegisterProfile(p)
+		}
+	}
+	return nil
+}
+
 // setLints returns a filtered registry to use based on the nameFilter,
 // includeNames, excludeNames, includeSources, and excludeSources flag values in
 // use.
@@ -264,7 +746,7 @@ func setLints() (lint.Registry, error) {
 		}
 		return false
 	}
-	if !anyFilters(nameFilter, includeNames, excludeNames, includeSources, excludeSources, profile) {
+	if !anyFilters(nameFilter, includeNames, excludeNames, includeSources, excludeSources, includeTags, excludeTags, filterExpr, profile) {
 		return lint.GlobalRegistry(), nil
 	}
 	filterOpts := lint.FilterOptions{}
@@ -291,6 +773,19 @@ func setLints() (lint.Registry, error) {
 	if includeNames != "" {
 		filterOpts.IncludeNames = trimmedList(includeNames)
 	}
+	if excludeTags != "" {
+		filterOpts.ExcludeTags = trimmedList(excludeTags)
+	}
+	if includeTags != "" {
+		filterOpts.IncludeTags = trimmedList(includeTags)
+	}
+	if filterExpr != "" {
+		expr, err := lint.ParseFilterExpression(filterExpr)
+		if err != nil {
+			return nil, fmt.Errorf("bad -filter: %v", err)
+		}
+		filterOpts.Expression = expr
+	}
 	if profile != "" {
 		p, ok := lint.GetProfile(profile)
 		if !ok {
@@ -301,3 +796,27 @@ func setLints() (lint.Registry, error) {
 
 	return lint.GlobalRegistry().Filter(filterOpts)
 }
+
+// parseAsOf parses the value of the -asOf flag, which may either be a date or
+// a full RFC 3339 timestamp.
+func parseAsOf(value string) error {
+	for _, layout := range []string{"2006-01-02", time.RFC3339} {
+		if t, err := time.Parse(layout, value); err == nil {
+			asOf = t
+			return nil
+		}
+	}
+	return fmt.Errorf("%q is neither a date (YYYY-MM-DD) nor an RFC 3339 timestamp", value)
+}
+
+// parseFailOn parses the value of the -fail-on flag.
+func parseFailOn(value string) error {
+	status, ok := lint.StatusLabelToLintStatus[value]
+	switch {
+	case ok && status >= lint.Notice && status <= lint.Fatal:
+		failOn = status
+		return nil
+	default:
+		return fmt.Errorf("%q must be one of %q, %q, %q or %q", value, lint.Notice, lint.Warn, lint.Error, lint.Fatal)
+	}
+}
@@ -0,0 +1,113 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package ct
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3"
+	"github.com/zmap/zlint/v3/lint"
+)
+
+// rawEntry is a single entry of a get-entries response.
+type rawEntry struct {
+	LeafInput []byte `json:"leaf_input"`
+	ExtraData []byte `json:"extra_data"`
+}
+
+// DecodeEntries reads a get-entries response (RFC 6962 section 4.6) from r,
+// calling fn with each of its entries in turn. As the response does not hold
+// the indices of its entries, the first entry is given the index start. If an
+// entry can not be decoded then fn is called with the error instead, and
+// decoding continues. An error is only returned if the response itself is
+// malformed.
+func DecodeEntries(r io.Reader, start int64, fn func(index int64, e *Entry, err error)) error {
+	dec := json.NewDecoder(r)
+	if err := expectDelim(dec, '{'); err != nil {
+		return err
+	}
+	for dec.More() {
+		key, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		if key != "entries" {
+			var skip json.RawMessage
+			if err := dec.Decode(&skip); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := expectDelim(dec, '['); err != nil {
+			return err
+		}
+		for index := start; dec.More(); index++ {
+			var raw rawEntry
+			if err := dec.Decode(&raw); err != nil {
+				return fmt.Errorf("malformed entry %d: %w", index, err)
+			}
+			e, err := ParseEntry(index, raw.LeafInput, raw.ExtraData)
+			fn(index, e, err)
+		}
+		if err := expectDelim(dec, ']'); err != nil {
+			return err
+		}
+	}
+	return expectDelim(dec, '}')
+}
+
+func expectDelim(dec *json.Decoder, want json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return fmt.Errorf("malformed get-entries response: %w", err)
+	}
+	if tok != want {
+		return fmt.Errorf("malformed get-entries response: expected %q, found %v", want, tok)
+	}
+	return nil
+}
+
+// Result is the outcome of linting a log entry.
+type Result struct {
+	// Index is the index of the entry within the log.
+	Index    int64            `json:"index"`
+	Type     EntryType        `json:"entry_type"`
+	LoggedAt time.Time        `json:"logged_at"`
+	Results  *zlint.ResultSet `json:"results"`
+}
+
+// LintEntry lints the certificate or rebuilt precertificate of the entry with
+// the lints in the registry, as directed by opts. If the issuer of the entry
+// is known then the chain lints are run too (see Entry.Issuer). If registry is
+// nil then the global registry is used. As the signature of a rebuilt
+// precertificate never verifies, the lints listed in
+// zlint.TemplateSignatureLints are reported as skipped for precert entries.
+func LintEntry(ctx context.Context, e *Entry, registry lint.Registry, opts zlint.LintOptions) *Result {
+	res := &Result{Index: e.Index, Type: e.Type, LoggedAt: e.Timestamp}
+	if e.Type == PrecertEntry {
+		opts.SkipSignatureLints = true
+	}
+	if issuer := e.Issuer(); issuer != nil {
+		res.Results = zlint.LintChainWithOptions(ctx, e.Certificate, []*x509.Certificate{issuer}, nil, registry, opts)[0]
+	} else {
+		res.Results = zlint.LintCertificateWithOptions(ctx, e.Certificate, registry, opts)
+	}
+	return res
+}
@@ -0,0 +1,255 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+// Package ct decodes the entries of RFC 6962 Certificate Transparency logs, as
+// returned by the get-entries endpoint, so that the certificates and
+// precertificates that they hold can be linted offline.
+package ct
+
+import (
+	stdasn1 "encoding/asn1"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3/util"
+	"golang.org/x/crypto/cryptobyte"
+	cbasn1 "golang.org/x/crypto/cryptobyte/asn1"
+)
+
+// EntryType is the type of a log entry (RFC 6962 section 3.4).
+type EntryType uint16
+
+const (
+	X509Entry    EntryType = 0
+	PrecertEntry EntryType = 1
+)
+
+// String returns the name of the entry type as used by RFC 6962.
+func (t EntryType) String() string {
+	switch t {
+	case X509Entry:
+		return "x509_entry"
+	case PrecertEntry:
+		return "precert_entry"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint16(t))
+	}
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (t EntryType) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// An Entry is a single decoded log entry.
+type Entry struct {
+	// Index is the index of the entry within the log.
+	Index int64
+	// Timestamp is the time at which the entry was logged.
+	Timestamp time.Time
+	Type      EntryType
+	// IssuerKeyHash is the SHA-256 hash of the public key of the issuer of a
+	// precertificate entry.
+	IssuerKeyHash [32]byte
+	// Certificate is the certificate of an X509Entry or, for a PrecertEntry,
+	// the precertificate rebuilt from the logged TBSCertificate (see
+	// RebuildPrecertificate).
+	Certificate *x509.Certificate
+	// Chain holds the certificates of the extra_data of the entry, starting
+	// with the issuer. For a PrecertEntry the submitted precertificate is
+	// not included. Chain is empty if no extra_data was provided.
+	Chain []*x509.Certificate
+}
+
+// Issuer returns the issuer of the certificate of the entry, or nil if the
+// extra_data of the entry was not provided. If a precertificate was issued by
+// a Precertificate Signing Certificate, then the issuer is the CA that will
+// issue the final certificate, as named within the logged TBSCertificate.
+func (e *Entry) Issuer() *x509.Certificate {
+	chain := e.Chain
+	if e.Type == PrecertEntry && len(chain) > 1 && util.IsPrecertSigningCert(chain[0]) {
+		chain = chain[1:]
+	}
+	if len(chain) == 0 {
+		return nil
+	}
+	return chain[0]
+}
+
+// ParseEntry decodes the leaf_input and extra_data of the log entry with the
+// given index. extraData may be nil, in which case the Chain of the entry is
+// empty.
+func ParseEntry(index int64, leafInput []byte, extraData []byte) (*Entry, error) {
+	e := &Entry{Index: index}
+	s := cryptobyte.String(leafInput)
+	var version, leafType uint8
+	var timestamp uint64
+	var entryType uint16
+	if !s.ReadUint8(&version) || !s.ReadUint8(&leafType) || !s.ReadUint64(&timestamp) || !s.ReadUint16(&entryType) {
+		return nil, errors.New("truncated MerkleTreeLeaf")
+	}
+	if version != 0 {
+		return nil, fmt.Errorf("unsupported MerkleTreeLeaf version %d", version)
+	}
+	if leafType != 0 {
+		return nil, fmt.Errorf("unsupported MerkleTreeLeaf type %d", leafType)
+	}
+	e.Timestamp = time.UnixMilli(int64(timestamp)).UTC()
+	e.Type = EntryType(entryType)
+
+	var der, tbs cryptobyte.String
+	switch e.Type {
+	case X509Entry:
+		if !s.ReadUint24LengthPrefixed(&der) {
+			return nil, errors.New("truncated x509_entry")
+		}
+	case PrecertEntry:
+		if !s.CopyBytes(e.IssuerKeyHash[:]) || !s.ReadUint24LengthPrefixed(&tbs) {
+			return nil, errors.New("truncated precert_entry")
+		}
+	default:
+		return nil, fmt.Errorf("unsupported entry type %s", e.Type)
+	}
+	var extensions cryptobyte.String
+	if !s.ReadUint16LengthPrefixed(&extensions) || !s.Empty() {
+		return nil, errors.New("malformed CtExtensions")
+	}
+
+	chain, precert, err := parseExtraData(e.Type, extraData)
+	if err != nil {
+		return nil, err
+	}
+	if e.Type == PrecertEntry {
+		der, err = RebuildPrecertificate(tbs, precert)
+		if err != nil {
+			return nil, err
+		}
+	}
+	e.Certificate, err = x509.ParseCertificate(der)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse the certificate of the entry: %w", err)
+	}
+	for _, raw := range chain {
+		c, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse the certificate chain of the entry: %w", err)
+		}
+		e.Chain = append(e.Chain, c)
+	}
+	return e, nil
+}
+
+// parseExtraData decodes the extra_data of an entry, returning the DER
+// encoded certificate chain and, for a PrecertEntry, the submitted
+// precertificate.
+func parseExtraData(t EntryType, extraData []byte) (chain [][]byte, precert []byte, err error) {
+	if len(extraData) == 0 {
+		return nil, nil, nil
+	}
+	s := cryptobyte.String(extraData)
+	if t == PrecertEntry {
+		var raw cryptobyte.String
+		if !s.ReadUint24LengthPrefixed(&raw) {
+			return nil, nil, errors.New("malformed PrecertChainEntry")
+		}
+		precert = raw
+	}
+	var list cryptobyte.String
+	if !s.ReadUint24LengthPrefixed(&list) || !s.Empty() {
+		return nil, nil, errors.New("malformed certificate chain in extra_data")
+	}
+	for !list.Empty() {
+		var raw cryptobyte.String
+		if !list.ReadUint24LengthPrefixed(&raw) {
+			return nil, nil, errors.New("malformed certificate chain in extra_data")
+		}
+		chain = append(chain, raw)
+	}
+	return chain, precert, nil
+}
+
+// RebuildPrecertificate rebuilds a precertificate from the TBSCertificate of a
+// precert_entry, which has had its poison extension removed and whose issuer
+// and authority key identifier are those of the final certificate (RFC 6962
+// section 3.2). The poison extension is appended to the extensions of tbs, so
+// that the result is recognized as a precertificate.
+//
+// The signature of the result is taken from the submitted precertificate if
+// it is provided, or is otherwise empty. It never verifies, since the
+// TBSCertificate was modified after signing.
+func RebuildPrecertificate(tbs []byte, precert []byte) ([]byte, error) {
+	fields, err := util.ParseTBSCertificateFields(tbs)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse the TBSCertificate of the entry: %w", err)
+	}
+	signature := []byte{0x03, 0x01, 0x00}
+	if len(precert) > 0 {
+		signature, err = signatureValue(precert)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var b cryptobyte.Builder
+	b.AddASN1(cbasn1.SEQUENCE, func(cert *cryptobyte.Builder) {
+		cert.AddASN1(cbasn1.SEQUENCE, func(t *cryptobyte.Builder) {
+			for _, field := range [][]byte{
+				fields.Version,
+				fields.SerialNumber,
+				fields.Signature,
+				fields.Issuer,
+				fields.Validity,
+				fields.Subject,
+				fields.SubjectPublicKeyInfo,
+				fields.IssuerUniqueID,
+				fields.SubjectUniqueID,
+			} {
+				t.AddBytes(field)
+			}
+			t.AddASN1(cbasn1.Tag(3).Constructed().ContextSpecific(), func(explicit *cryptobyte.Builder) {
+				explicit.AddASN1(cbasn1.SEQUENCE, func(exts *cryptobyte.Builder) {
+					for _, ext := range util.RemoveRawExtension(fields.Extensions, util.CtPoisonOID) {
+						exts.AddBytes(ext.Raw)
+					}
+					exts.AddASN1(cbasn1.SEQUENCE, func(poison *cryptobyte.Builder) {
+						poison.AddASN1ObjectIdentifier(stdasn1.ObjectIdentifier(util.CtPoisonOID))
+						poison.AddASN1Boolean(true)
+						poison.AddASN1OctetString([]byte{0x05, 0x00})
+					})
+				})
+			})
+		})
+		cert.AddBytes(fields.Signature)
+		cert.AddBytes(signature)
+	})
+	return b.Bytes()
+}
+
+// signatureValue returns the DER encoded signatureValue of a certificate.
+func signatureValue(der []byte) ([]byte, error) {
+	s := cryptobyte.String(der)
+	var cert cryptobyte.String
+	var sig cryptobyte.String
+	var tag cbasn1.Tag
+	if !s.ReadASN1(&cert, cbasn1.SEQUENCE) ||
+		!cert.SkipASN1(cbasn1.SEQUENCE) ||
+		!cert.SkipASN1(cbasn1.SEQUENCE) ||
+		!cert.ReadAnyASN1Element(&sig, &tag) ||
+		tag != cbasn1.BIT_STRING {
+		return nil, errors.New("unable to parse the submitted precertificate")
+	}
+	return sig, nil
+}
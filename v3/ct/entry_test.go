@@ -0,0 +1,336 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package ct
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	stdx509 "crypto/x509"
+	"crypto/x509/pkix"
+	stdasn1 "encoding/asn1"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+	"golang.org/x/crypto/cryptobyte"
+	cbasn1 "golang.org/x/crypto/cryptobyte/asn1"
+)
+
+// testCertificates issues a CA certificate, a Precertificate Signing
+// Certificate and a leaf certificate bearing the poison extension.
+func testCertificates(t *testing.T) (ca, precertSigner, leaf *x509.Certificate) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	caTemplate := &stdx509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test CA"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(time.Hour),
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		KeyUsage:              stdx509.KeyUsageCertSign,
+	}
+	caDER, err := stdx509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signerTemplate := &stdx509.Certificate{
+		SerialNumber:       big.NewInt(2),
+		Subject:            pkix.Name{CommonName: "Test Precertificate Signer"},
+		NotBefore:          now.Add(-time.Hour),
+		NotAfter:           now.Add(time.Hour),
+		UnknownExtKeyUsage: []stdasn1.ObjectIdentifier{{1, 3, 6, 1, 4, 1, 11129, 2, 4, 4}},
+	}
+	signerDER, err := stdx509.CreateCertificate(rand.Reader, signerTemplate, caTemplate, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leafTemplate := &stdx509.Certificate{
+		SerialNumber: big.NewInt(3),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		DNSNames:     []string{"example.com"},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(time.Hour),
+		// The poison extension is not the last, as it is in most
+		// precertificates, so that the rebuilt precertificate differs from
+		// the one that was signed.
+		ExtraExtensions: []pkix.Extension{
+			{Id: stdasn1.ObjectIdentifier(util.CtPoisonOID), Critical: true, Value: []byte{0x05, 0x00}},
+			{Id: stdasn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 99999, 1}, Value: []byte{0x05, 0x00}},
+		},
+	}
+	leafDER, err := stdx509.CreateCertificate(rand.Reader, leafTemplate, caTemplate, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parse := func(der []byte) *x509.Certificate {
+		c, err := x509.ParseCertificate(der)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return c
+	}
+	return parse(caDER), parse(signerDER), parse(leafDER)
+}
+
+// leafInput encodes a MerkleTreeLeaf holding an entry of the given type.
+func leafInput(entryType EntryType, timestamp uint64, body []byte) []byte {
+	var b cryptobyte.Builder
+	b.AddUint8(0)
+	b.AddUint8(0)
+	b.AddUint64(timestamp)
+	b.AddUint16(uint16(entryType))
+	if entryType == PrecertEntry {
+		b.AddBytes(make([]byte, 32))
+	}
+	b.AddUint24LengthPrefixed(func(b *cryptobyte.Builder) { b.AddBytes(body) })
+	b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {})
+	return b.BytesOrPanic()
+}
+
+// extraData encodes the extra_data of an entry. precert is only encoded if it
+// is not nil.
+func extraData(precert *x509.Certificate, chain ...*x509.Certificate) []byte {
+	var b cryptobyte.Builder
+	if precert != nil {
+		b.AddUint24LengthPrefixed(func(b *cryptobyte.Builder) { b.AddBytes(precert.Raw) })
+	}
+	b.AddUint24LengthPrefixed(func(b *cryptobyte.Builder) {
+		for _, c := range chain {
+			c := c
+			b.AddUint24LengthPrefixed(func(b *cryptobyte.Builder) { b.AddBytes(c.Raw) })
+		}
+	})
+	return b.BytesOrPanic()
+}
+
+func TestParseEntry(t *testing.T) {
+	ca, signer, leaf := testCertificates(t)
+	wantExtensions := len(leaf.Extensions)
+	tbs := loggedTBS(t, leaf.RawTBSCertificate)
+
+	testCases := []struct {
+		name       string
+		leafInput  []byte
+		extraData  []byte
+		wantType   EntryType
+		wantIssuer *x509.Certificate
+		wantChain  int
+		wantErr    string
+	}{
+		{
+			name:       "x509 entry",
+			leafInput:  leafInput(X509Entry, 1500000000000, ca.Raw),
+			extraData:  extraData(nil, ca),
+			wantType:   X509Entry,
+			wantIssuer: ca,
+			wantChain:  1,
+		},
+		{
+			name:      "x509 entry without extra data",
+			leafInput: leafInput(X509Entry, 1500000000000, ca.Raw),
+			wantType:  X509Entry,
+		},
+		{
+			name:       "precert entry",
+			leafInput:  leafInput(PrecertEntry, 1500000000000, tbs),
+			extraData:  extraData(leaf, ca),
+			wantType:   PrecertEntry,
+			wantIssuer: ca,
+			wantChain:  1,
+		},
+		{
+			name:       "precert entry signed by a precertificate signing certificate",
+			leafInput:  leafInput(PrecertEntry, 1500000000000, tbs),
+			extraData:  extraData(leaf, signer, ca),
+			wantType:   PrecertEntry,
+			wantIssuer: ca,
+			wantChain:  2,
+		},
+		{
+			name:      "precert entry without extra data",
+			leafInput: leafInput(PrecertEntry, 1500000000000, tbs),
+			wantType:  PrecertEntry,
+		},
+		{
+			name:      "truncated",
+			leafInput: leafInput(X509Entry, 1500000000000, ca.Raw)[:20],
+			wantErr:   "truncated",
+		},
+		{
+			name:      "unknown entry type",
+			leafInput: leafInput(7, 1500000000000, ca.Raw),
+			wantErr:   "unsupported entry type",
+		},
+		{
+			name:      "malformed extra data",
+			leafInput: leafInput(X509Entry, 1500000000000, ca.Raw),
+			extraData: []byte{0, 0, 9},
+			wantErr:   "malformed certificate chain",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			e, err := ParseEntry(42, tc.leafInput, tc.extraData)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected an error containing %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if e.Index != 42 || e.Type != tc.wantType || !e.Timestamp.Equal(time.UnixMilli(1500000000000)) {
+				t.Errorf("expected entry 42 of type %s, got entry %d of type %s at %v", tc.wantType, e.Index, e.Type, e.Timestamp)
+			}
+			if len(e.Chain) != tc.wantChain {
+				t.Errorf("expected a chain of %d certificates, got %d", tc.wantChain, len(e.Chain))
+			}
+			if issuer := e.Issuer(); (issuer == nil) != (tc.wantIssuer == nil) || (issuer != nil && !bytes.Equal(issuer.Raw, tc.wantIssuer.Raw)) {
+				t.Errorf("expected the issuer to be %v, got %v", tc.wantIssuer, issuer)
+			}
+			if tc.wantType != PrecertEntry {
+				return
+			}
+			if !util.IsExtInCert(e.Certificate, util.CtPoisonOID) || len(e.Certificate.Extensions) != wantExtensions {
+				t.Errorf("expected the rebuilt precertificate to have %d extensions including the poison, got %d", wantExtensions, len(e.Certificate.Extensions))
+			}
+			if e.Certificate.Subject.CommonName != "example.com" || e.Certificate.SerialNumber.Int64() != 3 {
+				t.Errorf("expected the rebuilt precertificate to match the logged TBSCertificate, got %v", e.Certificate.Subject)
+			}
+		})
+	}
+}
+
+// loggedTBS removes the poison extension from a DER encoded TBSCertificate,
+// as a log does before logging a precertificate.
+func loggedTBS(t *testing.T, tbs []byte) []byte {
+	t.Helper()
+	fields, err := util.ParseTBSCertificateFields(tbs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b cryptobyte.Builder
+	b.AddASN1(cbasn1.SEQUENCE, func(b *cryptobyte.Builder) {
+		for _, field := range [][]byte{fields.Version, fields.SerialNumber, fields.Signature, fields.Issuer, fields.Validity, fields.Subject, fields.SubjectPublicKeyInfo} {
+			b.AddBytes(field)
+		}
+		b.AddASN1(cbasn1.Tag(3).Constructed().ContextSpecific(), func(b *cryptobyte.Builder) {
+			b.AddASN1(cbasn1.SEQUENCE, func(b *cryptobyte.Builder) {
+				for _, ext := range util.RemoveRawExtension(fields.Extensions, util.CtPoisonOID) {
+					b.AddBytes(ext.Raw)
+				}
+			})
+		})
+	})
+	return b.BytesOrPanic()
+}
+
+func TestDecodeEntries(t *testing.T) {
+	ca, _, _ := testCertificates(t)
+	entries := []rawEntry{
+		{LeafInput: leafInput(X509Entry, 1500000000000, ca.Raw), ExtraData: extraData(nil)},
+		{LeafInput: []byte{1, 2, 3}},
+		{LeafInput: leafInput(X509Entry, 1500000000000, ca.Raw)},
+	}
+	body, err := json.Marshal(map[string]interface{}{"entries": entries})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	err = DecodeEntries(bytes.NewReader(body), 100, func(index int64, e *Entry, err error) {
+		switch {
+		case err != nil:
+			got = append(got, "error")
+		case e.Index != index:
+			t.Errorf("expected entry %d to have index %d, got %d", index, index, e.Index)
+		default:
+			got = append(got, e.Type.String())
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "x509_entry error x509_entry"; strings.Join(got, " ") != want {
+		t.Errorf("expected %q, got %q", want, strings.Join(got, " "))
+	}
+
+	if err := DecodeEntries(strings.NewReader(`{"entries": {}}`), 0, func(int64, *Entry, error) {}); err == nil {
+		t.Error("expected an error for a malformed response")
+	}
+
+	e, err := ParseEntry(7, entries[0].LeafInput, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res := LintEntry(context.Background(), e, lint.GlobalRegistry(), zlint.LintOptions{})
+	if res.Index != 7 || res.Type != X509Entry || res.Results == nil || len(res.Results.Results) == 0 {
+		t.Errorf("expected results for entry 7, got %+v", res)
+	}
+}
+
+func TestLintEntrySkipsSignatureOfRebuiltPrecertificate(t *testing.T) {
+	ca, _, leaf := testCertificates(t)
+	testCases := []struct {
+		name       string
+		entry      func() (*Entry, error)
+		wantStatus lint.LintStatus
+	}{
+		{
+			name: "x509 entry",
+			entry: func() (*Entry, error) {
+				return ParseEntry(1, leafInput(X509Entry, 1500000000000, leaf.Raw), extraData(nil, ca))
+			},
+			wantStatus: lint.Pass,
+		},
+		{
+			name: "precert entry",
+			entry: func() (*Entry, error) {
+				return ParseEntry(2, leafInput(PrecertEntry, 1500000000000, loggedTBS(t, leaf.RawTBSCertificate)), extraData(leaf, ca))
+			},
+			wantStatus: lint.Skipped,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			e, err := tc.entry()
+			if err != nil {
+				t.Fatal(err)
+			}
+			res := LintEntry(context.Background(), e, lint.GlobalRegistry(), zlint.LintOptions{})
+			for _, name := range zlint.TemplateSignatureLints {
+				if got := res.Results.Results[name]; got == nil || got.Status != tc.wantStatus {
+					t.Errorf("expected %s to be %s, got %v", name, tc.wantStatus, got)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package zlint
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+)
+
+// CertificateIdentity identifies the certificate that a ResultSet describes
+// within enriched JSON output.
+type CertificateIdentity struct {
+	// Fingerprint is the hex encoded SHA-256 hash of the DER encoding of the
+	// certificate (see Fingerprint).
+	Fingerprint string `json:"fingerprint"`
+	// Subject is the distinguished name of the subject of the certificate.
+	Subject string `json:"subject"`
+	// Issuer is the distinguished name of the issuer of the certificate.
+	Issuer string `json:"issuer"`
+	// Serial is the hex encoded serial number of the certificate.
+	Serial    string    `json:"serial"`
+	NotBefore time.Time `json:"not_before"`
+}
+
+// NewCertificateIdentity returns the identity of the given certificate.
+func NewCertificateIdentity(c *x509.Certificate) *CertificateIdentity {
+	id := &CertificateIdentity{
+		Fingerprint: Fingerprint(c.Raw),
+		Subject:     c.Subject.String(),
+		Issuer:      c.Issuer.String(),
+		NotBefore:   c.NotBefore.UTC(),
+	}
+	if c.SerialNumber != nil {
+		id.Serial = c.SerialNumber.Text(16)
+	}
+	return id
+}
+
+// EnrichedLintResult is a lint result along with the metadata of the lint that
+// produced it.
+type EnrichedLintResult struct {
+	Status          lint.LintStatus `json:"result"`
+	Details         string          `json:"details,omitempty"`
+	Findings        []lint.Finding  `json:"findings,omitempty"`
+	Name            string          `json:"name"`
+	Description     string          `json:"description,omitempty"`
+	Citation        string          `json:"citation,omitempty"`
+	Remediation     string          `json:"remediation,omitempty"`
+	Source          lint.LintSource `json:"source"`
+	EffectiveDate   *time.Time      `json:"effective_date,omitempty"`
+	IneffectiveDate *time.Time      `json:"ineffective_date,omitempty"`
+	Prerequisites   []string        `json:"prerequisites,omitempty"`
+}
+
+// EnrichedResultSet is a ResultSet that carries the identity of the linted
+// certificate and the metadata of each lint, which are omitted from the
+// compact JSON encoding of a ResultSet.
+type EnrichedResultSet struct {
+	// Certificate identifies the linted certificate. It is nil if the linted
+	// object was not a certificate.
+	Certificate     *CertificateIdentity           `json:"certificate,omitempty"`
+	Version         int64                          `json:"version"`
+	Timestamp       int64                          `json:"timestamp"`
+	Results         map[string]*EnrichedLintResult `json:"lints"`
+	NoticesPresent  bool                           `json:"notices_present"`
+	WarningsPresent bool                           `json:"warnings_present"`
+	ErrorsPresent   bool                           `json:"errors_present"`
+	FatalsPresent   bool                           `json:"fatals_present"`
+	Waived          map[string]*WaivedResult       `json:"waived,omitempty"`
+}
+
+// Enrich returns the results of the ResultSet along with the metadata of each
+// lint and, if c is not nil, the identity of the certificate c that was linted.
+func (z *ResultSet) Enrich(c *x509.Certificate) *EnrichedResultSet {
+	enriched := &EnrichedResultSet{
+		Version:         z.Version,
+		Timestamp:       z.Timestamp,
+		Results:         make(map[string]*EnrichedLintResult, len(z.Results)),
+		NoticesPresent:  z.NoticesPresent,
+		WarningsPresent: z.WarningsPresent,
+		ErrorsPresent:   z.ErrorsPresent,
+		FatalsPresent:   z.FatalsPresent,
+		Waived:          z.Waived,
+	}
+	if c != nil {
+		enriched.Certificate = NewCertificateIdentity(c)
+	}
+	for name, res := range z.Results {
+		meta := res.LintMetadata
+		enriched.Results[name] = &EnrichedLintResult{
+			Status:          res.Status,
+			Details:         res.Details,
+			Findings:        res.Findings,
+			Name:            name,
+			Description:     meta.Description,
+			Citation:        meta.Citation,
+			Remediation:     meta.Remediation,
+			Source:          meta.Source,
+			EffectiveDate:   optionalTime(meta.EffectiveDate),
+			IneffectiveDate: optionalTime(meta.IneffectiveDate),
+			Prerequisites:   meta.Prerequisites,
+		}
+	}
+	return enriched
+}
+
+// MarshalEnrichedJSON encodes the ResultSet as JSON in the same manner as
+// Enrich. The compact encoding produced by json.Marshal remains the default.
+func (z *ResultSet) MarshalEnrichedJSON(c *x509.Certificate) ([]byte, error) {
+	return json.Marshal(z.Enrich(c))
+}
+
+// optionalTime returns nil for the zero time and util.ZeroDate, which lints use
+// to indicate that they have no effective date, so that it is omitted from JSON.
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() || t.Equal(util.ZeroDate) {
+		return nil
+	}
+	t = t.UTC()
+	return &t
+}
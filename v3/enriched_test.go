@@ -0,0 +1,71 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package zlint
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/zmap/zlint/v3/lint"
+)
+
+func TestMarshalEnrichedJSON(t *testing.T) {
+	c := readTestCertificate(t, "rsaFermatFactorizationSusceptible.pem")
+	res := LintCertificate(c)
+	data, err := res.MarshalEnrichedJSON(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got EnrichedResultSet
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+
+	want := CertificateIdentity{
+		Fingerprint: "3817c1e501e1ed8860f56b2533768e8580311d9ef1cbcb18403a6b297befa7c6",
+		Serial:      "3",
+		NotBefore:   time.Date(2008, time.May, 1, 0, 0, 0, 0, time.UTC),
+	}
+	if got.Certificate == nil || *got.Certificate != want {
+		t.Errorf("expected certificate identity %+v, got %+v", want, got.Certificate)
+	}
+	if len(got.Results) != len(res.Results) || !got.ErrorsPresent {
+		t.Errorf("expected %d results with errors present, got %d", len(res.Results), len(got.Results))
+	}
+
+	fermat := got.Results["e_rsa_fermat_factorization"]
+	if fermat == nil || fermat.Status != lint.Error || fermat.Citation != "Pierre de Fermat" || fermat.Source != lint.Community {
+		t.Errorf("expected the Fermat lint to fail with its metadata, got %+v", fermat)
+	} else if fermat.EffectiveDate != nil {
+		t.Errorf("expected the Fermat lint to have no effective date, got %v", fermat.EffectiveDate)
+	}
+	for name, r := range got.Results {
+		meta := lint.GlobalRegistry().CertificateLints().ByName(name)
+		if meta == nil {
+			continue
+		}
+		if r.Name != name || r.Description != meta.Description || r.Remediation != meta.Remediation {
+			t.Errorf("expected %s to be described as %q with remediation %q, got %+v", name, meta.Description, meta.Remediation, r)
+		}
+		if r.EffectiveDate != nil && !r.EffectiveDate.Equal(meta.EffectiveDate) {
+			t.Errorf("expected %s to be effective from %v, got %v", name, meta.EffectiveDate, r.EffectiveDate)
+		}
+	}
+
+	if got := res.Enrich(nil); got.Certificate != nil {
+		t.Errorf("expected no certificate identity without a certificate, got %+v", got.Certificate)
+	}
+}
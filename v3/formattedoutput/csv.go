@@ -0,0 +1,48 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package formattedoutput
+
+import (
+	"encoding/csv"
+	"io"
+)
+
+// csvHeader names the columns written by FormatCSV.
+var csvHeader = []string{"input", "lint", "status", "details", "source", "citation"}
+
+// FormatCSV writes the reports as CSV with a header row, followed by a row
+// for each lint that was run against each input.
+func FormatCSV(w io.Writer, reports []Report) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, report := range reports {
+		for _, res := range sortedResults(report.Results) {
+			if err := cw.Write([]string{
+				report.Input,
+				res.LintMetadata.Name,
+				res.Status.String(),
+				res.Details,
+				string(res.LintMetadata.Source),
+				res.LintMetadata.Citation,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
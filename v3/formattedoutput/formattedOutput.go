@@ -70,7 +70,7 @@ func OutputSummary(zlintResult *zlint.ResultSet, longSummary bool) {
 		// by type
 		var olsl string
 		headings := []string{
-			"Level",
+			"Level   ",
 			"# occurrences",
 			"                      Details                      ",
 		}
@@ -106,8 +106,9 @@ func OutputSummary(zlintResult *zlint.ResultSet, longSummary bool) {
 			}
 		}
 		printTableBody(hlengths, lines)
+		printRemediations(zlintResult, rt)
 	} else {
-		headings := []string{"Level", "# occurrences"}
+		headings := []string{"Level   ", "# occurrences"}
 		hlengths := printTableHeadings(headings)
 		lines := [][]string{}
 		for _, level := range rt.sortedLevels {
@@ -120,6 +121,23 @@ func OutputSummary(zlintResult *zlint.ResultSet, longSummary bool) {
 	}
 }
 
+// printRemediations prints the remediation of each lint listed within the long
+// summary table, in the order of the table, for those lints that supply one.
+func printRemediations(results *zlint.ResultSet, rt resultsTable) {
+	var remediations []string
+	for _, level := range rt.sortedLevels {
+		for _, name := range rt.resultDetails[lint.LintStatus(level)] {
+			if remediation := results.Results[name].LintMetadata.Remediation; remediation != "" {
+				remediations = append(remediations, fmt.Sprintf("  %s: %s", name, remediation))
+			}
+		}
+	}
+	if len(remediations) == 0 {
+		return
+	}
+	fmt.Printf("\nRemediation:\n%s\n", strings.Join(remediations, "\n"))
+}
+
 func printTableHeadings(headings []string) []int {
 	hlengths := []int{}
 	for i, h := range headings {
@@ -156,3 +174,51 @@ func printTableBody(hlengths []int, lines [][]string) {
 	}
 
 }
+
+// OutputBaselineSummary prints a table of the number of new, fixed and
+// unchanged findings within cmp. If longSummary is true then the names of the
+// lints behind each finding are listed as well.
+func OutputBaselineSummary(cmp zlint.BaselineComparison, longSummary bool) {
+	groups := []struct {
+		label    string
+		findings []zlint.BaselineEntry
+	}{
+		{"new", cmp.New},
+		{"fixed", cmp.Fixed},
+		{"unchanged", cmp.Unchanged},
+	}
+	if !longSummary {
+		hlengths := printTableHeadings([]string{"Comparison", "# occurrences"})
+		lines := [][]string{}
+		for _, group := range groups {
+			lines = append(lines, []string{group.label, strconv.Itoa(len(group.findings))})
+		}
+		printTableBody(hlengths, lines)
+		fmt.Printf("\n")
+		return
+	}
+	hlengths := printTableHeadings([]string{
+		"Comparison",
+		"# occurrences",
+		"                      Details                      ",
+	})
+	lines := [][]string{}
+	for _, group := range groups {
+		if len(group.findings) == 0 {
+			lines = append(lines, []string{group.label, "0", " - "})
+			continue
+		}
+		for i, finding := range group.findings {
+			label, count := "", ""
+			if i == 0 {
+				label, count = group.label, strconv.Itoa(len(group.findings))
+			}
+			details := finding.Lint
+			if finding.Previous != lint.Reserved {
+				details += fmt.Sprintf(" (escalated from %s)", finding.Previous)
+			}
+			lines = append(lines, []string{label, count, details})
+		}
+	}
+	printTableBody(hlengths, lines)
+}
@@ -0,0 +1,123 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package formattedoutput
+
+import (
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/zmap/zlint/v3"
+	"github.com/zmap/zlint/v3/lint"
+)
+
+// A Report is the outcome of linting a single input.
+type Report struct {
+	// Input names the input that was linted, e.g. its file path.
+	Input string
+	// Results are the results of linting the input.
+	Results *zlint.ResultSet
+}
+
+// A Formatter writes a collection of Reports in a particular output format.
+type Formatter interface {
+	// Format writes the reports to w.
+	Format(w io.Writer, reports []Report) error
+}
+
+// FormatterFunc adapts an ordinary function to the Formatter interface.
+type FormatterFunc func(w io.Writer, reports []Report) error
+
+// Format implements Formatter.
+func (f FormatterFunc) Format(w io.Writer, reports []Report) error {
+	return f(w, reports)
+}
+
+var (
+	formattersMu sync.RWMutex
+	formatters   = map[string]Formatter{
+		"sarif":    FormatterFunc(FormatSARIF),
+		"junit":    FormatterFunc(FormatJUnit),
+		"csv":      FormatterFunc(FormatCSV),
+		"markdown": FormatterFunc(FormatMarkdown),
+	}
+)
+
+// RegisterFormatter makes the formatter available under name, replacing any
+// formatter previously registered under the same name.
+func RegisterFormatter(name string, f Formatter) {
+	formattersMu.Lock()
+	defer formattersMu.Unlock()
+	formatters[name] = f
+}
+
+// GetFormatter returns the formatter registered under name. If no such
+// formatter exists then ok is false.
+func GetFormatter(name string) (f Formatter, ok bool) {
+	formattersMu.RLock()
+	defer formattersMu.RUnlock()
+	f, ok = formatters[name]
+	return f, ok
+}
+
+// FormatterNames returns the names of all registered formatters in sorted
+// order.
+func FormatterNames() []string {
+	formattersMu.RLock()
+	defer formattersMu.RUnlock()
+	names := make([]string, 0, len(formatters))
+	for name := range formatters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// sortedResults returns the results of a ResultSet sorted by lint name.
+func sortedResults(results *zlint.ResultSet) []*lint.LintResult {
+	sorted := make([]*lint.LintResult, 0, len(results.Results))
+	for name, res := range results.Results {
+		if res.LintMetadata.Name == "" {
+			named := *res
+			named.LintMetadata.Name = name
+			res = &named
+		}
+		sorted = append(sorted, res)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].LintMetadata.Name < sorted[j].LintMetadata.Name
+	})
+	return sorted
+}
+
+// isFinding returns true if the status of a result indicates that the input
+// did not conform to the lint.
+func isFinding(status lint.LintStatus) bool {
+	switch status {
+	case lint.Notice, lint.Warn, lint.Error, lint.Fatal:
+		return true
+	default:
+		return false
+	}
+}
+
+// findingMessage describes a result for a reader, using its details or, if it
+// has none, the description of its lint.
+func findingMessage(res *lint.LintResult) string {
+	if res.Details != "" {
+		return res.Details
+	}
+	return res.LintMetadata.Description
+}
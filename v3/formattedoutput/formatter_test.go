@@ -0,0 +1,216 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package formattedoutput
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/zmap/zlint/v3"
+	"github.com/zmap/zlint/v3/lint"
+)
+
+func testReports() []Report {
+	result := func(name string, status lint.LintStatus, details string) *lint.LintResult {
+		return &lint.LintResult{
+			Status:  status,
+			Details: details,
+			LintMetadata: lint.LintMetadata{
+				Name:        name,
+				Description: "description of " + name,
+				Citation:    "RFC 5280: 4.1",
+				Source:      lint.RFC5280,
+			},
+		}
+	}
+	return []Report{
+		{
+			Input: "a.pem",
+			Results: &zlint.ResultSet{Results: map[string]*lint.LintResult{
+				"e_error": result("e_error", lint.Error, "bad | value"),
+				"w_warn":  result("w_warn", lint.Warn, ""),
+				"n_pass":  result("n_pass", lint.Pass, ""),
+				"e_na":    result("e_na", lint.NA, ""),
+			}},
+		},
+		{
+			Input: "b.pem",
+			Results: &zlint.ResultSet{Results: map[string]*lint.LintResult{
+				"e_error": result("e_error", lint.Fatal, "lint panicked"),
+				"n_info":  result("n_info", lint.Notice, "noted"),
+			}},
+		},
+	}
+}
+
+func TestFormatSARIFFindings(t *testing.T) {
+	res := lint.NewResultFromFindings([]lint.Finding{
+		{Path: "extensions.subjectAltName[0].dNSName", Value: "*", Status: lint.Error},
+		{Path: "extensions.subjectAltName[1].dNSName", Value: ".example.com", Status: lint.Warn},
+	})
+	res.LintMetadata = lint.LintMetadata{Name: "e_names", Source: lint.RFC5280}
+	reports := []Report{{
+		Input:   "c.pem",
+		Results: &zlint.ResultSet{Results: map[string]*lint.LintResult{"e_names": res}},
+	}}
+	var buf bytes.Buffer
+	if err := FormatSARIF(&buf, reports); err != nil {
+		t.Fatal(err)
+	}
+	var log sarifLog
+	if err := json.Unmarshal(buf.Bytes(), &log); err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, r := range log.Runs[0].Results {
+		loc := r.Locations[0]
+		if len(loc.LogicalLocations) != 1 {
+			t.Fatalf("expected a logical location for each finding, got %+v", loc)
+		}
+		got = append(got, strings.Join([]string{loc.PhysicalLocation.ArtifactLocation.URI, loc.LogicalLocations[0].FullyQualifiedName, r.Level}, " "))
+	}
+	want := []string{
+		"c.pem extensions.subjectAltName[0].dNSName error",
+		"c.pem extensions.subjectAltName[1].dNSName warning",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected results %q, got %q", want, got)
+	}
+}
+
+func TestFormatSARIF(t *testing.T) {
+	var buf bytes.Buffer
+	if err := FormatSARIF(&buf, testReports()); err != nil {
+		t.Fatal(err)
+	}
+	var log sarifLog
+	if err := json.Unmarshal(buf.Bytes(), &log); err != nil {
+		t.Fatal(err)
+	}
+	if log.Version != "2.1.0" || len(log.Runs) != 1 {
+		t.Fatalf("expected a single SARIF 2.1.0 run, got version %s with %d runs", log.Version, len(log.Runs))
+	}
+	var rules []string
+	for _, rule := range log.Runs[0].Tool.Driver.Rules {
+		rules = append(rules, rule.ID)
+	}
+	if want := []string{"e_error", "n_info", "w_warn"}; !reflect.DeepEqual(rules, want) {
+		t.Errorf("expected rules %v, got %v", want, rules)
+	}
+	var got []string
+	for _, res := range log.Runs[0].Results {
+		uri := res.Locations[0].PhysicalLocation.ArtifactLocation.URI
+		got = append(got, strings.Join([]string{uri, res.RuleID, res.Level, res.Message.Text}, " "))
+		if rules[res.RuleIndex] != res.RuleID {
+			t.Errorf("expected the rule index of %s to refer to its rule", res.RuleID)
+		}
+	}
+	want := []string{
+		"a.pem e_error error bad | value",
+		"a.pem w_warn warning description of w_warn",
+		"b.pem e_error error lint panicked",
+		"b.pem n_info note noted",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected results %q, got %q", want, got)
+	}
+}
+
+func TestFormatJUnit(t *testing.T) {
+	var buf bytes.Buffer
+	if err := FormatJUnit(&buf, testReports()); err != nil {
+		t.Fatal(err)
+	}
+	var suites junitTestSuites
+	if err := xml.Unmarshal(buf.Bytes(), &suites); err != nil {
+		t.Fatal(err)
+	}
+	if suites.Tests != 6 || suites.Failures != 2 || suites.Errors != 1 || suites.Skipped != 1 {
+		t.Errorf("expected 6 tests, 2 failures, 1 error and 1 skipped, got %d, %d, %d and %d",
+			suites.Tests, suites.Failures, suites.Errors, suites.Skipped)
+	}
+	if len(suites.Suites) != 2 || suites.Suites[0].Name != "a.pem" || len(suites.Suites[0].TestCases) != 4 {
+		t.Fatalf("expected a test suite of 4 test cases for a.pem, got %+v", suites.Suites)
+	}
+	if tc := suites.Suites[0].TestCases[0]; tc.Name != "e_error" || tc.Failure == nil || tc.Failure.Message != "bad | value" {
+		t.Errorf("expected e_error to fail with its details, got %+v", tc)
+	}
+}
+
+func TestFormatCSV(t *testing.T) {
+	var buf bytes.Buffer
+	if err := FormatCSV(&buf, testReports()); err != nil {
+		t.Fatal(err)
+	}
+	rows, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 7 || !reflect.DeepEqual(rows[0], csvHeader) {
+		t.Fatalf("expected a header and 6 rows, got %v", rows)
+	}
+	if want := []string{"a.pem", "e_error", "error", "bad | value", "RFC5280", "RFC 5280: 4.1"}; !reflect.DeepEqual(rows[1], want) {
+		t.Errorf("expected %v, got %v", want, rows[1])
+	}
+}
+
+func TestFormatMarkdown(t *testing.T) {
+	var buf bytes.Buffer
+	if err := FormatMarkdown(&buf, testReports()); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		"## a.pem",
+		"- **error**: 1",
+		"| error | `e_error` | bad \\| value | RFC 5280: 4.1 |",
+		"| warn | `w_warn` | description of w_warn | RFC 5280: 4.1 |",
+		"## b.pem",
+		"- **fatal**: 1",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected the report to contain %q, got:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "n_pass") || strings.Contains(out, "e_na") {
+		t.Errorf("expected the report to contain only findings, got:\n%s", out)
+	}
+}
+
+func TestRegisterFormatter(t *testing.T) {
+	for _, name := range []string{"sarif", "junit", "csv", "markdown"} {
+		if _, ok := GetFormatter(name); !ok {
+			t.Errorf("expected the %s formatter to be registered", name)
+		}
+	}
+	RegisterFormatter("test_count", FormatterFunc(func(w io.Writer, reports []Report) error {
+		_, err := io.WriteString(w, strings.Repeat("x", len(reports)))
+		return err
+	}))
+	f, ok := GetFormatter("test_count")
+	if !ok {
+		t.Fatal("expected the test_count formatter to be registered")
+	}
+	var buf bytes.Buffer
+	if err := f.Format(&buf, testReports()); err != nil || buf.String() != "xx" {
+		t.Errorf("expected the registered formatter to be used, got %q (%v)", buf.String(), err)
+	}
+}
@@ -0,0 +1,105 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package formattedoutput
+
+import (
+	"encoding/xml"
+	"io"
+
+	"github.com/zmap/zlint/v3/lint"
+)
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Errors   int              `xml:"errors,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Errors    int             `xml:"errors,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Error     *junitMessage `xml:"error,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr,omitempty"`
+	Type    string `xml:"type,attr,omitempty"`
+}
+
+// FormatJUnit writes the reports as JUnit XML, with a test suite for each
+// input and a test case for each lint that was run against it. Warnings and
+// errors are failures, fatal results are errors, and lints that did not apply
+// or were not run are skipped. Notices pass, with their details written to
+// the standard output of the test case.
+func FormatJUnit(w io.Writer, reports []Report) error {
+	suites := junitTestSuites{Name: "zlint", Suites: []junitTestSuite{}}
+	for _, report := range reports {
+		suite := junitTestSuite{Name: report.Input, TestCases: []junitTestCase{}}
+		for _, res := range sortedResults(report.Results) {
+			tc := junitTestCase{
+				Name:      res.LintMetadata.Name,
+				ClassName: string(res.LintMetadata.Source),
+			}
+			message := &junitMessage{Message: findingMessage(res), Type: res.Status.String()}
+			switch res.Status {
+			case lint.Pass:
+			case lint.Notice:
+				tc.SystemOut = findingMessage(res)
+			case lint.Warn, lint.Error:
+				tc.Failure = message
+				suite.Failures++
+			case lint.Fatal:
+				tc.Error = message
+				suite.Errors++
+			default:
+				tc.Skipped = message
+				suite.Skipped++
+			}
+			suite.TestCases = append(suite.TestCases, tc)
+		}
+		suite.Tests = len(suite.TestCases)
+		suites.Tests += suite.Tests
+		suites.Failures += suite.Failures
+		suites.Errors += suite.Errors
+		suites.Skipped += suite.Skipped
+		suites.Suites = append(suites.Suites, suite)
+	}
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(suites); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
@@ -0,0 +1,71 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package formattedoutput
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/zmap/zlint/v3/lint"
+)
+
+// markdownStatuses are the statuses counted within each section of the
+// Markdown report, in order of increasing severity.
+var markdownStatuses = []lint.LintStatus{lint.Notice, lint.Warn, lint.Error, lint.Fatal}
+
+// FormatMarkdown writes the reports as a Markdown document with a section for
+// each input. Each section counts the findings of each severity and lists the
+// findings in a table.
+func FormatMarkdown(w io.Writer, reports []Report) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "# ZLint Report\n")
+	for _, report := range reports {
+		fmt.Fprintf(bw, "\n## %s\n\n", markdownEscape(report.Input))
+		counts := make(map[lint.LintStatus]int)
+		var findings []*lint.LintResult
+		for _, res := range sortedResults(report.Results) {
+			if isFinding(res.Status) {
+				counts[res.Status]++
+				findings = append(findings, res)
+			}
+		}
+		for _, status := range markdownStatuses {
+			fmt.Fprintf(bw, "- **%s**: %d\n", status, counts[status])
+		}
+		if len(findings) == 0 {
+			fmt.Fprintf(bw, "\nNo findings.\n")
+			continue
+		}
+		fmt.Fprintf(bw, "\n| Status | Lint | Finding | Citation |\n")
+		fmt.Fprintf(bw, "|--------|------|---------|----------|\n")
+		for _, res := range findings {
+			fmt.Fprintf(bw, "| %s | `%s` | %s | %s |\n",
+				res.Status,
+				res.LintMetadata.Name,
+				markdownEscape(findingMessage(res)),
+				markdownEscape(res.LintMetadata.Citation))
+		}
+	}
+	return bw.Flush()
+}
+
+// markdownEscape escapes s for use within a cell of a Markdown table.
+func markdownEscape(s string) string {
+	s = strings.ReplaceAll(s, "\\", "\\\\")
+	s = strings.ReplaceAll(s, "|", "\\|")
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -0,0 +1,185 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package formattedoutput
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+
+	"github.com/zmap/zlint/v3/lint"
+)
+
+const (
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion = "2.1.0"
+	zlintURI     = "https://github.com/zmap/zlint"
+)
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string          `json:"id"`
+	ShortDescription sarifMessage    `json:"shortDescription"`
+	Properties       sarifProperties `json:"properties"`
+}
+
+type sarifProperties struct {
+	Citation string          `json:"citation,omitempty"`
+	Source   lint.LintSource `json:"source,omitempty"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	RuleIndex int             `json:"ruleIndex"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation  `json:"physicalLocation"`
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations,omitempty"`
+}
+
+type sarifLogicalLocation struct {
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+// sarifLevel returns the SARIF level of a finding with the given status.
+func sarifLevel(status lint.LintStatus) string {
+	switch status {
+	case lint.Notice:
+		return "note"
+	case lint.Warn:
+		return "warning"
+	default:
+		return "error"
+	}
+}
+
+// FormatSARIF writes the findings of the reports as a SARIF 2.1.0 log with a
+// single run. Each lint with a finding is described as a rule using its
+// LintMetadata, and each finding is a result located at the input in which
+// it was found. A lint result carrying structured Findings produces a result
+// for each of them, additionally located at the path of the finding. Fatal
+// results are reported at the error level.
+func FormatSARIF(w io.Writer, reports []Report) error {
+	run := sarifRun{
+		Tool: sarifTool{Driver: sarifDriver{
+			Name:           "zlint",
+			InformationURI: zlintURI,
+			Rules:          []sarifRule{},
+		}},
+		Results: []sarifResult{},
+	}
+	rules := make(map[string]lint.LintMetadata)
+	for _, report := range reports {
+		for _, res := range sortedResults(report.Results) {
+			if isFinding(res.Status) {
+				rules[res.LintMetadata.Name] = res.LintMetadata
+			}
+		}
+	}
+	names := make([]string, 0, len(rules))
+	for name := range rules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	ruleIndex := make(map[string]int, len(names))
+	for i, name := range names {
+		meta := rules[name]
+		ruleIndex[name] = i
+		run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, sarifRule{
+			ID:               name,
+			ShortDescription: sarifMessage{Text: meta.Description},
+			Properties:       sarifProperties{Citation: meta.Citation, Source: meta.Source},
+		})
+	}
+	for _, report := range reports {
+		for _, res := range sortedResults(report.Results) {
+			if !isFinding(res.Status) {
+				continue
+			}
+			location := sarifLocation{
+				PhysicalLocation: sarifPhysicalLocation{
+					ArtifactLocation: sarifArtifactLocation{URI: report.Input},
+				},
+			}
+			if len(res.Findings) == 0 {
+				run.Results = append(run.Results, sarifResult{
+					RuleID:    res.LintMetadata.Name,
+					RuleIndex: ruleIndex[res.LintMetadata.Name],
+					Level:     sarifLevel(res.Status),
+					Message:   sarifMessage{Text: findingMessage(res)},
+					Locations: []sarifLocation{location},
+				})
+				continue
+			}
+			for _, f := range res.Findings {
+				if !isFinding(f.Status) {
+					continue
+				}
+				location := location
+				location.LogicalLocations = []sarifLogicalLocation{{FullyQualifiedName: f.Path}}
+				run.Results = append(run.Results, sarifResult{
+					RuleID:    res.LintMetadata.Name,
+					RuleIndex: ruleIndex[res.LintMetadata.Name],
+					Level:     sarifLevel(f.Status),
+					Message:   sarifMessage{Text: f.String()},
+					Locations: []sarifLocation{location},
+				})
+			}
+		}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs:    []sarifRun{run},
+	})
+}
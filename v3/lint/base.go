@@ -15,9 +15,11 @@ package lint
  */
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zcrypto/x509/revocation/ocsp"
 	"github.com/zmap/zlint/v3/util"
 )
 
@@ -39,6 +41,33 @@ type RevocationListLintInterface interface {
 	Execute(r *x509.RevocationList) *LintResult
 }
 
+// OCSPResponseLintInterface is implemented by each OCSP response linter.
+type OCSPResponseLintInterface interface {
+	// CheckApplies runs once per OCSP response. It returns true if the Lint
+	// should run on the given response. If CheckApplies returns false, the Lint
+	// result is automatically set to NA without calling CheckEffective() or
+	// Run().
+	CheckApplies(r *ocsp.Response) bool
+
+	// Execute is the body of the lint. It is called for every OCSP response
+	// for which CheckApplies returns true.
+	Execute(r *ocsp.Response) *LintResult
+}
+
+// CertificateRequestLintInterface is implemented by each PKCS#10 certificate
+// signing request linter.
+type CertificateRequestLintInterface interface {
+	// CheckApplies runs once per certificate request. It returns true if the
+	// Lint should run on the given request. If CheckApplies returns false, the
+	// Lint result is automatically set to NA without calling CheckEffective()
+	// or Run().
+	CheckApplies(r *x509.CertificateRequest) bool
+
+	// Execute is the body of the lint. It is called for every certificate
+	// request for which CheckApplies returns true.
+	Execute(r *x509.CertificateRequest) *LintResult
+}
+
 // CertificateLintInterface is implemented by each certificate linter.
 type CertificateLintInterface interface {
 	// CheckApplies runs once per certificate. It returns true if the Lint should
@@ -52,6 +81,41 @@ type CertificateLintInterface interface {
 	Execute(c *x509.Certificate) *LintResult
 }
 
+// ChainLintInterface is implemented by each certificate linter that requires
+// access to the certificate of the issuer in order to perform its checks.
+type ChainLintInterface interface {
+	// CheckApplies runs once per certificate. It returns true if the Lint should
+	// run on the given certificate and issuer pair. If CheckApplies returns
+	// false, the Lint result is automatically set to NA without calling
+	// CheckEffective() or Run().
+	CheckApplies(c *x509.Certificate, issuer *x509.Certificate) bool
+
+	// Execute is the body of the lint. It is called for every certificate for
+	// which CheckApplies returns true.
+	Execute(c *x509.Certificate, issuer *x509.Certificate) *LintResult
+}
+
+// PrecertificatePairLintInterface is implemented by each linter that compares
+// an RFC 6962 precertificate with the final certificate issued from it.
+//
+// The issuer is the certificate that signed the precertificate, or nil if it
+// is not known. It is either the CA that signed the final certificate or an
+// RFC 6962 Precertificate Signing Certificate (see
+// util.IsPrecertSigningCert), in which case the issuer and authority key
+// identifier of the precertificate differ from those of the final
+// certificate.
+type PrecertificatePairLintInterface interface {
+	// CheckApplies runs once per precertificate and certificate pair. It
+	// returns true if the Lint should run on the given pair. If CheckApplies
+	// returns false, the Lint result is automatically set to NA without calling
+	// CheckEffective() or Run().
+	CheckApplies(precert *x509.Certificate, cert *x509.Certificate, issuer *x509.Certificate) bool
+
+	// Execute is the body of the lint. It is called for every pair for which
+	// CheckApplies returns true.
+	Execute(precert *x509.Certificate, cert *x509.Certificate, issuer *x509.Certificate) *LintResult
+}
+
 // Configurable lints return a pointer into a struct that they wish to receive their configuration into.
 type Configurable interface {
 	Configure() interface{}
@@ -80,6 +144,18 @@ type LintMetadata struct {
 	// Programmatic source of the check, BRs, RFC5280, or ZLint
 	Source LintSource `json:"source"`
 
+	// Tags are free-form lowercase labels describing the subject of the check,
+	// e.g. "dns", "key", "encoding", "time", "smime", "sti" or "ev". The tag
+	// "expensive" marks lints that are far slower than the rest, such as those
+	// attempting to factor a key. Tags are used to select lints with
+	// FilterOptions.
+	Tags []string `json:"tags,omitempty"`
+
+	// Remediation is a short, actionable description of what to change in a
+	// certificate profile or issuance process to resolve a finding of this
+	// lint, e.g. "Mark the basicConstraints extension as critical.".
+	Remediation string `json:"remediation,omitempty"`
+
 	// Lints automatically returns NE for all certificates where CheckApplies() is
 	// true but with NotBefore < EffectiveDate. This check is bypassed if
 	// EffectiveDate is zero. Please see CheckEffective for more information.
@@ -89,6 +165,20 @@ type LintMetadata struct {
 	// true but with NotBefore >= IneffectiveDate. This check is bypassed if
 	// IneffectiveDate is zero. Please see CheckEffective for more information.
 	IneffectiveDate time.Time `json:"-"`
+
+	// Prerequisites lists the names of lints which check more basic properties
+	// that this lint relies upon. When linting, each prerequisite is executed
+	// before this lint, and if any of them returns Fatal or Skipped then this
+	// lint is not executed and instead returns Skipped. This avoids reporting
+	// a cascade of results that all stem from one root cause, such as an
+	// extension that can not be parsed.
+	//
+	// Prerequisites must be lints of the same kind as this lint, with the
+	// exception that ChainLints may also depend upon CertificateLints, which
+	// are always executed before them. Registration rejects a lint that would
+	// become its own prerequisite through lints of its own kind. Prerequisites
+	// that are not present in the registry being used to lint are ignored.
+	Prerequisites []string `json:"prerequisites,omitempty"`
 }
 
 // A Lint struct represents a single lint, e.g.
@@ -111,6 +201,12 @@ type Lint struct {
 	// Programmatic source of the check, BRs, RFC5280, or ZLint
 	Source LintSource `json:"source"`
 
+	// Free-form lowercase labels describing the subject of the check.
+	Tags []string `json:"tags,omitempty"`
+
+	// What to change to resolve a finding of the check.
+	Remediation string `json:"remediation,omitempty"`
+
 	// Lints automatically returns NE for all certificates where CheckApplies() is
 	// true but with NotBefore < EffectiveDate. This check is bypassed if
 	// EffectiveDate is zero. Please see CheckEffective for more information.
@@ -120,6 +216,11 @@ type Lint struct {
 	// true but with NotBefore >= IneffectiveDate. This check is bypassed if
 	// IneffectiveDate is zero. Please see CheckEffective for more information.
 	IneffectiveDate time.Time `json:"-"`
+
+	// The names of lints which must not have returned Fatal or Skipped for
+	// this lint to be executed.
+	Prerequisites []string `json:"prerequisites,omitempty"`
+
 	// A constructor which returns the implementation of the lint logic.
 	Lint func() LintInterface `json:"-"`
 }
@@ -134,8 +235,11 @@ func (l *Lint) toCertificateLint() *CertificateLint {
 			Description:     l.Description,
 			Citation:        l.Citation,
 			Source:          l.Source,
+			Tags:            l.Tags,
+			Remediation:     l.Remediation,
 			EffectiveDate:   l.EffectiveDate,
 			IneffectiveDate: l.IneffectiveDate,
+			Prerequisites:   l.Prerequisites,
 		},
 		Lint: l.Lint,
 	}
@@ -188,8 +292,11 @@ func (l *CertificateLint) toLint() *Lint {
 		Description:     l.Description,
 		Citation:        l.Citation,
 		Source:          l.Source,
+		Tags:            l.Tags,
+		Remediation:     l.Remediation,
 		EffectiveDate:   l.EffectiveDate,
 		IneffectiveDate: l.IneffectiveDate,
+		Prerequisites:   l.Prerequisites,
 		Lint:            l.Lint,
 	}
 }
@@ -217,7 +324,18 @@ func (l *CertificateLint) CheckEffective(c *x509.Certificate) bool {
 // CheckApplies()
 // CheckEffective()
 // Execute()
+//
+// If the lint panics then the panic is recovered and reported as a Fatal
+// LintResult, unless strict mode has been enabled with SetStrictPanics.
 func (l *CertificateLint) Execute(cert *x509.Certificate, config Configuration) *LintResult {
+	return l.ExecuteWithOptions(cert, config, ExecutionOptions{})
+}
+
+// ExecuteWithOptions is equivalent to Execute, except that the effective
+// window of the lint is evaluated as directed by opts rather than against the
+// NotBefore of cert.
+func (l *CertificateLint) ExecuteWithOptions(cert *x509.Certificate, config Configuration, opts ExecutionOptions) (res *LintResult) {
+	defer recoverLintPanic(&res)
 	if l.Source == CABFBaselineRequirements && !util.IsServerAuthCert(cert) {
 		return &LintResult{Status: NA}
 	}
@@ -233,10 +351,145 @@ func (l *CertificateLint) Execute(cert *x509.Certificate, config Configuration)
 	}
 	if !lint.CheckApplies(cert) {
 		return &LintResult{Status: NA}
-	} else if !l.CheckEffective(cert) {
-		return &LintResult{Status: NE}
 	}
-	return lint.Execute(cert)
+	return opts.execute(l.LintMetadata, cert.NotBefore, func() *LintResult {
+		return lint.Execute(cert)
+	})
+}
+
+// ChainLint represents a single x509 certificate linter that is provided with
+// the certificate of the issuer in addition to the certificate being linted.
+type ChainLint struct {
+	// Metadata associated with the linter.
+	LintMetadata
+	// A constructor which returns the implementation of the linter.
+	Lint func() ChainLintInterface `json:"-"`
+}
+
+// CheckEffective returns true if c was issued on or after the EffectiveDate
+// AND before (but not on) the Ineffective date. That is, CheckEffective
+// returns true if...
+//
+//	c.NotBefore in [EffectiveDate, IneffectiveDate)
+//
+// If EffectiveDate is zero, then only IneffectiveDate is checked. Conversely,
+// if IneffectiveDate is zero then only EffectiveDate is checked. If both EffectiveDate
+// and IneffectiveDate are zero then CheckEffective always returns true.
+func (l *ChainLint) CheckEffective(c *x509.Certificate) bool {
+	return checkEffective(l.EffectiveDate, l.IneffectiveDate, c.NotBefore)
+}
+
+// Execute runs the lint against a certificate and the certificate of its
+// issuer. If no issuer is available then the result is always NA. Otherwise
+// the ordering is the same as for CertificateLint.Execute:
+//
+// Configure() ----> only if the lint implements Configurable
+// CheckApplies()
+// CheckEffective()
+// Execute()
+//
+// If the lint panics then the panic is recovered and reported as a Fatal
+// LintResult, unless strict mode has been enabled with SetStrictPanics.
+func (l *ChainLint) Execute(cert *x509.Certificate, issuer *x509.Certificate, config Configuration) *LintResult {
+	return l.ExecuteWithOptions(cert, issuer, config, ExecutionOptions{})
+}
+
+// ExecuteWithOptions is equivalent to Execute, except that the effective
+// window of the lint is evaluated as directed by opts rather than against the
+// NotBefore of cert.
+func (l *ChainLint) ExecuteWithOptions(cert *x509.Certificate, issuer *x509.Certificate, config Configuration, opts ExecutionOptions) (res *LintResult) {
+	defer recoverLintPanic(&res)
+	if issuer == nil {
+		return &LintResult{Status: NA}
+	}
+	if l.Source == CABFBaselineRequirements && !util.IsServerAuthCert(cert) {
+		return &LintResult{Status: NA}
+	}
+	if l.Source == CABFSMIMEBaselineRequirements && !((util.IsEmailProtectionCert(cert) && util.HasEmailSAN(cert)) || util.IsSMIMEBRCertificate(cert)) {
+		return &LintResult{Status: NA}
+	}
+	lint := l.Lint()
+	err := config.MaybeConfigure(lint, l.Name)
+	if err != nil {
+		return &LintResult{
+			Status:  Fatal,
+			Details: err.Error()}
+	}
+	if !lint.CheckApplies(cert, issuer) {
+		return &LintResult{Status: NA}
+	}
+	return opts.execute(l.LintMetadata, cert.NotBefore, func() *LintResult {
+		return lint.Execute(cert, issuer)
+	})
+}
+
+// PrecertificatePairLint represents a single linter that compares an RFC 6962
+// precertificate with the final certificate issued from it.
+type PrecertificatePairLint struct {
+	// Metadata associated with the linter.
+	LintMetadata
+	// A constructor which returns the implementation of the linter.
+	Lint func() PrecertificatePairLintInterface `json:"-"`
+}
+
+// CheckEffective returns true if the final certificate c was issued on or
+// after the EffectiveDate AND before (but not on) the Ineffective date. That
+// is, CheckEffective returns true if...
+//
+//	c.NotBefore in [EffectiveDate, IneffectiveDate)
+//
+// If EffectiveDate is zero, then only IneffectiveDate is checked. Conversely,
+// if IneffectiveDate is zero then only EffectiveDate is checked. If both EffectiveDate
+// and IneffectiveDate are zero then CheckEffective always returns true.
+func (l *PrecertificatePairLint) CheckEffective(c *x509.Certificate) bool {
+	return checkEffective(l.EffectiveDate, l.IneffectiveDate, c.NotBefore)
+}
+
+// Execute runs the lint against a precertificate, the final certificate
+// issued from it and the issuer of the precertificate, which may be nil if it
+// is not known. If the precertificate or the certificate is missing then the
+// result is always NA.
+// Otherwise the ordering is the same as for CertificateLint.Execute, with the
+// final certificate deciding whether BR and S/MIME BR lints apply:
+//
+// Configure() ----> only if the lint implements Configurable
+// CheckApplies()
+// CheckEffective()
+// Execute()
+//
+// If the lint panics then the panic is recovered and reported as a Fatal
+// LintResult, unless strict mode has been enabled with SetStrictPanics.
+func (l *PrecertificatePairLint) Execute(precert *x509.Certificate, cert *x509.Certificate, issuer *x509.Certificate, config Configuration) *LintResult {
+	return l.ExecuteWithOptions(precert, cert, issuer, config, ExecutionOptions{})
+}
+
+// ExecuteWithOptions is equivalent to Execute, except that the effective
+// window of the lint is evaluated as directed by opts rather than against the
+// NotBefore of the final certificate.
+func (l *PrecertificatePairLint) ExecuteWithOptions(precert *x509.Certificate, cert *x509.Certificate, issuer *x509.Certificate, config Configuration, opts ExecutionOptions) (res *LintResult) {
+	defer recoverLintPanic(&res)
+	if precert == nil || cert == nil {
+		return &LintResult{Status: NA}
+	}
+	if l.Source == CABFBaselineRequirements && !util.IsServerAuthCert(cert) {
+		return &LintResult{Status: NA}
+	}
+	if l.Source == CABFSMIMEBaselineRequirements && !((util.IsEmailProtectionCert(cert) && util.HasEmailSAN(cert)) || util.IsSMIMEBRCertificate(cert)) {
+		return &LintResult{Status: NA}
+	}
+	lint := l.Lint()
+	err := config.MaybeConfigure(lint, l.Name)
+	if err != nil {
+		return &LintResult{
+			Status:  Fatal,
+			Details: err.Error()}
+	}
+	if !lint.CheckApplies(precert, cert, issuer) {
+		return &LintResult{Status: NA}
+	}
+	return opts.execute(l.LintMetadata, cert.NotBefore, func() *LintResult {
+		return lint.Execute(precert, cert, issuer)
+	})
 }
 
 // RevocationListLint represents a single x509 CRL linter.
@@ -267,7 +520,18 @@ func (l *RevocationListLint) CheckEffective(r *x509.RevocationList) bool {
 // CheckApplies()
 // CheckEffective()
 // Execute()
+//
+// If the lint panics then the panic is recovered and reported as a Fatal
+// LintResult, unless strict mode has been enabled with SetStrictPanics.
 func (l *RevocationListLint) Execute(r *x509.RevocationList, config Configuration) *LintResult {
+	return l.ExecuteWithOptions(r, config, ExecutionOptions{})
+}
+
+// ExecuteWithOptions is equivalent to Execute, except that the effective
+// window of the lint is evaluated as directed by opts rather than against the
+// ThisUpdate of r.
+func (l *RevocationListLint) ExecuteWithOptions(r *x509.RevocationList, config Configuration, opts ExecutionOptions) (res *LintResult) {
+	defer recoverLintPanic(&res)
 	lint := l.Lint()
 	err := config.MaybeConfigure(lint, l.Name)
 	if err != nil {
@@ -277,10 +541,122 @@ func (l *RevocationListLint) Execute(r *x509.RevocationList, config Configuratio
 	}
 	if !lint.CheckApplies(r) {
 		return &LintResult{Status: NA}
-	} else if !l.CheckEffective(r) {
-		return &LintResult{Status: NE}
 	}
-	return lint.Execute(r)
+	return opts.execute(l.LintMetadata, r.ThisUpdate, func() *LintResult {
+		return lint.Execute(r)
+	})
+}
+
+// OCSPResponseLint represents a single OCSP response linter.
+type OCSPResponseLint struct {
+	// Metadata associated with the linter.
+	LintMetadata
+	// A constructor which returns the implementation of the linter.
+	Lint func() OCSPResponseLintInterface `json:"-"`
+}
+
+// CheckEffective returns true if r was produced on or after the EffectiveDate
+// AND before (but not on) the Ineffective date. That is, CheckEffective
+// returns true if...
+//
+//	r.ProducedAt in [EffectiveDate, IneffectiveDate)
+//
+// If EffectiveDate is zero, then only IneffectiveDate is checked. Conversely,
+// if IneffectiveDate is zero then only EffectiveDate is checked. If both EffectiveDate
+// and IneffectiveDate are zero then CheckEffective always returns true.
+func (l *OCSPResponseLint) CheckEffective(r *ocsp.Response) bool {
+	return checkEffective(l.EffectiveDate, l.IneffectiveDate, r.ProducedAt)
+}
+
+// Execute runs the lint against an OCSP response.
+// The ordering is as follows:
+//
+// Configure() ----> only if the lint implements Configurable
+// CheckApplies()
+// CheckEffective()
+// Execute()
+//
+// If the lint panics then the panic is recovered and reported as a Fatal
+// LintResult, unless strict mode has been enabled with SetStrictPanics.
+func (l *OCSPResponseLint) Execute(r *ocsp.Response, config Configuration) *LintResult {
+	return l.ExecuteWithOptions(r, config, ExecutionOptions{})
+}
+
+// ExecuteWithOptions is equivalent to Execute, except that the effective
+// window of the lint is evaluated as directed by opts rather than against the
+// ProducedAt of r.
+func (l *OCSPResponseLint) ExecuteWithOptions(r *ocsp.Response, config Configuration, opts ExecutionOptions) (res *LintResult) {
+	defer recoverLintPanic(&res)
+	lint := l.Lint()
+	err := config.MaybeConfigure(lint, l.Name)
+	if err != nil {
+		return &LintResult{
+			Status:  Fatal,
+			Details: err.Error()}
+	}
+	if !lint.CheckApplies(r) {
+		return &LintResult{Status: NA}
+	}
+	return opts.execute(l.LintMetadata, r.ProducedAt, func() *LintResult {
+		return lint.Execute(r)
+	})
+}
+
+// CertificateRequestLint represents a single PKCS#10 certificate signing
+// request linter.
+type CertificateRequestLint struct {
+	// Metadata associated with the linter.
+	LintMetadata
+	// A constructor which returns the implementation of the linter.
+	Lint func() CertificateRequestLintInterface `json:"-"`
+}
+
+// CheckEffective returns true if the current time is on or after the
+// EffectiveDate AND before (but not on) the Ineffective date. A certificate
+// request carries no date of its own, so it is linted as if the certificate
+// it asks for were issued now. That is, CheckEffective returns true if...
+//
+//	time.Now() in [EffectiveDate, IneffectiveDate)
+//
+// If EffectiveDate is zero, then only IneffectiveDate is checked. Conversely,
+// if IneffectiveDate is zero then only EffectiveDate is checked. If both EffectiveDate
+// and IneffectiveDate are zero then CheckEffective always returns true.
+func (l *CertificateRequestLint) CheckEffective(r *x509.CertificateRequest) bool {
+	return checkEffective(l.EffectiveDate, l.IneffectiveDate, time.Now())
+}
+
+// Execute runs the lint against a certificate request.
+// The ordering is as follows:
+//
+// Configure() ----> only if the lint implements Configurable
+// CheckApplies()
+// CheckEffective()
+// Execute()
+//
+// If the lint panics then the panic is recovered and reported as a Fatal
+// LintResult, unless strict mode has been enabled with SetStrictPanics.
+func (l *CertificateRequestLint) Execute(r *x509.CertificateRequest, config Configuration) *LintResult {
+	return l.ExecuteWithOptions(r, config, ExecutionOptions{})
+}
+
+// ExecuteWithOptions is equivalent to Execute, except that the effective
+// window of the lint is evaluated as directed by opts rather than against the
+// current time.
+func (l *CertificateRequestLint) ExecuteWithOptions(r *x509.CertificateRequest, config Configuration, opts ExecutionOptions) (res *LintResult) {
+	defer recoverLintPanic(&res)
+	lint := l.Lint()
+	err := config.MaybeConfigure(lint, l.Name)
+	if err != nil {
+		return &LintResult{
+			Status:  Fatal,
+			Details: err.Error()}
+	}
+	if !lint.CheckApplies(r) {
+		return &LintResult{Status: NA}
+	}
+	return opts.execute(l.LintMetadata, time.Now(), func() *LintResult {
+		return lint.Execute(r)
+	})
 }
 
 // checkEffective returns true if target was generated on or after the EffectiveDate
@@ -297,3 +673,49 @@ func checkEffective(effective, ineffective, target time.Time) bool {
 	strictlyBeforeIneffective := ineffective.IsZero() || target.Before(ineffective)
 	return onOrAfterEffective && strictlyBeforeIneffective
 }
+
+// ExecutionOptions control how the effective window of a lint is evaluated
+// when it is executed with ExecuteWithOptions.
+type ExecutionOptions struct {
+	// AsOf, if not zero, is the date against which the EffectiveDate and
+	// IneffectiveDate of a lint are compared, instead of the date taken from
+	// the object being linted (e.g. the NotBefore of a certificate). This
+	// allows asking which lints an object would fail were it issued on a
+	// different date, such as once a future requirement takes effect.
+	AsOf time.Time
+
+	// Preview causes lints which are not yet effective to be executed anyway.
+	// Should such a lint return Warn, Error or Fatal then the result is
+	// reported as Upcoming, with Details describing the original status and
+	// the date from which the lint is effective, and with any Findings keeping
+	// the statuses they will have once effective. Any other result is reported
+	// as NE, as it would have been without Preview. Lints that are no longer
+	// effective are never previewed.
+	Preview bool
+}
+
+// execute calls run if the lint described by meta is effective on the date
+// selected by o, where issued is the date taken from the object being linted.
+func (o ExecutionOptions) execute(meta LintMetadata, issued time.Time, run func() *LintResult) *LintResult {
+	date := issued
+	if !o.AsOf.IsZero() {
+		date = o.AsOf
+	}
+	if checkEffective(meta.EffectiveDate, meta.IneffectiveDate, date) {
+		return run()
+	}
+	if !o.Preview || !date.Before(meta.EffectiveDate) {
+		return &LintResult{Status: NE}
+	}
+	res := run()
+	switch res.Status {
+	case Warn, Error, Fatal:
+		details := fmt.Sprintf("%s once effective on %s", res.Status, meta.EffectiveDate.Format("2006-01-02"))
+		if res.Details != "" {
+			details += ": " + res.Details
+		}
+		return &LintResult{Status: Upcoming, Details: details, Findings: res.Findings}
+	default:
+		return &LintResult{Status: NE}
+	}
+}
@@ -15,6 +15,7 @@ package lint
  */
 
 import (
+	"reflect"
 	"testing"
 	"time"
 
@@ -312,3 +313,166 @@ func TestLint_RevocationListLint_CheckEffective(t *testing.T) {
 		}
 	}
 }
+
+type staticCertificateLint struct {
+	result *LintResult
+}
+
+func (l *staticCertificateLint) CheckApplies(c *x509.Certificate) bool {
+	return true
+}
+
+func (l *staticCertificateLint) Execute(c *x509.Certificate) *LintResult {
+	return l.result
+}
+
+type staticRevocationListLint struct {
+	result *LintResult
+}
+
+func (l *staticRevocationListLint) CheckApplies(r *x509.RevocationList) bool {
+	return true
+}
+
+func (l *staticRevocationListLint) Execute(r *x509.RevocationList) *LintResult {
+	return l.result
+}
+
+func TestExecuteWithOptions(t *testing.T) {
+	one := time.Date(2001, 1, 1, 0, 0, 0, 0, time.UTC)
+	two := time.Date(2002, 1, 1, 0, 0, 0, 0, time.UTC)
+	three := time.Date(2003, 1, 1, 0, 0, 0, 0, time.UTC)
+	four := time.Date(2004, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	failing := &LintResult{Status: Error, Details: "something is wrong"}
+	passing := &LintResult{Status: Pass}
+
+	testCases := []struct {
+		name        string
+		result      *LintResult
+		effective   time.Time
+		ineffective time.Time
+		date        time.Time
+		opts        ExecutionOptions
+		want        LintResult
+	}{
+		{
+			name:      "not yet effective",
+			result:    failing,
+			effective: two,
+			date:      one,
+			want:      LintResult{Status: NE},
+		},
+		{
+			name:      "effective as of a later date",
+			result:    failing,
+			effective: two,
+			date:      one,
+			opts:      ExecutionOptions{AsOf: three},
+			want:      *failing,
+		},
+		{
+			name:      "not effective as of an earlier date",
+			result:    failing,
+			effective: two,
+			date:      three,
+			opts:      ExecutionOptions{AsOf: one},
+			want:      LintResult{Status: NE},
+		},
+		{
+			name:        "no longer effective as of a later date",
+			result:      failing,
+			effective:   two,
+			ineffective: three,
+			date:        two,
+			opts:        ExecutionOptions{AsOf: four},
+			want:        LintResult{Status: NE},
+		},
+		{
+			name:      "preview of an upcoming failure",
+			result:    failing,
+			effective: two,
+			date:      one,
+			opts:      ExecutionOptions{Preview: true},
+			want:      LintResult{Status: Upcoming, Details: "error once effective on 2002-01-01: something is wrong"},
+		},
+		{
+			name:      "preview of an upcoming pass",
+			result:    passing,
+			effective: two,
+			date:      one,
+			opts:      ExecutionOptions{Preview: true},
+			want:      LintResult{Status: NE},
+		},
+		{
+			name:      "preview of an effective lint",
+			result:    failing,
+			effective: two,
+			date:      three,
+			opts:      ExecutionOptions{Preview: true},
+			want:      *failing,
+		},
+		{
+			name:        "preview of an ineffective lint",
+			result:      failing,
+			effective:   one,
+			ineffective: two,
+			date:        three,
+			opts:        ExecutionOptions{Preview: true},
+			want:        LintResult{Status: NE},
+		},
+		{
+			name:      "preview as of an earlier date",
+			result:    failing,
+			effective: two,
+			date:      three,
+			opts:      ExecutionOptions{AsOf: one, Preview: true},
+			want:      LintResult{Status: Upcoming, Details: "error once effective on 2002-01-01: something is wrong"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			meta := LintMetadata{
+				Name:            "e_static",
+				EffectiveDate:   tc.effective,
+				IneffectiveDate: tc.ineffective,
+			}
+			certLint := &CertificateLint{
+				LintMetadata: meta,
+				Lint:         func() CertificateLintInterface { return &staticCertificateLint{tc.result} },
+			}
+			got := certLint.ExecuteWithOptions(&x509.Certificate{NotBefore: tc.date}, NewEmptyConfig(), tc.opts)
+			if got.Status != tc.want.Status || got.Details != tc.want.Details {
+				t.Errorf("certificate lint: expected %v, got %v", tc.want, *got)
+			}
+			crlLint := &RevocationListLint{
+				LintMetadata: meta,
+				Lint:         func() RevocationListLintInterface { return &staticRevocationListLint{tc.result} },
+			}
+			got = crlLint.ExecuteWithOptions(&x509.RevocationList{ThisUpdate: tc.date}, NewEmptyConfig(), tc.opts)
+			if got.Status != tc.want.Status || got.Details != tc.want.Details {
+				t.Errorf("revocation list lint: expected %v, got %v", tc.want, *got)
+			}
+		})
+	}
+}
+
+func TestLintConversionKeepsMetadata(t *testing.T) {
+	meta := LintMetadata{
+		Name:            "e_mock",
+		Description:     "description",
+		Citation:        "citation",
+		Source:          Community,
+		Tags:            []string{"key"},
+		Remediation:     "remediation",
+		EffectiveDate:   time.Unix(1, 0),
+		IneffectiveDate: time.Unix(2, 0),
+		Prerequisites:   []string{"e_other"},
+	}
+	l := (&CertificateLint{LintMetadata: meta, Lint: func() CertificateLintInterface { return &mockLint{} }}).toLint()
+	if got := l.toCertificateLint().LintMetadata; !reflect.DeepEqual(got, meta) {
+		t.Errorf("expected %+v, got %+v", meta, got)
+	}
+}
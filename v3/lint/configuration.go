@@ -27,8 +27,12 @@ import (
 
 // Configuration is a ZLint configuration which serves as a target
 // to hold the full TOML tree that is a physical ZLint configuration./
+//
+// The underlying TOML tree is never modified after construction, so a
+// Configuration is safe for concurrent use by multiple goroutines.
 type Configuration struct {
-	tree *toml.Tree
+	tree    *toml.Tree
+	waivers []Waiver
 }
 
 // MaybeConfigure is a thin wrapper over Configure.
@@ -91,13 +95,24 @@ func (c Configuration) Configure(lint interface{}, namespace string) error {
 // NewConfig attempts to instantiate a configuration by consuming the contents of the provided reader.
 //
 // The contents of the provided reader MUST be in a valid TOML format. The caller of this function
-// is responsible for closing the reader, if appropriate.
+// is responsible for closing the reader, if appropriate. An error is also returned if any of the
+// waivers declared within the configuration are invalid.
 func NewConfig(r io.Reader) (Configuration, error) {
 	tree, err := toml.LoadReader(r)
 	if err != nil {
 		return Configuration{}, err
 	}
-	return Configuration{tree}, nil
+	waivers, err := parseWaivers(tree)
+	if err != nil {
+		return Configuration{}, err
+	}
+	return Configuration{tree, waivers}, nil
+}
+
+// Waivers returns the waivers declared within the configuration, in the order
+// in which they were declared. See Waiver for details.
+func (c Configuration) Waivers() []Waiver {
+	return c.waivers
 }
 
 // NewConfigFromFile attempts to instantiate a configuration from the provided filesystem path.
@@ -129,8 +144,10 @@ func NewConfigFromString(config string) (Configuration, error) {
 // any attempt to resolve a namespace in `deserializeConfigInto` fails and thus results
 // in all defaults for all lints being maintained.
 func NewEmptyConfig() Configuration {
-	cfg, _ := NewConfigFromString("")
-	return cfg
+	// The tree is loaded directly, rather than through NewConfig, as an empty
+	// configuration declares no waivers to validate against the registry.
+	tree, _ := toml.Load("")
+	return Configuration{tree: tree}
 }
 
 // deserializeConfigInto deserializes the section labeled by the provided `namespace`
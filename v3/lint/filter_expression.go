@@ -0,0 +1,410 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package lint
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Lint types as named by the "type" field of a FilterExpression.
+const (
+	certificateLintType        = "certificate"
+	revocationListLintType     = "crl"
+	chainLintType              = "chain"
+	ocspResponseLintType       = "ocsp"
+	certificateRequestLintType = "csr"
+	precertificatePairLintType = "precert"
+)
+
+// neverIneffective stands in for the zero IneffectiveDate of a lint, which
+// means that the lint never becomes ineffective, when comparing dates.
+var neverIneffective = time.Date(9999, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+// A FilterExpression is a boolean expression over the metadata of a lint,
+// used by Registry.Filter to select lints. It is created with
+// ParseFilterExpression from a string such as:
+//
+//	tag == dns && source != Community
+//	type == crl || (name =~ "^e_ext_" && effective >= 2020-09-30)
+//
+// Comparisons may be combined with && (and), || (or), ! (not) and
+// parentheses, where && binds more tightly than ||. The fields that may be
+// compared are:
+//
+//	name         the lint name, compared with ==, !=, =~ or !~ (regexp)
+//	source       the LintSource, compared with == or !=
+//	tag          compared with == if the lint has the tag, or != if not
+//	type         the kind of object linted: certificate, crl, chain, ocsp,
+//	             csr or precert, compared with == or !=
+//	effective    the EffectiveDate, compared with ==, !=, <, <=, > or >=
+//	             against a date of the form YYYY-MM-DD
+//	ineffective  the IneffectiveDate, compared as effective is
+//
+// Values containing spaces or operator characters must be double quoted. A
+// lint without an EffectiveDate is effective from the earliest possible date,
+// and a lint without an IneffectiveDate never becomes ineffective.
+type FilterExpression struct {
+	source string
+	root   expressionNode
+}
+
+// ParseFilterExpression parses the filter expression s, as described by
+// FilterExpression.
+func ParseFilterExpression(s string) (*FilterExpression, error) {
+	tokens, err := tokenizeExpression(s)
+	if err != nil {
+		return nil, err
+	}
+	p := &expressionParser{tokens: tokens}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if !p.done() {
+		return nil, fmt.Errorf("unexpected %q in filter expression", p.peek().text)
+	}
+	return &FilterExpression{source: s, root: root}, nil
+}
+
+// String returns the filter expression as it was parsed.
+func (e *FilterExpression) String() string {
+	return e.source
+}
+
+// matches returns true if the lint with the given metadata, which lints
+// objects of the given type, satisfies the expression.
+func (e *FilterExpression) matches(meta LintMetadata, lintType string) bool {
+	return e.root.eval(meta, lintType)
+}
+
+type expressionNode interface {
+	eval(meta LintMetadata, lintType string) bool
+}
+
+type andNode struct{ left, right expressionNode }
+
+func (n andNode) eval(meta LintMetadata, lintType string) bool {
+	return n.left.eval(meta, lintType) && n.right.eval(meta, lintType)
+}
+
+type orNode struct{ left, right expressionNode }
+
+func (n orNode) eval(meta LintMetadata, lintType string) bool {
+	return n.left.eval(meta, lintType) || n.right.eval(meta, lintType)
+}
+
+type notNode struct{ operand expressionNode }
+
+func (n notNode) eval(meta LintMetadata, lintType string) bool {
+	return !n.operand.eval(meta, lintType)
+}
+
+// comparisonNode compares a single field of a lint against a value.
+type comparisonNode struct {
+	field string
+	op    string
+	value string
+	re    *regexp.Regexp
+	date  time.Time
+}
+
+func (n comparisonNode) eval(meta LintMetadata, lintType string) bool {
+	switch n.field {
+	case "name":
+		switch n.op {
+		case "=~":
+			return n.re.MatchString(meta.Name)
+		case "!~":
+			return !n.re.MatchString(meta.Name)
+		}
+		return compareEquality(n.op, meta.Name == n.value)
+	case "source":
+		return compareEquality(n.op, string(meta.Source) == n.value)
+	case "type":
+		return compareEquality(n.op, lintType == n.value)
+	case "tag":
+		hasTag := false
+		for _, tag := range meta.Tags {
+			if tag == n.value {
+				hasTag = true
+				break
+			}
+		}
+		return compareEquality(n.op, hasTag)
+	case "effective":
+		return compareDates(n.op, meta.EffectiveDate, n.date)
+	case "ineffective":
+		date := meta.IneffectiveDate
+		if date.IsZero() {
+			date = neverIneffective
+		}
+		return compareDates(n.op, date, n.date)
+	}
+	return false
+}
+
+func compareEquality(op string, equal bool) bool {
+	if op == "!=" {
+		return !equal
+	}
+	return equal
+}
+
+func compareDates(op string, a, b time.Time) bool {
+	switch op {
+	case "==":
+		return a.Equal(b)
+	case "!=":
+		return !a.Equal(b)
+	case "<":
+		return a.Before(b)
+	case "<=":
+		return !a.After(b)
+	case ">":
+		return a.After(b)
+	case ">=":
+		return !a.Before(b)
+	}
+	return false
+}
+
+// fieldOperators lists the comparison operators permitted for each field.
+var fieldOperators = map[string][]string{
+	"name":        {"==", "!=", "=~", "!~"},
+	"source":      {"==", "!="},
+	"tag":         {"==", "!="},
+	"type":        {"==", "!="},
+	"effective":   {"==", "!=", "<", "<=", ">", ">="},
+	"ineffective": {"==", "!=", "<", "<=", ">", ">="},
+}
+
+type tokenKind int
+
+const (
+	wordToken tokenKind = iota
+	// stringToken is a word that was double quoted.
+	stringToken
+	operatorToken
+	punctuationToken
+)
+
+type expressionToken struct {
+	kind tokenKind
+	text string
+}
+
+// isWord returns true if the token is a word, whether or not it was quoted.
+func (t expressionToken) isWord() bool {
+	return t.kind == wordToken || t.kind == stringToken
+}
+
+// tokenizeExpression splits a filter expression into words, which may be
+// double quoted, comparison operators and the punctuation (, ), !, && and ||.
+func tokenizeExpression(s string) ([]expressionToken, error) {
+	return tokenize(s, "filter expression", "()!")
+}
+
+// tokenize splits s into words, which may be double quoted, comparison
+// operators, && and || and the single characters of punctuation. The kind of
+// expression being tokenized is named within errors.
+func tokenize(s, kind, punctuation string) ([]expressionToken, error) {
+	var tokens []expressionToken
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case unicode.IsSpace(rune(c)):
+			i++
+		case c == '"':
+			end := i + 1
+			for end < len(s) && s[end] != '"' {
+				if s[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(s) {
+				return nil, fmt.Errorf("unterminated string in %s: %s", kind, s[i:])
+			}
+			word, err := strconv.Unquote(s[i : end+1])
+			if err != nil {
+				return nil, fmt.Errorf("bad string in %s: %s", kind, s[i:end+1])
+			}
+			tokens = append(tokens, expressionToken{stringToken, word})
+			i = end + 1
+		case strings.HasPrefix(s[i:], "&&"), strings.HasPrefix(s[i:], "||"):
+			tokens = append(tokens, expressionToken{punctuationToken, s[i : i+2]})
+			i += 2
+		case strings.HasPrefix(s[i:], "=="), strings.HasPrefix(s[i:], "!="),
+			strings.HasPrefix(s[i:], "=~"), strings.HasPrefix(s[i:], "!~"),
+			strings.HasPrefix(s[i:], "<="), strings.HasPrefix(s[i:], ">="):
+			tokens = append(tokens, expressionToken{operatorToken, s[i : i+2]})
+			i += 2
+		case c == '<' || c == '>':
+			tokens = append(tokens, expressionToken{operatorToken, s[i : i+1]})
+			i++
+		case strings.IndexByte(punctuation, c) >= 0:
+			tokens = append(tokens, expressionToken{punctuationToken, s[i : i+1]})
+			i++
+		default:
+			end := i
+			for end < len(s) && !unicode.IsSpace(rune(s[end])) && !strings.ContainsRune(punctuation+`&|=<>~"`, rune(s[end])) {
+				end++
+			}
+			if end == i {
+				return nil, fmt.Errorf("unexpected %q in %s", s[i:i+1], kind)
+			}
+			tokens = append(tokens, expressionToken{wordToken, s[i:end]})
+			i = end
+		}
+	}
+	return tokens, nil
+}
+
+type expressionParser struct {
+	tokens []expressionToken
+	pos    int
+}
+
+func (p *expressionParser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *expressionParser) peek() expressionToken {
+	if p.done() {
+		return expressionToken{}
+	}
+	return p.tokens[p.pos]
+}
+
+// accept consumes the next token if it is the given punctuation.
+func (p *expressionParser) accept(punctuation string) bool {
+	if t := p.peek(); t.kind == punctuationToken && t.text == punctuation {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *expressionParser) parseOr() (expressionNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.accept("||") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orNode{left, right}
+	}
+	return left, nil
+}
+
+func (p *expressionParser) parseAnd() (expressionNode, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.accept("&&") {
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = andNode{left, right}
+	}
+	return left, nil
+}
+
+func (p *expressionParser) parseUnary() (expressionNode, error) {
+	if p.accept("!") {
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notNode{operand}, nil
+	}
+	if p.accept("(") {
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if !p.accept(")") {
+			return nil, fmt.Errorf("missing ) in filter expression")
+		}
+		return inner, nil
+	}
+	return p.parseComparison()
+}
+
+func (p *expressionParser) parseComparison() (expressionNode, error) {
+	if p.done() {
+		return nil, fmt.Errorf("unexpected end of filter expression")
+	}
+	field := p.tokens[p.pos]
+	if !field.isWord() {
+		return nil, fmt.Errorf("unexpected %q in filter expression", field.text)
+	}
+	operators, ok := fieldOperators[field.text]
+	if !ok {
+		return nil, fmt.Errorf("unknown field %q in filter expression", field.text)
+	}
+	if p.pos+2 >= len(p.tokens) {
+		return nil, fmt.Errorf("incomplete comparison of %s in filter expression", field.text)
+	}
+	op, value := p.tokens[p.pos+1], p.tokens[p.pos+2]
+	if op.kind != operatorToken || !value.isWord() {
+		return nil, fmt.Errorf("incomplete comparison of %s in filter expression", field.text)
+	}
+	p.pos += 3
+	permitted := false
+	for _, o := range operators {
+		permitted = permitted || o == op.text
+	}
+	if !permitted {
+		return nil, fmt.Errorf("%s can not be compared with %s in filter expression", field.text, op.text)
+	}
+	node := comparisonNode{field: field.text, op: op.text, value: value.text}
+	var err error
+	switch field.text {
+	case "name":
+		if op.text == "=~" || op.text == "!~" {
+			if node.re, err = regexp.Compile(value.text); err != nil {
+				return nil, fmt.Errorf("bad regexp in filter expression: %w", err)
+			}
+		}
+	case "source":
+		var source LintSource
+		source.FromString(value.text)
+		if source == UnknownLintSource {
+			return nil, fmt.Errorf("unknown lint source %q in filter expression", value.text)
+		}
+	case "type":
+		switch value.text {
+		case certificateLintType, revocationListLintType, chainLintType, ocspResponseLintType, certificateRequestLintType, precertificatePairLintType:
+		default:
+			return nil, fmt.Errorf("unknown lint type %q in filter expression", value.text)
+		}
+	case "effective", "ineffective":
+		if node.date, err = time.Parse("2006-01-02", value.text); err != nil {
+			return nil, fmt.Errorf("bad date %q in filter expression, expected YYYY-MM-DD", value.text)
+		}
+	}
+	return node, nil
+}
@@ -0,0 +1,109 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package lint
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFilterExpression(t *testing.T) {
+	meta := LintMetadata{
+		Name:          "e_ext_san_dns_example",
+		Source:        CABFBaselineRequirements,
+		Tags:          []string{"dns", "encoding"},
+		EffectiveDate: time.Date(2020, time.September, 30, 0, 0, 0, 0, time.UTC),
+	}
+	testCases := []struct {
+		expression string
+		want       bool
+	}{
+		{`name == e_ext_san_dns_example`, true},
+		{`name != e_ext_san_dns_example`, false},
+		{`name =~ "^e_ext_san_"`, true},
+		{`name !~ _dns_`, false},
+		{`source == CABF_BR`, true},
+		{`source != CABF_BR`, false},
+		{`tag == dns`, true},
+		{`tag == key`, false},
+		{`tag != key`, true},
+		{`type == certificate`, true},
+		{`type == crl`, false},
+		{`effective == 2020-09-30`, true},
+		{`effective < 2020-09-30`, false},
+		{`effective <= 2020-09-30`, true},
+		{`effective > 2020-01-01`, true},
+		{`effective >= 2021-01-01`, false},
+		{`ineffective > 2100-01-01`, true},
+		{`ineffective != 2020-09-30`, true},
+		{`tag == dns && tag == encoding`, true},
+		{`tag == dns && tag == key`, false},
+		{`tag == key || tag == encoding`, true},
+		{`!tag == key`, true},
+		{`!(tag == dns || tag == key)`, false},
+		{`tag == key || tag == dns && source == Community`, false},
+		{`(tag == key || tag == dns) && source == CABF_BR`, true},
+		{`tag==dns&&source!=Community`, true},
+		{`name == "e_ext_san_dns_example"`, true},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.expression, func(t *testing.T) {
+			expr, err := ParseFilterExpression(tc.expression)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := expr.matches(meta, certificateLintType); got != tc.want {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+			if expr.String() != tc.expression {
+				t.Errorf("expected String() to return %q, got %q", tc.expression, expr.String())
+			}
+		})
+	}
+}
+
+func TestParseFilterExpressionErrors(t *testing.T) {
+	testCases := []struct {
+		expression string
+		wantErr    string
+	}{
+		{``, "unexpected end"},
+		{`tag`, "incomplete comparison"},
+		{`tag ==`, "incomplete comparison"},
+		{`tag == dns &&`, "unexpected end"},
+		{`tag == dns tag == key`, `unexpected "tag"`},
+		{`(tag == dns`, "missing )"},
+		{`tag == dns)`, `unexpected ")"`},
+		{`colour == red`, "unknown field"},
+		{`tag < dns`, "can not be compared"},
+		{`name =~ "("`, "bad regexp"},
+		{`source == RFC9999`, "unknown lint source"},
+		{`type == cert`, "unknown lint type"},
+		{`effective > yesterday`, "bad date"},
+		{`name == "unterminated`, "unterminated string"},
+		{`tag == dns & tag == key`, `unexpected "&"`},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.expression, func(t *testing.T) {
+			_, err := ParseFilterExpression(tc.expression)
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Errorf("expected an error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
@@ -21,9 +21,13 @@ import (
 
 var (
 	// Verify that the interface holds
-	_ linterLookup               = &linterLookupImpl{}
-	_ CertificateLinterLookup    = &certificateLinterLookupImpl{}
-	_ RevocationListLinterLookup = &revocationListLinterLookupImpl{}
+	_ linterLookup                   = &linterLookupImpl{}
+	_ CertificateLinterLookup        = &certificateLinterLookupImpl{}
+	_ RevocationListLinterLookup     = &revocationListLinterLookupImpl{}
+	_ ChainLinterLookup              = &chainLinterLookupImpl{}
+	_ OCSPResponseLinterLookup       = &ocspResponseLinterLookupImpl{}
+	_ CertificateRequestLinterLookup = &certificateRequestLinterLookupImpl{}
+	_ PrecertificatePairLinterLookup = &precertificatePairLinterLookupImpl{}
 )
 
 type linterLookup interface {
@@ -215,3 +219,295 @@ func newRevocationListLintLookup() revocationListLinterLookupImpl {
 		lints:            make([]*RevocationListLint, 0),
 	}
 }
+
+// ChainLinterLookup is an interface describing how registered chain lints can be looked up.
+type ChainLinterLookup interface {
+	linterLookup
+	// ByName returns a pointer to the registered lint with the given name, or nil
+	// if there is no such lint registered in the registry.
+	ByName(name string) *ChainLint
+	// BySource returns a list of registered lints that have the same LintSource as
+	// provided (or nil if there were no such lints in the registry).
+	BySource(s LintSource) []*ChainLint
+	// Lints returns a list of all the lints registered.
+	Lints() []*ChainLint
+}
+
+type chainLinterLookupImpl struct {
+	linterLookupImpl
+	// lintsByName is a map of all registered lints by name.
+	lintsByName   map[string]*ChainLint
+	lintsBySource map[LintSource][]*ChainLint
+	lints         []*ChainLint
+}
+
+// ByName returns the Lint previously registered under the given name with
+// Register, or nil if no matching lint name has been registered.
+func (lookup *chainLinterLookupImpl) ByName(name string) *ChainLint {
+	lookup.RLock()
+	defer lookup.RUnlock()
+	return lookup.lintsByName[name]
+}
+
+// BySource returns a list of registered lints that have the same LintSource as
+// provided (or nil if there were no such lints).
+func (lookup *chainLinterLookupImpl) BySource(s LintSource) []*ChainLint {
+	lookup.RLock()
+	defer lookup.RUnlock()
+	return lookup.lintsBySource[s]
+}
+
+// Lints returns a list of registered lints.
+func (lookup *chainLinterLookupImpl) Lints() []*ChainLint {
+	lookup.RLock()
+	defer lookup.RUnlock()
+	return lookup.lints
+}
+
+func (lookup *chainLinterLookupImpl) register(lint *ChainLint, name string, source LintSource) error {
+	if name == "" {
+		return errEmptyName
+	}
+	lookup.RLock()
+	defer lookup.RUnlock()
+
+	if existing := lookup.lintsByName[name]; existing != nil {
+		return &errDuplicateName{name}
+	}
+	lookup.lints = append(lookup.lints, lint)
+	lookup.lintNames = append(lookup.lintNames, name)
+	lookup.lintsByName[name] = lint
+
+	lookup.sources[source] = struct{}{}
+	lookup.lintsBySource[source] = append(lookup.lintsBySource[source], lint)
+	sort.Strings(lookup.lintNames)
+	return nil
+}
+
+func newChainLintLookup() chainLinterLookupImpl {
+	return chainLinterLookupImpl{
+		linterLookupImpl: newLinterLookup(),
+		lintsByName:      make(map[string]*ChainLint),
+		lintsBySource:    make(map[LintSource][]*ChainLint),
+		lints:            make([]*ChainLint, 0),
+	}
+}
+
+// OCSPResponseLinterLookup is an interface describing how registered OCSP response lints can be looked up.
+type OCSPResponseLinterLookup interface {
+	linterLookup
+	// ByName returns a pointer to the registered lint with the given name, or nil
+	// if there is no such lint registered in the registry.
+	ByName(name string) *OCSPResponseLint
+	// BySource returns a list of registered lints that have the same LintSource as
+	// provided (or nil if there were no such lints in the registry).
+	BySource(s LintSource) []*OCSPResponseLint
+	// Lints returns a list of all the lints registered.
+	Lints() []*OCSPResponseLint
+}
+
+type ocspResponseLinterLookupImpl struct {
+	linterLookupImpl
+	// lintsByName is a map of all registered lints by name.
+	lintsByName   map[string]*OCSPResponseLint
+	lintsBySource map[LintSource][]*OCSPResponseLint
+	lints         []*OCSPResponseLint
+}
+
+// ByName returns the Lint previously registered under the given name with
+// Register, or nil if no matching lint name has been registered.
+func (lookup *ocspResponseLinterLookupImpl) ByName(name string) *OCSPResponseLint {
+	lookup.RLock()
+	defer lookup.RUnlock()
+	return lookup.lintsByName[name]
+}
+
+// BySource returns a list of registered lints that have the same LintSource as
+// provided (or nil if there were no such lints).
+func (lookup *ocspResponseLinterLookupImpl) BySource(s LintSource) []*OCSPResponseLint {
+	lookup.RLock()
+	defer lookup.RUnlock()
+	return lookup.lintsBySource[s]
+}
+
+// Lints returns a list of registered lints.
+func (lookup *ocspResponseLinterLookupImpl) Lints() []*OCSPResponseLint {
+	lookup.RLock()
+	defer lookup.RUnlock()
+	return lookup.lints
+}
+
+func (lookup *ocspResponseLinterLookupImpl) register(lint *OCSPResponseLint, name string, source LintSource) error {
+	if name == "" {
+		return errEmptyName
+	}
+	lookup.RLock()
+	defer lookup.RUnlock()
+
+	if existing := lookup.lintsByName[name]; existing != nil {
+		return &errDuplicateName{name}
+	}
+	lookup.lints = append(lookup.lints, lint)
+	lookup.lintNames = append(lookup.lintNames, name)
+	lookup.lintsByName[name] = lint
+
+	lookup.sources[source] = struct{}{}
+	lookup.lintsBySource[source] = append(lookup.lintsBySource[source], lint)
+	sort.Strings(lookup.lintNames)
+	return nil
+}
+
+func newOCSPResponseLintLookup() ocspResponseLinterLookupImpl {
+	return ocspResponseLinterLookupImpl{
+		linterLookupImpl: newLinterLookup(),
+		lintsByName:      make(map[string]*OCSPResponseLint),
+		lintsBySource:    make(map[LintSource][]*OCSPResponseLint),
+		lints:            make([]*OCSPResponseLint, 0),
+	}
+}
+
+// CertificateRequestLinterLookup is an interface describing how registered certificate request lints can be looked up.
+type CertificateRequestLinterLookup interface {
+	linterLookup
+	// ByName returns a pointer to the registered lint with the given name, or nil
+	// if there is no such lint registered in the registry.
+	ByName(name string) *CertificateRequestLint
+	// BySource returns a list of registered lints that have the same LintSource as
+	// provided (or nil if there were no such lints in the registry).
+	BySource(s LintSource) []*CertificateRequestLint
+	// Lints returns a list of all the lints registered.
+	Lints() []*CertificateRequestLint
+}
+
+type certificateRequestLinterLookupImpl struct {
+	linterLookupImpl
+	// lintsByName is a map of all registered lints by name.
+	lintsByName   map[string]*CertificateRequestLint
+	lintsBySource map[LintSource][]*CertificateRequestLint
+	lints         []*CertificateRequestLint
+}
+
+// ByName returns the Lint previously registered under the given name with
+// Register, or nil if no matching lint name has been registered.
+func (lookup *certificateRequestLinterLookupImpl) ByName(name string) *CertificateRequestLint {
+	lookup.RLock()
+	defer lookup.RUnlock()
+	return lookup.lintsByName[name]
+}
+
+// BySource returns a list of registered lints that have the same LintSource as
+// provided (or nil if there were no such lints).
+func (lookup *certificateRequestLinterLookupImpl) BySource(s LintSource) []*CertificateRequestLint {
+	lookup.RLock()
+	defer lookup.RUnlock()
+	return lookup.lintsBySource[s]
+}
+
+// Lints returns a list of registered lints.
+func (lookup *certificateRequestLinterLookupImpl) Lints() []*CertificateRequestLint {
+	lookup.RLock()
+	defer lookup.RUnlock()
+	return lookup.lints
+}
+
+func (lookup *certificateRequestLinterLookupImpl) register(lint *CertificateRequestLint, name string, source LintSource) error {
+	if name == "" {
+		return errEmptyName
+	}
+	lookup.RLock()
+	defer lookup.RUnlock()
+
+	if existing := lookup.lintsByName[name]; existing != nil {
+		return &errDuplicateName{name}
+	}
+	lookup.lints = append(lookup.lints, lint)
+	lookup.lintNames = append(lookup.lintNames, name)
+	lookup.lintsByName[name] = lint
+
+	lookup.sources[source] = struct{}{}
+	lookup.lintsBySource[source] = append(lookup.lintsBySource[source], lint)
+	sort.Strings(lookup.lintNames)
+	return nil
+}
+
+func newCertificateRequestLintLookup() certificateRequestLinterLookupImpl {
+	return certificateRequestLinterLookupImpl{
+		linterLookupImpl: newLinterLookup(),
+		lintsByName:      make(map[string]*CertificateRequestLint),
+		lintsBySource:    make(map[LintSource][]*CertificateRequestLint),
+		lints:            make([]*CertificateRequestLint, 0),
+	}
+}
+
+// PrecertificatePairLinterLookup is an interface describing how registered precertificate pair lints can be looked up.
+type PrecertificatePairLinterLookup interface {
+	linterLookup
+	// ByName returns a pointer to the registered lint with the given name, or nil
+	// if there is no such lint registered in the registry.
+	ByName(name string) *PrecertificatePairLint
+	// BySource returns a list of registered lints that have the same LintSource as
+	// provided (or nil if there were no such lints in the registry).
+	BySource(s LintSource) []*PrecertificatePairLint
+	// Lints returns a list of all the lints registered.
+	Lints() []*PrecertificatePairLint
+}
+
+type precertificatePairLinterLookupImpl struct {
+	linterLookupImpl
+	// lintsByName is a map of all registered lints by name.
+	lintsByName   map[string]*PrecertificatePairLint
+	lintsBySource map[LintSource][]*PrecertificatePairLint
+	lints         []*PrecertificatePairLint
+}
+
+// ByName returns the Lint previously registered under the given name with
+// Register, or nil if no matching lint name has been registered.
+func (lookup *precertificatePairLinterLookupImpl) ByName(name string) *PrecertificatePairLint {
+	lookup.RLock()
+	defer lookup.RUnlock()
+	return lookup.lintsByName[name]
+}
+
+// BySource returns a list of registered lints that have the same LintSource as
+// provided (or nil if there were no such lints).
+func (lookup *precertificatePairLinterLookupImpl) BySource(s LintSource) []*PrecertificatePairLint {
+	lookup.RLock()
+	defer lookup.RUnlock()
+	return lookup.lintsBySource[s]
+}
+
+// Lints returns a list of registered lints.
+func (lookup *precertificatePairLinterLookupImpl) Lints() []*PrecertificatePairLint {
+	lookup.RLock()
+	defer lookup.RUnlock()
+	return lookup.lints
+}
+
+func (lookup *precertificatePairLinterLookupImpl) register(lint *PrecertificatePairLint, name string, source LintSource) error {
+	if name == "" {
+		return errEmptyName
+	}
+	lookup.RLock()
+	defer lookup.RUnlock()
+
+	if existing := lookup.lintsByName[name]; existing != nil {
+		return &errDuplicateName{name}
+	}
+	lookup.lints = append(lookup.lints, lint)
+	lookup.lintNames = append(lookup.lintNames, name)
+	lookup.lintsByName[name] = lint
+
+	lookup.sources[source] = struct{}{}
+	lookup.lintsBySource[source] = append(lookup.lintsBySource[source], lint)
+	sort.Strings(lookup.lintNames)
+	return nil
+}
+
+func newPrecertificatePairLintLookup() precertificatePairLinterLookupImpl {
+	return precertificatePairLinterLookupImpl{
+		linterLookupImpl: newLinterLookup(),
+		lintsByName:      make(map[string]*PrecertificatePairLint),
+		lintsBySource:    make(map[LintSource][]*PrecertificatePairLint),
+		lints:            make([]*PrecertificatePairLint, 0),
+	}
+}
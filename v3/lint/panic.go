@@ -0,0 +1,78 @@
+package lint
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"bytes"
+	"fmt"
+	"runtime/debug"
+	"sync/atomic"
+)
+
+// maxPanicStackLength is the maximum number of bytes of the stack trace of a
+// panicking lint that are included within its LintResult.
+const maxPanicStackLength = 4096
+
+var strictPanics int32
+
+// SetStrictPanics controls how a panic raised while executing a lint is
+// handled. By default such a panic is recovered and reported as a Fatal
+// LintResult carrying the panic message and a truncated stack trace, so that
+// one broken lint cannot take down every other lint (or the process linting
+// on behalf of a CA). In strict mode the panic is left to propagate to the
+// caller instead, which is useful in tests.
+func SetStrictPanics(strict bool) {
+	var v int32
+	if strict {
+		v = 1
+	}
+	atomic.StoreInt32(&strictPanics, v)
+}
+
+// StrictPanics reports whether strict mode has been enabled using
+// SetStrictPanics.
+func StrictPanics() bool {
+	return atomic.LoadInt32(&strictPanics) == 1
+}
+
+// recoverLintPanic is deferred by each of the Execute methods of the lint
+// types. If the lint panicked then the panic is recovered and res is replaced
+// with a Fatal result describing the panic, unless strict mode is enabled.
+func recoverLintPanic(res **LintResult) {
+	if StrictPanics() {
+		// Not calling recover lets the panic continue unwinding with its
+		// original stack intact.
+		return
+	}
+	if r := recover(); r != nil {
+		*res = &LintResult{
+			Status:  Fatal,
+			Details: fmt.Sprintf("lint panicked: %v\n\n%s", r, truncateStack(debug.Stack())),
+		}
+	}
+}
+
+// truncateStack shortens stack to at most maxPanicStackLength bytes, cutting
+// it at the end of a line where possible.
+func truncateStack(stack []byte) []byte {
+	if len(stack) <= maxPanicStackLength {
+		return stack
+	}
+	stack = stack[:maxPanicStackLength]
+	if i := bytes.LastIndexByte(stack, '\n'); i > 0 {
+		stack = stack[:i+1]
+	}
+	return append(stack, "...\n"...)
+}
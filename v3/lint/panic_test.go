@@ -0,0 +1,125 @@
+package lint
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/zmap/zcrypto/x509"
+)
+
+type panickingCertificateLint struct{}
+
+func (l *panickingCertificateLint) CheckApplies(c *x509.Certificate) bool {
+	return true
+}
+
+func (l *panickingCertificateLint) Execute(c *x509.Certificate) *LintResult {
+	panic("certificate lint exploded")
+}
+
+type panickingRevocationListLint struct{}
+
+func (l *panickingRevocationListLint) CheckApplies(r *x509.RevocationList) bool {
+	return true
+}
+
+func (l *panickingRevocationListLint) Execute(r *x509.RevocationList) *LintResult {
+	var reasons []int
+	_ = reasons[len(r.RevokedCertificates)]
+	return &LintResult{Status: Pass}
+}
+
+func TestExecuteRecoversPanics(t *testing.T) {
+	certLint := &CertificateLint{
+		LintMetadata: LintMetadata{Name: "e_panicking_certificate_lint"},
+		Lint:         func() CertificateLintInterface { return &panickingCertificateLint{} },
+	}
+	crlLint := &RevocationListLint{
+		LintMetadata: LintMetadata{Name: "e_panicking_revocation_list_lint"},
+		Lint:         func() RevocationListLintInterface { return &panickingRevocationListLint{} },
+	}
+
+	testCases := []struct {
+		name        string
+		execute     func() *LintResult
+		wantMessage string
+		wantFrame   string
+	}{
+		{
+			name:        "certificate lint",
+			execute:     func() *LintResult { return certLint.Execute(&x509.Certificate{}, NewEmptyConfig()) },
+			wantMessage: "lint panicked: certificate lint exploded\n\n",
+			wantFrame:   "(*panickingCertificateLint).Execute",
+		},
+		{
+			name:        "revocation list lint",
+			execute:     func() *LintResult { return crlLint.Execute(&x509.RevocationList{}, NewEmptyConfig()) },
+			wantMessage: "lint panicked: runtime error: index out of range [0] with length 0\n\n",
+			wantFrame:   "(*panickingRevocationListLint).Execute",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got := tc.execute()
+			if got.Status != Fatal {
+				t.Errorf("expected %s, got %s", Fatal, got.Status)
+			}
+			if !strings.HasPrefix(got.Details, tc.wantMessage) {
+				t.Errorf("expected details to start with %q, got %q", tc.wantMessage, got.Details)
+			}
+			if !strings.Contains(got.Details, tc.wantFrame) {
+				t.Errorf("expected details to contain the stack frame %q, got %q", tc.wantFrame, got.Details)
+			}
+		})
+	}
+}
+
+func TestExecuteStrictPanics(t *testing.T) {
+	SetStrictPanics(true)
+	defer SetStrictPanics(false)
+
+	l := &CertificateLint{
+		LintMetadata: LintMetadata{Name: "e_panicking_certificate_lint"},
+		Lint:         func() CertificateLintInterface { return &panickingCertificateLint{} },
+	}
+	defer func() {
+		if r := recover(); r != "certificate lint exploded" {
+			t.Errorf("expected the original panic to propagate, got %v", r)
+		}
+	}()
+	l.Execute(&x509.Certificate{}, NewEmptyConfig())
+	t.Error("expected Execute to panic in strict mode")
+}
+
+func TestTruncateStack(t *testing.T) {
+	short := []byte("goroutine 1 [running]:\nmain.main()\n")
+	if got := truncateStack(short); string(got) != string(short) {
+		t.Errorf("expected a short stack to be left untouched, got %q", got)
+	}
+
+	line := strings.Repeat("x", 99) + "\n"
+	long := []byte(strings.Repeat(line, 100))
+	got := truncateStack(long)
+	if len(got) > maxPanicStackLength+len("...\n") {
+		t.Errorf("expected at most %d bytes, got %d", maxPanicStackLength+len("...\n"), len(got))
+	}
+	want := strings.Repeat(line, maxPanicStackLength/len(line)) + "...\n"
+	if string(got) != want {
+		t.Errorf("expected the stack to be cut at a line boundary, got %q", got)
+	}
+}
@@ -14,6 +14,8 @@
 
 package lint
 
+import "sort"
+
 type Profile struct {
 	// Name is a lowercase underscore-separated string describing what a given
 	// profile aggregates.
@@ -49,11 +51,15 @@ func GetProfile(name string) (profile Profile, ok bool) {
 	return profile, ok
 }
 
-// AllProfiles returns a slice of all Profiles currently registered globally.
+// AllProfiles returns a slice of all Profiles currently registered globally,
+// sorted by name.
 func AllProfiles() []Profile {
 	p := make([]Profile, 0)
 	for _, profile := range profiles {
 		p = append(p, profile)
 	}
+	sort.Slice(p, func(i, j int) bool {
+		return p[i].Name < p[j].Name
+	})
 	return p
 }
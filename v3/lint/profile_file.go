@@ -0,0 +1,228 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pelletier/go-toml"
+)
+
+// profileDefinition is the form in which a Profile is declared within a
+// profile file. The lints of a profile are those of every profile that it
+// extends, plus its own Lints, minus its Exclude.
+type profileDefinition struct {
+	Name        string     `json:"name" toml:"name"`
+	Description string     `json:"description" toml:"description"`
+	Citation    string     `json:"citation" toml:"citation"`
+	Source      LintSource `json:"source" toml:"source"`
+	Extends     []string   `json:"extends" toml:"extends"`
+	Lints       []string   `json:"lints" toml:"lints"`
+	Exclude     []string   `json:"exclude" toml:"exclude"`
+}
+
+// profileFile is the top level of a profile file.
+type profileFile struct {
+	Profiles []profileDefinition `json:"profiles" toml:"profiles"`
+}
+
+// NewProfilesFromJSON reads the Profiles declared within a JSON profile file,
+// e.g.
+//
+// ```
+//
+//	{
+//	  "profiles": [
+//	    {
+//	      "name": "example_tls_subscriber",
+//	      "description": "TLS subscriber certificates issued by Example CA",
+//	      "extends": ["tls_subscriber"],
+//	      "lints": ["e_rsa_fermat_factorization"],
+//	      "exclude": ["w_subject_common_name_included"]
+//	    }
+//	  ]
+//	}
+//
+// ```
+//
+// A profile may extend any globally registered profile, as well as any other
+// profile declared within the same file. Every lint that a profile includes
+// or excludes must be registered within the global registry. The returned
+// Profiles are not registered.
+func NewProfilesFromJSON(r io.Reader) ([]Profile, error) {
+	var file profileFile
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&file); err != nil {
+		return nil, fmt.Errorf("failed to parse profiles: %w", err)
+	}
+	return resolveProfiles(file.Profiles)
+}
+
+// NewProfilesFromTOML reads the Profiles declared within a TOML profile file,
+// e.g.
+//
+// ```
+//
+//	[[profiles]]
+//	name = "example_tls_subscriber"
+//	description = "TLS subscriber certificates issued by Example CA"
+//	extends = ["tls_subscriber"]
+//	lints = ["e_rsa_fermat_factorization"]
+//	exclude = ["w_subject_common_name_included"]
+//
+// ```
+//
+// See NewProfilesFromJSON for how profiles are resolved.
+func NewProfilesFromTOML(r io.Reader) ([]Profile, error) {
+	var file profileFile
+	if err := toml.NewDecoder(r).Strict(true).Decode(&file); err != nil {
+		return nil, fmt.Errorf("failed to parse profiles: %w", err)
+	}
+	for i := range file.Profiles {
+		if src := file.Profiles[i].Source; src != "" {
+			file.Profiles[i].Source.FromString(string(src))
+			if file.Profiles[i].Source == UnknownLintSource {
+				return nil, fmt.Errorf("profile %q has an unknown source %q", file.Profiles[i].Name, src)
+			}
+		}
+	}
+	return resolveProfiles(file.Profiles)
+}
+
+// NewProfilesFromFile reads the Profiles declared within the profile file at
+// path. Files with a ".toml" extension are read with NewProfilesFromTOML and
+// all others with NewProfilesFromJSON.
+func NewProfilesFromFile(path string) ([]Profile, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the provided profiles at %s. Error: %s", path, err.Error())
+	}
+	defer f.Close()
+	if strings.EqualFold(filepath.Ext(path), ".toml") {
+		return NewProfilesFromTOML(f)
+	}
+	return NewProfilesFromJSON(f)
+}
+
+// resolveProfiles resolves the lints of each of the definitions, in the order
+// in which they were declared.
+func resolveProfiles(definitions []profileDefinition) ([]Profile, error) {
+	byName := make(map[string]*profileDefinition, len(definitions))
+	for i := range definitions {
+		def := &definitions[i]
+		if def.Name == "" {
+			return nil, fmt.Errorf("profile %d has no name", i+1)
+		}
+		if _, ok := byName[def.Name]; ok {
+			return nil, fmt.Errorf("profile %q is declared more than once", def.Name)
+		}
+		if _, ok := GetProfile(def.Name); ok {
+			return nil, fmt.Errorf("profile %q conflicts with a registered profile of the same name", def.Name)
+		}
+		byName[def.Name] = def
+	}
+	known := make(map[string]bool)
+	for _, name := range GlobalRegistry().Names() {
+		known[name] = true
+	}
+	r := profileResolver{
+		definitions: byName,
+		known:       known,
+		resolved:    make(map[string]Profile),
+		resolving:   make(map[string]bool),
+	}
+	profiles := make([]Profile, 0, len(definitions))
+	for _, def := range definitions {
+		profile, err := r.resolve(def.Name)
+		if err != nil {
+			return nil, err
+		}
+		profiles = append(profiles, profile)
+	}
+	return profiles, nil
+}
+
+// profileResolver resolves the lints of profile definitions that may extend
+// one another.
+type profileResolver struct {
+	definitions map[string]*profileDefinition
+	known       map[string]bool
+	resolved    map[string]Profile
+	resolving   map[string]bool
+}
+
+func (r *profileResolver) resolve(name string) (Profile, error) {
+	if profile, ok := r.resolved[name]; ok {
+		return profile, nil
+	}
+	def, ok := r.definitions[name]
+	if !ok {
+		if profile, ok := GetProfile(name); ok {
+			return profile, nil
+		}
+		return Profile{}, fmt.Errorf("unknown profile %q", name)
+	}
+	if r.resolving[name] {
+		return Profile{}, fmt.Errorf("profile %q extends itself", name)
+	}
+	r.resolving[name] = true
+	defer delete(r.resolving, name)
+
+	lints := make(map[string]bool)
+	for _, parent := range def.Extends {
+		profile, err := r.resolve(parent)
+		if err != nil {
+			return Profile{}, fmt.Errorf("profile %q can not extend %q: %w", name, parent, err)
+		}
+		for _, l := range profile.LintNames {
+			lints[l] = true
+		}
+	}
+	for _, l := range def.Lints {
+		if !r.known[l] {
+			return Profile{}, fmt.Errorf("profile %q includes the unknown lint %q", name, l)
+		}
+		lints[l] = true
+	}
+	for _, l := range def.Exclude {
+		if !r.known[l] {
+			return Profile{}, fmt.Errorf("profile %q excludes the unknown lint %q", name, l)
+		}
+		delete(lints, l)
+	}
+	if len(lints) == 0 {
+		return Profile{}, fmt.Errorf("profile %q does not include any lints", name)
+	}
+	profile := Profile{
+		Name:        def.Name,
+		Description: def.Description,
+		Citation:    def.Citation,
+		Source:      def.Source,
+		LintNames:   make([]string, 0, len(lints)),
+	}
+	for l := range lints {
+		profile.LintNames = append(profile.LintNames, l)
+	}
+	sort.Strings(profile.LintNames)
+	r.resolved[name] = profile
+	return profile, nil
+}
@@ -31,7 +31,9 @@ import (
 // containing only lints that meet the filter options specified.
 //
 // Source based exclusion/inclusion is evaluated before Lint name based
-// exclusion/inclusion. In both cases exclusion is processed before inclusion.
+// exclusion/inclusion, which is evaluated before tag based exclusion/inclusion.
+// In each case exclusion is processed before inclusion. A lint must also
+// satisfy the Expression, if one is provided.
 //
 // Only one of NameFilter or IncludeNames/ExcludeNames can be provided at
 // a time.
@@ -51,6 +53,15 @@ type FilterOptions struct {
 	// ExcludeSources is a SourceList of LintSources's to be excluded in the
 	// registry being filtered.
 	ExcludeSources SourceList
+	// IncludeTags is a list of tags of which lints must have at least one to
+	// be included in the registry being filtered.
+	IncludeTags []string
+	// ExcludeTags is a list of tags of which lints must have none to be
+	// included in the registry being filtered.
+	ExcludeTags []string
+	// Expression is a FilterExpression that lints must satisfy to be included
+	// in the registry being filtered.
+	Expression *FilterExpression
 }
 
 // Empty returns true if the FilterOptions is empty and does not specify any
@@ -60,7 +71,10 @@ func (f FilterOptions) Empty() bool {
 		len(f.IncludeNames) == 0 &&
 		len(f.ExcludeNames) == 0 &&
 		len(f.IncludeSources) == 0 &&
-		len(f.ExcludeSources) == 0
+		len(f.ExcludeSources) == 0 &&
+		len(f.IncludeTags) == 0 &&
+		len(f.ExcludeTags) == 0 &&
+		f.Expression == nil
 }
 
 // AddProfile takes in a Profile and appends all Profile.LintNames
@@ -110,14 +124,26 @@ type Registry interface { //nolint: interfacebloat // Somewhat unavoidable here.
 	CertificateLints() CertificateLinterLookup
 	// RevocationListLitns returns an interface used to lookup RevocationListLints.
 	RevocationListLints() RevocationListLinterLookup
+	// ChainLints returns an interface used to lookup ChainLints.
+	ChainLints() ChainLinterLookup
+	// OCSPResponseLints returns an interface used to lookup OCSPResponseLints.
+	OCSPResponseLints() OCSPResponseLinterLookup
+	// CertificateRequestLints returns an interface used to lookup CertificateRequestLints.
+	CertificateRequestLints() CertificateRequestLinterLookup
+	// PrecertificatePairLints returns an interface used to lookup PrecertificatePairLints.
+	PrecertificatePairLints() PrecertificatePairLinterLookup
 }
 
 // registryImpl implements the Registry interface to provide a global collection
 // of Lints that have been registered.
 type registryImpl struct {
-	certificateLints    certificateLinterLookupImpl
-	revocationListLints revocationListLinterLookupImpl
-	configuration       Configuration
+	certificateLints        certificateLinterLookupImpl
+	revocationListLints     revocationListLinterLookupImpl
+	chainLints              chainLinterLookupImpl
+	ocspResponseLints       ocspResponseLinterLookupImpl
+	certificateRequestLints certificateRequestLinterLookupImpl
+	precertificatePairLints precertificatePairLinterLookupImpl
+	configuration           Configuration
 }
 
 var (
@@ -143,6 +169,43 @@ func (e errDuplicateName) Error() string {
 		e.lintName)
 }
 
+// errPrerequisiteCycle is returned from registry.Register if the provided lint
+// would, directly or indirectly, be a prerequisite of itself.
+type errPrerequisiteCycle struct {
+	lintName string
+}
+
+func (e errPrerequisiteCycle) Error() string {
+	return fmt.Sprintf(
+		"can not register lint with name %q - its prerequisites form a cycle",
+		e.lintName)
+}
+
+// checkPrerequisiteCycle returns an errPrerequisiteCycle if the lint described
+// by meta is reachable from its own prerequisites. The prerequisites of the
+// already registered lints are provided by prerequisitesOf.
+//
+// As every other lint in a cycle must already be registered by the time its
+// final member is, checking each lint as it is registered is sufficient to
+// keep the registry free of cycles.
+func checkPrerequisiteCycle(meta LintMetadata, prerequisitesOf func(name string) []string) error {
+	seen := map[string]bool{}
+	pending := append([]string{}, meta.Prerequisites...)
+	for len(pending) > 0 {
+		name := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+		if name == meta.Name {
+			return &errPrerequisiteCycle{meta.Name}
+		}
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		pending = append(pending, prerequisitesOf(name)...)
+	}
+	return nil
+}
+
 // registerLint registers a lint to the registry.
 //
 // @deprecated - use registerCertificateLint instead.
@@ -160,7 +223,8 @@ func (r *registryImpl) register(l *Lint) error {
 // registerCertificateLint registers a CertificateLint to the registry.
 //
 // An error is returned if the lint or lint's Lint pointer is nil, if the Lint
-// has an empty Name or if the Name was previously registered.
+// has an empty Name, if the Name was previously registered or if the lint's
+// prerequisites form a cycle.
 func (r *registryImpl) registerCertificateLint(l *CertificateLint) error {
 	if l == nil {
 		return errNilLint
@@ -168,13 +232,23 @@ func (r *registryImpl) registerCertificateLint(l *CertificateLint) error {
 	if l.Lint() == nil {
 		return errNilLintPtr
 	}
+	err := checkPrerequisiteCycle(l.LintMetadata, func(name string) []string {
+		if prerequisite := r.certificateLints.ByName(name); prerequisite != nil {
+			return prerequisite.Prerequisites
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
 	return r.certificateLints.register(l, l.Name, l.Source)
 }
 
 // registerCertificateLint registers a CertificateLint to the registry.
 //
 // An error is returned if the lint or lint's Lint pointer is nil, if the Lint
-// has an empty Name or if the Name was previously registered.
+// has an empty Name, if the Name was previously registered or if the lint's
+// prerequisites form a cycle.
 func (r *registryImpl) registerRevocationListLint(l *RevocationListLint) error {
 	if l == nil {
 		return errNilLint
@@ -182,9 +256,114 @@ func (r *registryImpl) registerRevocationListLint(l *RevocationListLint) error {
 	if l.Lint() == nil {
 		return errNilLintPtr
 	}
+	err := checkPrerequisiteCycle(l.LintMetadata, func(name string) []string {
+		if prerequisite := r.revocationListLints.ByName(name); prerequisite != nil {
+			return prerequisite.Prerequisites
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
 	return r.revocationListLints.register(l, l.Name, l.Source)
 }
 
+// registerChainLint registers a ChainLint to the registry.
+//
+// An error is returned if the lint or lint's Lint pointer is nil, if the Lint
+// has an empty Name, if the Name was previously registered or if the lint's
+// prerequisites form a cycle.
+func (r *registryImpl) registerChainLint(l *ChainLint) error {
+	if l == nil {
+		return errNilLint
+	}
+	if l.Lint() == nil {
+		return errNilLintPtr
+	}
+	err := checkPrerequisiteCycle(l.LintMetadata, func(name string) []string {
+		if prerequisite := r.chainLints.ByName(name); prerequisite != nil {
+			return prerequisite.Prerequisites
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return r.chainLints.register(l, l.Name, l.Source)
+}
+
+// registerOCSPResponseLint registers an OCSPResponseLint to the registry.
+//
+// An error is returned if the lint or lint's Lint pointer is nil, if the Lint
+// has an empty Name, if the Name was previously registered or if the lint's
+// prerequisites form a cycle.
+func (r *registryImpl) registerOCSPResponseLint(l *OCSPResponseLint) error {
+	if l == nil {
+		return errNilLint
+	}
+	if l.Lint() == nil {
+		return errNilLintPtr
+	}
+	err := checkPrerequisiteCycle(l.LintMetadata, func(name string) []string {
+		if prerequisite := r.ocspResponseLints.ByName(name); prerequisite != nil {
+			return prerequisite.Prerequisites
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return r.ocspResponseLints.register(l, l.Name, l.Source)
+}
+
+// registerCertificateRequestLint registers a CertificateRequestLint to the registry.
+//
+// An error is returned if the lint or lint's Lint pointer is nil, if the Lint
+// has an empty Name, if the Name was previously registered or if the lint's
+// prerequisites form a cycle.
+func (r *registryImpl) registerCertificateRequestLint(l *CertificateRequestLint) error {
+	if l == nil {
+		return errNilLint
+	}
+	if l.Lint() == nil {
+		return errNilLintPtr
+	}
+	err := checkPrerequisiteCycle(l.LintMetadata, func(name string) []string {
+		if prerequisite := r.certificateRequestLints.ByName(name); prerequisite != nil {
+			return prerequisite.Prerequisites
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return r.certificateRequestLints.register(l, l.Name, l.Source)
+}
+
+// registerPrecertificatePairLint registers a PrecertificatePairLint to the registry.
+//
+// An error is returned if the lint or lint's Lint pointer is nil, if the Lint
+// has an empty Name, if the Name was previously registered or if the lint's
+// prerequisites form a cycle.
+func (r *registryImpl) registerPrecertificatePairLint(l *PrecertificatePairLint) error {
+	if l == nil {
+		return errNilLint
+	}
+	if l.Lint() == nil {
+		return errNilLintPtr
+	}
+	err := checkPrerequisiteCycle(l.LintMetadata, func(name string) []string {
+		if prerequisite := r.precertificatePairLints.ByName(name); prerequisite != nil {
+			return prerequisite.Prerequisites
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return r.precertificatePairLints.register(l, l.Name, l.Source)
+}
+
 // ByName returns the Lint previously registered under the given name with
 // Register, or nil if no matching lint name has been registered.
 //
@@ -204,6 +383,10 @@ func (r *registryImpl) Names() []string {
 	var names []string
 	names = append(names, r.certificateLints.lintNames...)
 	names = append(names, r.revocationListLints.lintNames...)
+	names = append(names, r.chainLints.lintNames...)
+	names = append(names, r.ocspResponseLints.lintNames...)
+	names = append(names, r.certificateRequestLints.lintNames...)
+	names = append(names, r.precertificatePairLints.lintNames...)
 
 	sort.Strings(names)
 	return names
@@ -234,6 +417,10 @@ func (r *registryImpl) Sources() SourceList {
 
 	sources = append(sources, r.certificateLints.Sources()...)
 	sources = append(sources, r.revocationListLints.Sources()...)
+	sources = append(sources, r.chainLints.Sources()...)
+	sources = append(sources, r.ocspResponseLints.Sources()...)
+	sources = append(sources, r.certificateRequestLints.Sources()...)
+	sources = append(sources, r.precertificatePairLints.Sources()...)
 	return sources
 }
 
@@ -245,6 +432,22 @@ func (r *registryImpl) RevocationListLints() RevocationListLinterLookup {
 	return &r.revocationListLints
 }
 
+func (r *registryImpl) ChainLints() ChainLinterLookup {
+	return &r.chainLints
+}
+
+func (r *registryImpl) OCSPResponseLints() OCSPResponseLinterLookup {
+	return &r.ocspResponseLints
+}
+
+func (r *registryImpl) CertificateRequestLints() CertificateRequestLinterLookup {
+	return &r.certificateRequestLints
+}
+
+func (r *registryImpl) PrecertificatePairLints() PrecertificatePairLinterLookup {
+	return &r.precertificatePairLints
+}
+
 // lintNamesToMap converts a list of lit names into a bool hashmap useful for
 // filtering. If any of the lint names are not known by the registry an error is
 // returned.
@@ -264,11 +467,48 @@ func (r *registryImpl) lintNamesToMap(names []string) (map[string]bool, error) {
 			namesMap[n] = true
 			continue
 		}
+		if l := r.chainLints.ByName(n); l != nil {
+			namesMap[n] = true
+			continue
+		}
+		if l := r.ocspResponseLints.ByName(n); l != nil {
+			namesMap[n] = true
+			continue
+		}
+		if l := r.certificateRequestLints.ByName(n); l != nil {
+			namesMap[n] = true
+			continue
+		}
+		if l := r.precertificatePairLints.ByName(n); l != nil {
+			namesMap[n] = true
+			continue
+		}
 		return nil, fmt.Errorf("unknown lint name %q", n)
 	}
 	return namesMap, nil
 }
 
+func tagsToMap(tags []string) map[string]bool {
+	if len(tags) == 0 {
+		return nil
+	}
+	tagMap := make(map[string]bool, len(tags))
+	for _, t := range tags {
+		tagMap[strings.TrimSpace(t)] = true
+	}
+	return tagMap
+}
+
+// hasAnyTag returns true if any of the tags are within tagMap.
+func hasAnyTag(tags []string, tagMap map[string]bool) bool {
+	for _, t := range tags {
+		if tagMap[t] {
+			return true
+		}
+	}
+	return false
+}
+
 func sourceListToMap(sources SourceList) map[LintSource]bool {
 	if len(sources) == 0 {
 		return nil
@@ -285,7 +525,8 @@ func sourceListToMap(sources SourceList) map[LintSource]bool {
 //
 // FilterOptions are applied in the following order of precedence:
 //
-//	ExcludeSources > IncludeSources > NameFilter > ExcludeNames > IncludeNames
+//	ExcludeSources > IncludeSources > NameFilter > ExcludeNames > IncludeNames >
+//	ExcludeTags > IncludeTags > Expression
 //
 //nolint:cyclop
 func (r *registryImpl) Filter(opts FilterOptions) (Registry, error) {
@@ -299,6 +540,8 @@ func (r *registryImpl) Filter(opts FilterOptions) (Registry, error) {
 
 	sourceExcludes := sourceListToMap(opts.ExcludeSources)
 	sourceIncludes := sourceListToMap(opts.IncludeSources)
+	tagExcludes := tagsToMap(opts.ExcludeTags)
+	tagIncludes := tagsToMap(opts.IncludeTags)
 
 	nameExcludes, err := r.lintNamesToMap(opts.ExcludeNames)
 	if err != nil {
@@ -317,18 +560,45 @@ func (r *registryImpl) Filter(opts FilterOptions) (Registry, error) {
 
 	for _, name := range r.Names() {
 		var meta LintMetadata
+		var lintType string
 		var registerFunc func() error
 
 		if l := r.certificateLints.ByName(name); l != nil {
 			meta = l.LintMetadata
+			lintType = certificateLintType
 			registerFunc = func() error {
 				return filteredRegistry.registerCertificateLint(l)
 			}
 		} else if l := r.revocationListLints.ByName(name); l != nil {
 			meta = l.LintMetadata
+			lintType = revocationListLintType
 			registerFunc = func() error {
 				return filteredRegistry.registerRevocationListLint(l)
 			}
+		} else if l := r.chainLints.ByName(name); l != nil {
+			meta = l.LintMetadata
+			lintType = chainLintType
+			registerFunc = func() error {
+				return filteredRegistry.registerChainLint(l)
+			}
+		} else if l := r.ocspResponseLints.ByName(name); l != nil {
+			meta = l.LintMetadata
+			lintType = ocspResponseLintType
+			registerFunc = func() error {
+				return filteredRegistry.registerOCSPResponseLint(l)
+			}
+		} else if l := r.certificateRequestLints.ByName(name); l != nil {
+			meta = l.LintMetadata
+			lintType = certificateRequestLintType
+			registerFunc = func() error {
+				return filteredRegistry.registerCertificateRequestLint(l)
+			}
+		} else if l := r.precertificatePairLints.ByName(name); l != nil {
+			meta = l.LintMetadata
+			lintType = precertificatePairLintType
+			registerFunc = func() error {
+				return filteredRegistry.registerPrecertificatePairLint(l)
+			}
 		}
 
 		if sourceExcludes != nil && sourceExcludes[meta.Source] {
@@ -346,6 +616,15 @@ func (r *registryImpl) Filter(opts FilterOptions) (Registry, error) {
 		if nameIncludes != nil && !nameIncludes[name] {
 			continue
 		}
+		if tagExcludes != nil && hasAnyTag(meta.Tags, tagExcludes) {
+			continue
+		}
+		if tagIncludes != nil && !hasAnyTag(meta.Tags, tagIncludes) {
+			continue
+		}
+		if opts.Expression != nil && !opts.Expression.matches(meta, lintType) {
+			continue
+		}
 
 		if err := registerFunc(); err != nil {
 			return nil, err
@@ -369,6 +648,26 @@ func (r *registryImpl) WriteJSON(w io.Writer) {
 		//nolint:errchkjson
 		_ = enc.Encode(lint)
 	}
+
+	for _, lint := range r.chainLints.Lints() {
+		//nolint:errchkjson
+		_ = enc.Encode(lint)
+	}
+
+	for _, lint := range r.ocspResponseLints.Lints() {
+		//nolint:errchkjson
+		_ = enc.Encode(lint)
+	}
+
+	for _, lint := range r.certificateRequestLints.Lints() {
+		//nolint:errchkjson
+		_ = enc.Encode(lint)
+	}
+
+	for _, lint := range r.precertificatePairLints.Lints() {
+		//nolint:errchkjson
+		_ = enc.Encode(lint)
+	}
 }
 
 func (r *registryImpl) SetConfiguration(cfg Configuration) {
@@ -408,6 +707,38 @@ func (r *registryImpl) defaultConfiguration(globals []GlobalConfiguration) ([]by
 		}
 	}
 
+	for name, lint := range r.chainLints.lintsByName {
+		switch configurable := lint.Lint().(type) {
+		case Configurable:
+			configurables[name] = stripGlobalsFromExample(configurable.Configure())
+		default:
+		}
+	}
+
+	for name, lint := range r.ocspResponseLints.lintsByName {
+		switch configurable := lint.Lint().(type) {
+		case Configurable:
+			configurables[name] = stripGlobalsFromExample(configurable.Configure())
+		default:
+		}
+	}
+
+	for name, lint := range r.certificateRequestLints.lintsByName {
+		switch configurable := lint.Lint().(type) {
+		case Configurable:
+			configurables[name] = stripGlobalsFromExample(configurable.Configure())
+		default:
+		}
+	}
+
+	for name, lint := range r.precertificatePairLints.lintsByName {
+		switch configurable := lint.Lint().(type) {
+		case Configurable:
+			configurables[name] = stripGlobalsFromExample(configurable.Configure())
+		default:
+		}
+	}
+
 	for _, config := range globals {
 		switch config.(type) {
 		case *Global:
@@ -443,8 +774,12 @@ func (r *registryImpl) defaultConfiguration(globals []GlobalConfiguration) ([]by
 //nolint:revive
 func NewRegistry() *registryImpl {
 	registry := &registryImpl{
-		certificateLints:    newCertificateLintLookup(),
-		revocationListLints: newRevocationListLintLookup(),
+		certificateLints:        newCertificateLintLookup(),
+		revocationListLints:     newRevocationListLintLookup(),
+		chainLints:              newChainLintLookup(),
+		ocspResponseLints:       newOCSPResponseLintLookup(),
+		certificateRequestLints: newCertificateRequestLintLookup(),
+		precertificatePairLints: newPrecertificatePairLintLookup(),
 	}
 	registry.SetConfiguration(NewEmptyConfig())
 	return registry
@@ -496,6 +831,58 @@ func RegisterRevocationListLint(l *RevocationListLint) {
 	}
 }
 
+// RegisterChainLint must be called once for each ChainLint to be executed.
+// Normally, RegisterChainLint is called from the Go init() function of a lint implementation.
+//
+// IMPORTANT: RegisterChainLint will panic if given a nil lint, or a lint with
+// a nil Lint pointer, or if the lint name matches a previously registered
+// lint's name. These conditions all indicate a bug that should be addressed by
+// a developer.
+func RegisterChainLint(l *ChainLint) {
+	if err := globalRegistry.registerChainLint(l); err != nil {
+		panic(fmt.Sprintf("RegisterLint error: %v\n", err.Error()))
+	}
+}
+
+// RegisterOCSPResponseLint must be called once for each OCSPResponseLint to be executed.
+// Normally, RegisterOCSPResponseLint is called from the Go init() function of a lint implementation.
+//
+// IMPORTANT: RegisterOCSPResponseLint will panic if given a nil lint, or a
+// lint with a nil Lint pointer, or if the lint name matches a previously
+// registered lint's name. These conditions all indicate a bug that should be
+// addressed by a developer.
+func RegisterOCSPResponseLint(l *OCSPResponseLint) {
+	if err := globalRegistry.registerOCSPResponseLint(l); err != nil {
+		panic(fmt.Sprintf("RegisterLint error: %v\n", err.Error()))
+	}
+}
+
+// RegisterCertificateRequestLint must be called once for each CertificateRequestLint to be executed.
+// Normally, RegisterCertificateRequestLint is called from the Go init() function of a lint implementation.
+//
+// IMPORTANT: RegisterCertificateRequestLint will panic if given a nil lint, or a
+// lint with a nil Lint pointer, or if the lint name matches a previously
+// registered lint's name. These conditions all indicate a bug that should be
+// addressed by a developer.
+func RegisterCertificateRequestLint(l *CertificateRequestLint) {
+	if err := globalRegistry.registerCertificateRequestLint(l); err != nil {
+		panic(fmt.Sprintf("RegisterLint error: %v\n", err.Error()))
+	}
+}
+
+// RegisterPrecertificatePairLint must be called once for each PrecertificatePairLint to be executed.
+// Normally, RegisterPrecertificatePairLint is called from the Go init() function of a lint implementation.
+//
+// IMPORTANT: RegisterPrecertificatePairLint will panic if given a nil lint, or a
+// lint with a nil Lint pointer, or if the lint name matches a previously
+// registered lint's name. These conditions all indicate a bug that should be
+// addressed by a developer.
+func RegisterPrecertificatePairLint(l *PrecertificatePairLint) {
+	if err := globalRegistry.registerPrecertificatePairLint(l); err != nil {
+		panic(fmt.Sprintf("RegisterLint error: %v\n", err.Error()))
+	}
+}
+
 // GlobalRegistry is the Registry used by RegisterLint and contains all of the
 // lints that are loaded.
 //
@@ -18,7 +18,9 @@ import (
 	"reflect"
 	"regexp"
 	"sort"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/zmap/zcrypto/x509"
 )
@@ -37,6 +39,14 @@ func TestAllLintsHaveValidMeta(t *testing.T) {
 		if meta.Source == UnknownLintSource {
 			t.Errorf("lint %s has unknown source", meta.Name)
 		}
+		if meta.Remediation == "" {
+			t.Errorf("lint %s has empty remediation", meta.Name)
+		}
+		for _, tag := range meta.Tags {
+			if tag == "" || tag != strings.ToLower(strings.TrimSpace(tag)) {
+				t.Errorf("lint %s has tag %q which is not a lowercase word", meta.Name, tag)
+			}
+		}
 	}
 	for _, lint := range globalRegistry.certificateLints.lints {
 		checkMeta(lint.LintMetadata)
@@ -44,6 +54,18 @@ func TestAllLintsHaveValidMeta(t *testing.T) {
 	for _, lint := range globalRegistry.revocationListLints.lints {
 		checkMeta(lint.LintMetadata)
 	}
+	for _, lint := range globalRegistry.chainLints.lints {
+		checkMeta(lint.LintMetadata)
+	}
+	for _, lint := range globalRegistry.ocspResponseLints.lints {
+		checkMeta(lint.LintMetadata)
+	}
+	for _, lint := range globalRegistry.certificateRequestLints.lints {
+		checkMeta(lint.LintMetadata)
+	}
+	for _, lint := range globalRegistry.precertificatePairLints.lints {
+		checkMeta(lint.LintMetadata)
+	}
 }
 
 func TestFilterOptionsEmpty(t *testing.T) {
@@ -77,6 +99,16 @@ func (m mockRevocationListLint) Execute(c *x509.RevocationList) *LintResult {
 	return nil
 }
 
+type mockChainLint struct{}
+
+func (m mockChainLint) CheckApplies(c *x509.Certificate, issuer *x509.Certificate) bool {
+	return true
+}
+
+func (m mockChainLint) Execute(c *x509.Certificate, issuer *x509.Certificate) *LintResult {
+	return nil
+}
+
 func TestRegister(t *testing.T) {
 	egLint := &Lint{
 		Name:   "mockLint",
@@ -161,16 +193,81 @@ func TestRegister(t *testing.T) {
 	}
 }
 
+func TestRegisterPrerequisiteCycle(t *testing.T) {
+	newLint := func(name string, prerequisites ...string) *CertificateLint {
+		return &CertificateLint{
+			LintMetadata: LintMetadata{
+				Name:          name,
+				Source:        Community,
+				Prerequisites: prerequisites,
+			},
+			Lint: func() CertificateLintInterface { return &mockLint{} },
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		existing  []*CertificateLint
+		lint      *CertificateLint
+		expectErr error
+	}{
+		{
+			name: "no prerequisites",
+			lint: newLint("e_a"),
+		},
+		{
+			name: "unregistered prerequisite",
+			lint: newLint("e_a", "e_b"),
+		},
+		{
+			name:     "chain of prerequisites",
+			existing: []*CertificateLint{newLint("e_c"), newLint("e_b", "e_c")},
+			lint:     newLint("e_a", "e_b", "e_c"),
+		},
+		{
+			name:      "own prerequisite",
+			lint:      newLint("e_a", "e_a"),
+			expectErr: &errPrerequisiteCycle{"e_a"},
+		},
+		{
+			name:      "indirect cycle",
+			existing:  []*CertificateLint{newLint("e_c", "e_a"), newLint("e_b", "e_c")},
+			lint:      newLint("e_a", "e_b"),
+			expectErr: &errPrerequisiteCycle{"e_a"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			reg := NewRegistry()
+			for _, l := range tc.existing {
+				if err := reg.registerCertificateLint(l); err != nil {
+					t.Fatalf("unexpected error registering %s: %v", l.Name, err)
+				}
+			}
+			err := reg.registerCertificateLint(tc.lint)
+			if err == nil && tc.expectErr != nil {
+				t.Errorf("expected err %v, got nil", tc.expectErr)
+			} else if err != nil && (tc.expectErr == nil || err.Error() != tc.expectErr.Error()) {
+				t.Errorf("expected err %v got %v", tc.expectErr, err)
+			}
+		})
+	}
+}
+
 func TestRegistryLookupEngine(t *testing.T) {
 	expectedNames := []string{
 		"A-mockCertificateLint",
 		"B-mockLint",
 		"C-mockRevocationListLint",
+		"D-mockChainLint",
 	}
 
 	expectedSources := []LintSource{
 		Community,
 		RFC3279,
+		RFC5480,
 		RFC8813,
 	}
 
@@ -196,6 +293,14 @@ func TestRegistryLookupEngine(t *testing.T) {
 		Lint: func() RevocationListLintInterface { return &mockRevocationListLint{} },
 	}
 
+	egChainLint := &ChainLint{
+		LintMetadata: LintMetadata{
+			Name:   "D-mockChainLint",
+			Source: RFC5480, // arbitrary value for testing
+		},
+		Lint: func() ChainLintInterface { return &mockChainLint{} },
+	}
+
 	registry := NewRegistry()
 	if err := registry.register(egLint); err != nil {
 		t.Fatalf("registry.register failed: %v", err)
@@ -206,6 +311,9 @@ func TestRegistryLookupEngine(t *testing.T) {
 	if err := registry.registerRevocationListLint(egRevocationListLint); err != nil {
 		t.Fatalf("registry.registerRevocationListLint failed: %v", err)
 	}
+	if err := registry.registerChainLint(egChainLint); err != nil {
+		t.Fatalf("registry.registerChainLint failed: %v", err)
+	}
 	t.Run("lint names are correct and sorted", func(t *testing.T) {
 		if !reflect.DeepEqual(registry.Names(), expectedNames) {
 			t.Fatalf("expected lint names: %v, got: %v", registry.Names(), expectedNames)
@@ -228,6 +336,7 @@ func TestRegistryLookupEngine(t *testing.T) {
 			deprecatedStore     bool
 			certificateStore    bool
 			revocationListStore bool
+			chainStore          bool
 		}{
 			{
 				name:                "A-mockCertificateLint",
@@ -247,6 +356,13 @@ func TestRegistryLookupEngine(t *testing.T) {
 				certificateStore:    false,
 				revocationListStore: true,
 			},
+			{
+				name:                "D-mockChainLint",
+				deprecatedStore:     false,
+				certificateStore:    false,
+				revocationListStore: false,
+				chainStore:          true,
+			},
 		}
 
 		for _, tc := range testCases {
@@ -268,6 +384,12 @@ func TestRegistryLookupEngine(t *testing.T) {
 					t.Fatalf("expected lint %s to be %t (true = present, false = absent) in revocationList store", tc.name, tc.revocationListStore)
 				}
 			}
+			{
+				lint := registry.ChainLints().ByName(tc.name)
+				if (lint != nil) != tc.chainStore {
+					t.Fatalf("expected lint %s to be %t (true = present, false = absent) in chain store", tc.name, tc.chainStore)
+				}
+			}
 		}
 	})
 }
@@ -484,3 +606,91 @@ func TestRegistryFilter(t *testing.T) {
 		})
 	}
 }
+
+func TestRegistryFilterTags(t *testing.T) {
+	registry := NewRegistry()
+	mustRegister := func(err error) {
+		if err != nil {
+			t.Fatalf("failed to register %v", err)
+		}
+	}
+	certLint := func(name string, source LintSource, tags ...string) *CertificateLint {
+		return &CertificateLint{
+			LintMetadata: LintMetadata{Name: name, Source: source, Tags: tags, EffectiveDate: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)},
+			Lint:         func() CertificateLintInterface { return &mockLint{} },
+		}
+	}
+	mustRegister(registry.registerCertificateLint(certLint("e_dns_example", CABFBaselineRequirements, "dns")))
+	mustRegister(registry.registerCertificateLint(certLint("e_dns_key_example", Community, "dns", "key")))
+	mustRegister(registry.registerCertificateLint(certLint("w_untagged_example", RFC5280)))
+	mustRegister(registry.registerRevocationListLint(&RevocationListLint{
+		LintMetadata: LintMetadata{Name: "e_crl_example", Source: RFC5280, Tags: []string{"time"}},
+		Lint:         func() RevocationListLintInterface { return &mockRevocationListLint{} },
+	}))
+
+	testCases := []struct {
+		name              string
+		opts              FilterOptions
+		expression        string
+		expectedLintNames []string
+	}{
+		{
+			name:              "IncludeTags",
+			opts:              FilterOptions{IncludeTags: []string{"dns", "time"}},
+			expectedLintNames: []string{"e_crl_example", "e_dns_example", "e_dns_key_example"},
+		},
+		{
+			name:              "ExcludeTags",
+			opts:              FilterOptions{ExcludeTags: []string{"key"}},
+			expectedLintNames: []string{"e_crl_example", "e_dns_example", "w_untagged_example"},
+		},
+		{
+			name:              "IncludeTags and ExcludeTags",
+			opts:              FilterOptions{IncludeTags: []string{"dns"}, ExcludeTags: []string{"key"}},
+			expectedLintNames: []string{"e_dns_example"},
+		},
+		{
+			name:              "tag expression",
+			expression:        "tag == dns && source != Community",
+			expectedLintNames: []string{"e_dns_example"},
+		},
+		{
+			name:              "type expression",
+			expression:        "type == crl",
+			expectedLintNames: []string{"e_crl_example"},
+		},
+		{
+			name:              "date expression",
+			expression:        "effective >= 2020-01-01 && !(name =~ ^e_dns_key)",
+			expectedLintNames: []string{"e_dns_example", "w_untagged_example"},
+		},
+		{
+			name:              "expression and IncludeSources",
+			opts:              FilterOptions{IncludeSources: SourceList{RFC5280}},
+			expression:        "tag != time",
+			expectedLintNames: []string{"w_untagged_example"},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.expression != "" {
+				expr, err := ParseFilterExpression(tc.expression)
+				if err != nil {
+					t.Fatalf("unexpected error parsing %q: %v", tc.expression, err)
+				}
+				tc.opts.Expression = expr
+			}
+			if tc.opts.Empty() {
+				t.Fatalf("FilterOptions %v was Empty()", tc.opts)
+			}
+			result, err := registry.Filter(tc.opts)
+			if err != nil {
+				t.Fatalf("Filter returned err for %v: %v", tc.opts, err)
+			}
+			if !reflect.DeepEqual(result.Names(), tc.expectedLintNames) {
+				t.Errorf("expected post-Filter Names %v got %v", tc.expectedLintNames, result.Names())
+			}
+		})
+	}
+}
@@ -18,6 +18,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+
+	"github.com/zmap/zlint/v3/util"
 )
 
 // LintStatus is an enum returned by lints inside of a LintResult.
@@ -41,6 +43,12 @@ const (
 	Warn   LintStatus = 5
 	Error  LintStatus = 6
 	Fatal  LintStatus = 7
+
+	// Skipped due to a failed prerequisite lint (see LintMetadata.Prerequisites)
+	Skipped LintStatus = 8
+
+	// Would fail once effective (see ExecutionOptions.Preview)
+	Upcoming LintStatus = 9
 )
 
 var (
@@ -56,17 +64,70 @@ var (
 		Warn.String():     Warn,
 		Error.String():    Error,
 		Fatal.String():    Fatal,
+		Skipped.String():  Skipped,
+		Upcoming.String(): Upcoming,
 	}
 )
 
 // LintResult contains a LintStatus, and an optional human-readable description.
 // The output of a lint is a LintResult.
 type LintResult struct {
-	Status       LintStatus   `json:"result"`
-	Details      string       `json:"details,omitempty"`
+	Status  LintStatus `json:"result"`
+	Details string     `json:"details,omitempty"`
+	// Findings optionally list each of the problems found by the lint, so that
+	// tooling need not parse Details. See NewResultFromFindings.
+	Findings     []Finding    `json:"findings,omitempty"`
 	LintMetadata LintMetadata `json:"-"`
 }
 
+// A Finding is a single problem found by a lint, such as one of several
+// offending names within a certificate.
+type Finding struct {
+	// Path locates the offending field within the linted object, e.g.
+	// "extensions.subjectAltName[3].dNSName".
+	Path string `json:"path"`
+	// Value is the offending value, if any.
+	Value string `json:"value,omitempty"`
+	// Expected describes the value or constraint that was expected, e.g.
+	// "at most 253 characters".
+	Expected string     `json:"expected,omitempty"`
+	Status   LintStatus `json:"result"`
+	// Location optionally locates the offending field within the DER encoding
+	// of the linted object, as found with a util.DERIndex.
+	Location *util.DERLocation `json:"location,omitempty"`
+}
+
+// String describes the finding in a form suitable for LintResult.Details.
+func (f Finding) String() string {
+	s := f.Path
+	if f.Value != "" {
+		s += fmt.Sprintf(" %q", f.Value)
+	}
+	if f.Expected != "" {
+		s += " (expected " + f.Expected + ")"
+	}
+	return s
+}
+
+// NewResultFromFindings returns a LintResult carrying the findings. Its Status
+// is the most severe status among the findings, or Pass if there are none, and
+// its Details lists every finding for consumers that only read Details.
+func NewResultFromFindings(findings []Finding) *LintResult {
+	if len(findings) == 0 {
+		return &LintResult{Status: Pass}
+	}
+	res := &LintResult{Findings: findings}
+	descriptions := make([]string, 0, len(findings))
+	for _, f := range findings {
+		if f.Status > res.Status {
+			res.Status = f.Status
+		}
+		descriptions = append(descriptions, f.String())
+	}
+	res.Details = strings.Join(descriptions, "; ")
+	return res
+}
+
 // MarshalJSON implements the json.Marshaler interface.
 func (e LintStatus) MarshalJSON() ([]byte, error) {
 	s := e.String()
@@ -103,6 +164,10 @@ func (e LintStatus) String() string {
 		return "error"
 	case Fatal:
 		return "fatal"
+	case Skipped:
+		return "skipped"
+	case Upcoming:
+		return "upcoming"
 	default:
 		return ""
 	}
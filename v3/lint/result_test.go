@@ -5,6 +5,32 @@ import (
 	"testing"
 )
 
+func TestNewResultFromFindings(t *testing.T) {
+	if res := NewResultFromFindings(nil); res.Status != Pass || res.Details != "" || res.Findings != nil {
+		t.Errorf("expected a bare pass without findings, got %+v", res)
+	}
+	findings := []Finding{
+		{Path: "extensions.subjectAltName[0].dNSName", Value: "a.example.com", Status: Notice},
+		{Path: "extensions.subjectAltName[2].dNSName", Value: "*", Expected: "a qualified wildcard", Status: Error},
+	}
+	res := NewResultFromFindings(findings)
+	if res.Status != Error {
+		t.Errorf("expected the most severe status %s, got %s", Error, res.Status)
+	}
+	want := `extensions.subjectAltName[0].dNSName "a.example.com"; extensions.subjectAltName[2].dNSName "*" (expected a qualified wildcard)`
+	if res.Details != want {
+		t.Errorf("expected details %q, got %q", want, res.Details)
+	}
+	j, err := json.Marshal(res.Findings[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantJSON := `{"path":"extensions.subjectAltName[2].dNSName","value":"*","expected":"a qualified wildcard","result":"error"}`
+	if string(j) != wantJSON {
+		t.Errorf("expected finding to marshal to %s, got %s", wantJSON, j)
+	}
+}
+
 func TestMarshalingLintStatus(t *testing.T) {
 	testCases := []struct {
 		result       LintStatus
@@ -42,6 +68,14 @@ func TestMarshalingLintStatus(t *testing.T) {
 			result:       Fatal,
 			expectedJSON: `"fatal"`,
 		},
+		{
+			result:       Skipped,
+			expectedJSON: `"skipped"`,
+		},
+		{
+			result:       Upcoming,
+			expectedJSON: `"upcoming"`,
+		},
 	}
 
 	for _, tc := range testCases {
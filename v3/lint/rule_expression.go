@@ -0,0 +1,520 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package lint
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zmap/zcrypto/x509"
+)
+
+// A RuleExpression is a boolean expression over a certificate, used by the
+// lints declared within rule files (see NewRuleLintsFromJSON). It is created
+// with ParseRuleExpression from a string such as:
+//
+//	"1.3.6.1.4.1.99999.1.1" in policies
+//	len(san.dns) <= 50 && all(san.dns, "\\.example\\.com$")
+//	key.algorithm != "RSA" || key.size == 3072
+//
+// Expressions are combined with && (and), || (or), ! (not) and parentheses,
+// where && binds more tightly than ||. Operands are the fields of the
+// certificate described below, double quoted strings, integers, dates of the
+// form YYYY-MM-DD, true, false, lists of strings such as ["a", "b"] and the
+// functions
+//
+//	len(x)         the number of elements of a list or characters of a string
+//	any(list, re)  true if any element of the list matches the regexp re
+//	all(list, re)  true if every element of the list matches the regexp re,
+//	               including when the list is empty
+//
+// Operands are compared with == and != (booleans, integers, strings and
+// dates), <, <=, > and >= (integers and dates), =~ and !~ (a string against a
+// regexp) and in (a string within a list, or a substring of a string).
+// Regexps must be given as strings, and are not anchored unless they begin
+// with ^ or end with $.
+//
+// The fields of the certificate are:
+//
+//	version                the X.509 version, e.g. 3
+//	serial                 the serial number in lowercase hexadecimal
+//	signatureAlgorithm     the signature algorithm, e.g. "SHA256-RSA"
+//	subject.dn             the subject distinguished name, e.g. "CN=a, O=b"
+//	subject.<attribute>    a list of the values of the attribute within the
+//	                       subject, one of commonName, serialNumber, country,
+//	                       organization, organizationalUnit,
+//	                       organizationIdentifier, locality, province,
+//	                       streetAddress, postalCode, domainComponent,
+//	                       emailAddress, givenName or surname
+//	issuer.dn              the issuer, as subject.dn
+//	issuer.<attribute>     the issuer, as subject.<attribute>
+//	san.dns                a list of the dNSNames of the subjectAltName
+//	san.email              a list of the rfc822Names of the subjectAltName
+//	san.ip                 a list of the iPAddresses of the subjectAltName
+//	san.uri                a list of the URIs of the subjectAltName
+//	validity.notBefore     the NotBefore date
+//	validity.notAfter      the NotAfter date
+//	validity.days          the validity period in days, rounded up, where
+//	                       NotAfter includes its final second
+//	key.algorithm          the public key algorithm: "RSA", "DSA", "ECDSA",
+//	                       "Ed25519" or "X25519"
+//	key.size               the size of the RSA modulus, DSA prime or ECDSA
+//	                       curve in bits, or 256 for Ed25519 keys
+//	key.curve              the ECDSA curve, e.g. "P-256", or "" for other keys
+//	key.exponent           the RSA public exponent, or 0 for other keys
+//	extensions             a list of the OIDs of each extension, e.g.
+//	                       "2.5.29.17"
+//	criticalExtensions     a list of the OIDs of each critical extension
+//	policies               a list of the certificate policy OIDs
+//	keyUsage               a list of the key usages: digitalSignature,
+//	                       contentCommitment, keyEncipherment,
+//	                       dataEncipherment, keyAgreement, keyCertSign,
+//	                       cRLSign, encipherOnly and decipherOnly
+//	extendedKeyUsage       a list of the extended key usages, named any,
+//	                       serverAuth, clientAuth, codeSigning,
+//	                       emailProtection, timeStamping or ocspSigning, or
+//	                       given as an OID
+//	ca                     the cA boolean of the basicConstraints
+//	pathLen                the pathLenConstraint, or -1 if there is none
+//	aia.ocsp               a list of the OCSP URLs of the
+//	                       authorityInformationAccess
+//	aia.caIssuers          a list of the caIssuers URLs of the
+//	                       authorityInformationAccess
+//	crlDistributionPoints  a list of the CRL distribution point URLs
+//	selfSigned             true if the certificate is self-signed
+//	root                   true if the certificate is a self-signed CA
+//	subCA                  true if the certificate is a CA that is not
+//	                       self-signed
+//	subscriber             true if the certificate is neither a CA nor
+//	                       self-signed
+//
+// Absent extensions and attributes are empty lists.
+type RuleExpression struct {
+	source string
+	root   ruleNode
+}
+
+// ParseRuleExpression parses the rule expression s, as described by
+// RuleExpression.
+func ParseRuleExpression(s string) (*RuleExpression, error) {
+	tokens, err := tokenize(s, "rule", "()![],")
+	if err != nil {
+		return nil, err
+	}
+	p := &ruleParser{expressionParser{tokens: tokens}}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if !p.done() {
+		return nil, fmt.Errorf("unexpected %q in rule", p.peek().text)
+	}
+	if root.typ() != boolRuleType {
+		return nil, fmt.Errorf("rule is a %s rather than a boolean", root.typ())
+	}
+	return &RuleExpression{source: s, root: root}, nil
+}
+
+// String returns the rule expression as it was parsed.
+func (e *RuleExpression) String() string {
+	return e.source
+}
+
+// Evaluate returns true if the certificate c satisfies the expression.
+func (e *RuleExpression) Evaluate(c *x509.Certificate) bool {
+	return e.root.eval(c).(bool)
+}
+
+// A ruleNode is a typed node of a RuleExpression. The value returned by eval
+// is of the Go type corresponding to typ, as for ruleField.
+type ruleNode interface {
+	typ() ruleType
+	eval(c *x509.Certificate) interface{}
+}
+
+type ruleLiteralNode struct {
+	t     ruleType
+	value interface{}
+}
+
+func (n ruleLiteralNode) typ() ruleType                        { return n.t }
+func (n ruleLiteralNode) eval(c *x509.Certificate) interface{} { return n.value }
+
+type ruleFieldNode struct{ field ruleField }
+
+func (n ruleFieldNode) typ() ruleType                        { return n.field.typ }
+func (n ruleFieldNode) eval(c *x509.Certificate) interface{} { return n.field.get(c) }
+
+type ruleAndNode struct{ left, right ruleNode }
+
+func (n ruleAndNode) typ() ruleType { return boolRuleType }
+func (n ruleAndNode) eval(c *x509.Certificate) interface{} {
+	return n.left.eval(c).(bool) && n.right.eval(c).(bool)
+}
+
+type ruleOrNode struct{ left, right ruleNode }
+
+func (n ruleOrNode) typ() ruleType { return boolRuleType }
+func (n ruleOrNode) eval(c *x509.Certificate) interface{} {
+	return n.left.eval(c).(bool) || n.right.eval(c).(bool)
+}
+
+type ruleNotNode struct{ operand ruleNode }
+
+func (n ruleNotNode) typ() ruleType { return boolRuleType }
+func (n ruleNotNode) eval(c *x509.Certificate) interface{} {
+	return !n.operand.eval(c).(bool)
+}
+
+// ruleComparisonNode compares two operands of the same type, except for in,
+// which looks for a string within a list or string, and =~ and !~, which
+// match a string against the regexp re.
+type ruleComparisonNode struct {
+	op          string
+	left, right ruleNode
+	re          *regexp.Regexp
+}
+
+func (n ruleComparisonNode) typ() ruleType { return boolRuleType }
+
+func (n ruleComparisonNode) eval(c *x509.Certificate) interface{} {
+	left := n.left.eval(c)
+	switch n.op {
+	case "=~":
+		return n.re.MatchString(left.(string))
+	case "!~":
+		return !n.re.MatchString(left.(string))
+	}
+	right := n.right.eval(c)
+	switch n.op {
+	case "in":
+		if list, ok := right.([]string); ok {
+			for _, elem := range list {
+				if elem == left.(string) {
+					return true
+				}
+			}
+			return false
+		}
+		return strings.Contains(right.(string), left.(string))
+	case "==":
+		if t, ok := left.(time.Time); ok {
+			return t.Equal(right.(time.Time))
+		}
+		return left == right
+	case "!=":
+		if t, ok := left.(time.Time); ok {
+			return !t.Equal(right.(time.Time))
+		}
+		return left != right
+	}
+	if t, ok := left.(time.Time); ok {
+		return compareDates(n.op, t, right.(time.Time))
+	}
+	a, b := left.(int), right.(int)
+	switch n.op {
+	case "<":
+		return a < b
+	case "<=":
+		return a <= b
+	case ">":
+		return a > b
+	case ">=":
+		return a >= b
+	}
+	return false
+}
+
+// ruleCallNode calls one of the functions len, any or all. The regexp
+// argument of any and all is compiled as re.
+type ruleCallNode struct {
+	function string
+	args     []ruleNode
+	re       *regexp.Regexp
+}
+
+func (n ruleCallNode) typ() ruleType {
+	if n.function == "len" {
+		return intRuleType
+	}
+	return boolRuleType
+}
+
+func (n ruleCallNode) eval(c *x509.Certificate) interface{} {
+	arg := n.args[0].eval(c)
+	switch n.function {
+	case "len":
+		if list, ok := arg.([]string); ok {
+			return len(list)
+		}
+		return len([]rune(arg.(string)))
+	case "any":
+		for _, elem := range arg.([]string) {
+			if n.re.MatchString(elem) {
+				return true
+			}
+		}
+		return false
+	case "all":
+		for _, elem := range arg.([]string) {
+			if !n.re.MatchString(elem) {
+				return false
+			}
+		}
+		return true
+	}
+	return nil
+}
+
+// ruleFunctionArgs lists the number of arguments of each function.
+var ruleFunctionArgs = map[string]int{
+	"len": 1,
+	"any": 2,
+	"all": 2,
+}
+
+type ruleParser struct {
+	expressionParser
+}
+
+// next consumes and returns the next token, which must exist.
+func (p *ruleParser) next() (expressionToken, error) {
+	if p.done() {
+		return expressionToken{}, fmt.Errorf("unexpected end of rule")
+	}
+	t := p.tokens[p.pos]
+	p.pos++
+	return t, nil
+}
+
+func (p *ruleParser) parseOr() (ruleNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.accept("||") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		if err := requireBooleans("||", left, right); err != nil {
+			return nil, err
+		}
+		left = ruleOrNode{left, right}
+	}
+	return left, nil
+}
+
+func (p *ruleParser) parseAnd() (ruleNode, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.accept("&&") {
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		if err := requireBooleans("&&", left, right); err != nil {
+			return nil, err
+		}
+		left = ruleAndNode{left, right}
+	}
+	return left, nil
+}
+
+func (p *ruleParser) parseUnary() (ruleNode, error) {
+	if p.accept("!") {
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		if err := requireBooleans("!", operand); err != nil {
+			return nil, err
+		}
+		return ruleNotNode{operand}, nil
+	}
+	return p.parseComparison()
+}
+
+func (p *ruleParser) parseComparison() (ruleNode, error) {
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	op := p.peek()
+	if op.kind != operatorToken && !(op.kind == wordToken && op.text == "in") {
+		return left, nil
+	}
+	p.pos++
+	right, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	node := ruleComparisonNode{op: op.text, left: left, right: right}
+	switch op.text {
+	case "in":
+		if left.typ() != stringRuleType || (right.typ() != listRuleType && right.typ() != stringRuleType) {
+			return nil, fmt.Errorf("in requires a string and a list or string in rule, not a %s and a %s", left.typ(), right.typ())
+		}
+	case "=~", "!~":
+		if left.typ() != stringRuleType {
+			return nil, fmt.Errorf("%s requires a string in rule, not a %s", op.text, left.typ())
+		}
+		if node.re, err = literalRegexp(right); err != nil {
+			return nil, err
+		}
+	case "==", "!=":
+		if left.typ() != right.typ() || left.typ() == listRuleType {
+			return nil, fmt.Errorf("a %s can not be compared with a %s using %s in rule", left.typ(), right.typ(), op.text)
+		}
+	default:
+		if left.typ() != right.typ() || (left.typ() != intRuleType && left.typ() != timeRuleType) {
+			return nil, fmt.Errorf("a %s can not be compared with a %s using %s in rule", left.typ(), right.typ(), op.text)
+		}
+	}
+	return node, nil
+}
+
+func (p *ruleParser) parseOperand() (ruleNode, error) {
+	if p.accept("(") {
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if !p.accept(")") {
+			return nil, fmt.Errorf("missing ) in rule")
+		}
+		return inner, nil
+	}
+	if p.accept("[") {
+		return p.parseList()
+	}
+	t, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	switch t.kind {
+	case stringToken:
+		return ruleLiteralNode{stringRuleType, t.text}, nil
+	case wordToken:
+	default:
+		return nil, fmt.Errorf("unexpected %q in rule", t.text)
+	}
+	if _, ok := ruleFunctionArgs[t.text]; ok && p.accept("(") {
+		return p.parseCall(t.text)
+	}
+	if field, ok := ruleFields[t.text]; ok {
+		return ruleFieldNode{field}, nil
+	}
+	switch t.text {
+	case "true":
+		return ruleLiteralNode{boolRuleType, true}, nil
+	case "false":
+		return ruleLiteralNode{boolRuleType, false}, nil
+	}
+	if i, err := strconv.Atoi(t.text); err == nil {
+		return ruleLiteralNode{intRuleType, i}, nil
+	}
+	if date, err := time.Parse("2006-01-02", t.text); err == nil {
+		return ruleLiteralNode{timeRuleType, date}, nil
+	}
+	return nil, fmt.Errorf("unknown field %q in rule", t.text)
+}
+
+// parseList parses the strings of a list following its opening [.
+func (p *ruleParser) parseList() (ruleNode, error) {
+	list := []string{}
+	if p.accept("]") {
+		return ruleLiteralNode{listRuleType, list}, nil
+	}
+	for {
+		t, err := p.next()
+		if err != nil {
+			return nil, err
+		}
+		if t.kind != stringToken {
+			return nil, fmt.Errorf("list in rule may only contain strings, not %q", t.text)
+		}
+		list = append(list, t.text)
+		if p.accept("]") {
+			return ruleLiteralNode{listRuleType, list}, nil
+		}
+		if !p.accept(",") {
+			return nil, fmt.Errorf("missing ] in rule")
+		}
+	}
+}
+
+// parseCall parses the arguments of a call to function following its
+// opening parenthesis.
+func (p *ruleParser) parseCall(function string) (ruleNode, error) {
+	node := ruleCallNode{function: function}
+	for !p.accept(")") {
+		if len(node.args) > 0 && !p.accept(",") {
+			return nil, fmt.Errorf("missing ) after the arguments of %s in rule", function)
+		}
+		arg, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		node.args = append(node.args, arg)
+	}
+	if len(node.args) != ruleFunctionArgs[function] {
+		return nil, fmt.Errorf("%s takes %d arguments in rule, not %d", function, ruleFunctionArgs[function], len(node.args))
+	}
+	switch function {
+	case "len":
+		if t := node.args[0].typ(); t != listRuleType && t != stringRuleType {
+			return nil, fmt.Errorf("len requires a list or string in rule, not a %s", t)
+		}
+	case "any", "all":
+		if t := node.args[0].typ(); t != listRuleType {
+			return nil, fmt.Errorf("%s requires a list in rule, not a %s", function, t)
+		}
+		var err error
+		if node.re, err = literalRegexp(node.args[1]); err != nil {
+			return nil, err
+		}
+	}
+	return node, nil
+}
+
+// literalRegexp compiles the string literal n as a regexp.
+func literalRegexp(n ruleNode) (*regexp.Regexp, error) {
+	literal, ok := n.(ruleLiteralNode)
+	if !ok || literal.t != stringRuleType {
+		return nil, fmt.Errorf("regexp in rule must be a string")
+	}
+	re, err := regexp.Compile(literal.value.(string))
+	if err != nil {
+		return nil, fmt.Errorf("bad regexp in rule: %w", err)
+	}
+	return re, nil
+}
+
+// requireBooleans returns an error unless each of the operands of op is a
+// boolean.
+func requireBooleans(op string, operands ...ruleNode) error {
+	for _, operand := range operands {
+		if operand.typ() != boolRuleType {
+			return fmt.Errorf("%s requires booleans in rule, not a %s", op, operand.typ())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package lint
+
+import (
+	"crypto/rsa"
+	"math/big"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/zmap/zcrypto/encoding/asn1"
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zcrypto/x509/pkix"
+)
+
+// ruleTestCertificate returns a TLS subscriber certificate with an RSA key for
+// the evaluation of rules.
+func ruleTestCertificate(t *testing.T) *x509.Certificate {
+	eku, err := asn1.Marshal([]asn1.ObjectIdentifier{{1, 3, 6, 1, 5, 5, 7, 3, 1}, {1, 2, 3, 4}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	extensions := []pkix.Extension{
+		{Id: asn1.ObjectIdentifier{2, 5, 29, 15}, Critical: true},
+		{Id: asn1.ObjectIdentifier{2, 5, 29, 17}},
+		{Id: asn1.ObjectIdentifier{2, 5, 29, 37}, Value: eku},
+	}
+	c := &x509.Certificate{
+		Version:            3,
+		SerialNumber:       big.NewInt(0xbeef),
+		SignatureAlgorithm: x509.SHA256WithRSA,
+		Subject: pkix.Name{
+			CommonName:   "www.example.com",
+			Organization: []string{"Example Inc"},
+			Country:      []string{"US"},
+		},
+		Issuer:             pkix.Name{CommonName: "Example CA"},
+		DNSNames:           []string{"www.example.com", "example.com"},
+		IPAddresses:        []net.IP{net.ParseIP("192.0.2.1")},
+		NotBefore:          time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+		NotAfter:           time.Date(2024, time.April, 9, 23, 59, 59, 0, time.UTC),
+		PublicKeyAlgorithm: x509.RSA,
+		PublicKey:          &rsa.PublicKey{N: new(big.Int).Lsh(big.NewInt(1), 3071), E: 65537},
+		KeyUsage:           x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		PolicyIdentifiers:  []asn1.ObjectIdentifier{{2, 23, 140, 1, 2, 2}, {1, 3, 6, 1, 4, 1, 99999, 1, 1}},
+		OCSPServer:         []string{"http://ocsp.example.com"},
+		Extensions:         extensions,
+		ExtensionsMap:      map[string]pkix.Extension{},
+	}
+	for _, ext := range extensions {
+		c.ExtensionsMap[ext.Id.String()] = ext
+	}
+	return c
+}
+
+func TestRuleExpression(t *testing.T) {
+	c := ruleTestCertificate(t)
+	testCases := []struct {
+		expression string
+		want       bool
+	}{
+		{`version == 3`, true},
+		{`serial == "beef"`, true},
+		{`signatureAlgorithm == "SHA256-RSA"`, true},
+		{`subject.dn =~ "O=Example Inc"`, true},
+		{`"www.example.com" in subject.commonName`, true},
+		{`"US" in subject.country`, true},
+		{`len(subject.organizationalUnit) == 0`, true},
+		{`"Example" in issuer.dn`, true},
+		{`len(san.dns) <= 50`, true},
+		{`len(san.dns) > 1`, true},
+		{`all(san.dns, "(^|\\.)example\\.com$")`, true},
+		{`any(san.dns, "^www\\.")`, true},
+		{`all(san.email, "^$")`, true},
+		{`"192.0.2.1" in san.ip`, true},
+		{`validity.notBefore >= 2024-01-01`, true},
+		{`validity.notAfter < 2024-04-09`, false},
+		{`validity.notBefore == 2024-01-01`, true},
+		{`validity.days == 100`, true},
+		{`validity.days <= 90`, false},
+		{`key.algorithm == "RSA" && key.size == 3072`, true},
+		{`key.algorithm != "RSA" || key.size == 2048`, false},
+		{`key.exponent == 65537 && key.curve == ""`, true},
+		{`"2.5.29.17" in extensions`, true},
+		{`"2.5.29.17" in criticalExtensions`, false},
+		{`"2.5.29.15" in criticalExtensions`, true},
+		{`"1.3.6.1.4.1.99999.1.1" in policies`, true},
+		{`"digitalSignature" in keyUsage && !("keyCertSign" in keyUsage)`, true},
+		{`"serverAuth" in extendedKeyUsage && "1.2.3.4" in extendedKeyUsage`, true},
+		{`"clientAuth" in extendedKeyUsage`, false},
+		{`subscriber && !ca && pathLen == -1`, true},
+		{`root || subCA || selfSigned`, false},
+		{`len(aia.ocsp) == 1 && len(aia.caIssuers) == 0`, true},
+		{`len(crlDistributionPoints) > 0`, false},
+		{`"serverAuth" in ["serverAuth", "clientAuth"]`, true},
+		{`subscriber == true`, true},
+		{`len("héllo") == 5`, true},
+		{`!subscriber || (ca || len(san.dns) > 0)`, true},
+		{`subscriber && ca || len(san.dns) > 0`, true},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.expression, func(t *testing.T) {
+			expr, err := ParseRuleExpression(tc.expression)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := expr.Evaluate(c); got != tc.want {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+			if expr.String() != tc.expression {
+				t.Errorf("expected String() to return %q, got %q", tc.expression, expr.String())
+			}
+		})
+	}
+}
+
+func TestParseRuleExpressionErrors(t *testing.T) {
+	testCases := []struct {
+		expression string
+		wantErr    string
+	}{
+		{``, "unexpected end"},
+		{`len(san.dns)`, "rather than a boolean"},
+		{`colour == "red"`, "unknown field"},
+		{`version == "3"`, "can not be compared"},
+		{`subject.country == ["US"]`, "can not be compared"},
+		{`serial < "ff"`, "can not be compared"},
+		{`3 in san.dns`, "in requires a string"},
+		{`san.dns =~ "x"`, "=~ requires a string"},
+		{`serial =~ subject.dn`, "must be a string"},
+		{`serial =~ "("`, "bad regexp"},
+		{`all(san.dns)`, "all takes 2 arguments"},
+		{`any(serial, "x")`, "any requires a list"},
+		{`len(3) == 1`, "len requires a list or string"},
+		{`ca && version`, "&& requires booleans"},
+		{`!version`, "! requires booleans"},
+		{`(ca`, "missing )"},
+		{`ca)`, `unexpected ")"`},
+		{`"a" in ["a" "b"]`, "missing ]"},
+		{`"a" in [ca]`, "may only contain strings"},
+		{`serial == "unterminated`, "unterminated string in rule"},
+		{`ca & selfSigned`, `unexpected "&" in rule`},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.expression, func(t *testing.T) {
+			_, err := ParseRuleExpression(tc.expression)
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Errorf("expected an error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,295 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pelletier/go-toml"
+	"github.com/zmap/zcrypto/x509"
+)
+
+// ruleDefinition is the form in which a lint is declared within a rule file.
+type ruleDefinition struct {
+	Name            string     `json:"name" toml:"name"`
+	Description     string     `json:"description" toml:"description"`
+	Citation        string     `json:"citation" toml:"citation"`
+	Source          LintSource `json:"source" toml:"source"`
+	Severity        string     `json:"severity" toml:"severity"`
+	Remediation     string     `json:"remediation" toml:"remediation"`
+	Tags            []string   `json:"tags" toml:"tags"`
+	EffectiveDate   string     `json:"effective_date" toml:"effective_date"`
+	IneffectiveDate string     `json:"ineffective_date" toml:"ineffective_date"`
+	Applies         string     `json:"applies" toml:"applies"`
+	Assert          string     `json:"assert" toml:"assert"`
+	Details         string     `json:"details" toml:"details"`
+}
+
+// ruleFile is the top level of a rule file.
+type ruleFile struct {
+	Lints []ruleDefinition `json:"lints" toml:"lints"`
+}
+
+// ruleSeverities maps the severity of a rule to the status of its failures
+// and the prefix of its name.
+var ruleSeverities = map[string]struct {
+	status LintStatus
+	prefix string
+}{
+	"notice": {Notice, "n_"},
+	"warn":   {Warn, "w_"},
+	"error":  {Error, "e_"},
+}
+
+// NewRuleLintsFromJSON reads the lints declared within a JSON rule file, e.g.
+//
+// ```
+//
+//	{
+//	  "lints": [
+//	    {
+//	      "name": "e_example_tls_policy_missing",
+//	      "description": "TLS subscriber certificates must assert the Example CA TLS policy",
+//	      "citation": "Example CA CP/CPS: 7.1.6.4",
+//	      "severity": "error",
+//	      "remediation": "Add 1.3.6.1.4.1.99999.1.1 to the certificatePolicies of the TLS profile.",
+//	      "effective_date": "2024-01-01",
+//	      "applies": "subscriber && \"serverAuth\" in extendedKeyUsage",
+//	      "assert": "\"1.3.6.1.4.1.99999.1.1\" in policies"
+//	    }
+//	  ]
+//	}
+//
+// ```
+//
+// Each lint fails a certificate to which its "applies" RuleExpression applies,
+// or every certificate if it has none, unless the certificate satisfies its
+// "assert" RuleExpression. A failure has the status given by "severity",
+// which is one of notice, warn or error, and is described by "details", which
+// defaults to the assertion that was not satisfied. The name of a lint must
+// begin with n_, w_ or e_ according to its severity, and its severity defaults
+// to that given by its name. The source of a lint defaults to Custom, and its
+// dates are of the form YYYY-MM-DD.
+//
+// The name of a lint must not be that of any lint within the global registry.
+// The returned lints are not registered.
+func NewRuleLintsFromJSON(r io.Reader) ([]*CertificateLint, error) {
+	var file ruleFile
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&file); err != nil {
+		return nil, fmt.Errorf("failed to parse rules: %w", err)
+	}
+	return resolveRules(file.Lints)
+}
+
+// NewRuleLintsFromTOML reads the lints declared within a TOML rule file, e.g.
+//
+// ```
+//
+//	[[lints]]
+//	name = "w_example_too_many_sans"
+//	description = "Certificates should not contain more than 50 DNS names"
+//	citation = "Example CA CP/CPS: 7.1.2.7"
+//	remediation = "Split the DNS names between several certificates."
+//	assert = "len(san.dns) <= 50"
+//
+// ```
+//
+// See NewRuleLintsFromJSON for how lints are declared.
+func NewRuleLintsFromTOML(r io.Reader) ([]*CertificateLint, error) {
+	var file ruleFile
+	if err := toml.NewDecoder(r).Strict(true).Decode(&file); err != nil {
+		return nil, fmt.Errorf("failed to parse rules: %w", err)
+	}
+	for i := range file.Lints {
+		if src := file.Lints[i].Source; src != "" {
+			file.Lints[i].Source.FromString(string(src))
+			if file.Lints[i].Source == UnknownLintSource {
+				return nil, fmt.Errorf("lint %q has an unknown source %q", file.Lints[i].Name, src)
+			}
+		}
+	}
+	return resolveRules(file.Lints)
+}
+
+// NewRuleLintsFromFile reads the lints declared within the rule file at path.
+// Files with a ".toml" extension are read with NewRuleLintsFromTOML and all
+// others with NewRuleLintsFromJSON.
+func NewRuleLintsFromFile(path string) ([]*CertificateLint, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the provided rules at %s. Error: %s", path, err.Error())
+	}
+	defer f.Close()
+	if strings.EqualFold(filepath.Ext(path), ".toml") {
+		return NewRuleLintsFromTOML(f)
+	}
+	return NewRuleLintsFromJSON(f)
+}
+
+// RegisterRuleLintsFromFiles registers the lints declared within the rule
+// files at paths with the global registry, as read by NewRuleLintsFromFile.
+// Each path is trimmed of surrounding whitespace and blank paths are skipped,
+// such that a comma-separated list may be split and passed as is. No lint is
+// registered unless every file can be read.
+func RegisterRuleLintsFromFiles(paths []string) error {
+	var lints []*CertificateLint
+	for _, path := range paths {
+		if path = strings.TrimSpace(path); path == "" {
+			continue
+		}
+		l, err := NewRuleLintsFromFile(path)
+		if err != nil {
+			return err
+		}
+		lints = append(lints, l...)
+	}
+	for _, l := range lints {
+		if err := globalRegistry.registerCertificateLint(l); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolveRules creates a lint from each of the definitions, in the order in
+// which they were declared.
+func resolveRules(definitions []ruleDefinition) ([]*CertificateLint, error) {
+	known := make(map[string]bool)
+	for _, name := range GlobalRegistry().Names() {
+		known[name] = true
+	}
+	seen := make(map[string]bool, len(definitions))
+	lints := make([]*CertificateLint, 0, len(definitions))
+	for i, def := range definitions {
+		if def.Name == "" {
+			return nil, fmt.Errorf("lint %d has no name", i+1)
+		}
+		if seen[def.Name] {
+			return nil, fmt.Errorf("lint %q is declared more than once", def.Name)
+		}
+		seen[def.Name] = true
+		if known[def.Name] {
+			return nil, fmt.Errorf("lint %q conflicts with a registered lint of the same name", def.Name)
+		}
+		l, err := newRuleLint(def)
+		if err != nil {
+			return nil, fmt.Errorf("lint %q: %w", def.Name, err)
+		}
+		lints = append(lints, l)
+	}
+	return lints, nil
+}
+
+// newRuleLint creates the lint declared by def.
+func newRuleLint(def ruleDefinition) (*CertificateLint, error) {
+	if def.Description == "" {
+		return nil, fmt.Errorf("no description")
+	}
+	severity := def.Severity
+	if severity == "" {
+		for name, s := range ruleSeverities {
+			if strings.HasPrefix(def.Name, s.prefix) {
+				severity = name
+			}
+		}
+		if severity == "" {
+			return nil, fmt.Errorf("the name of a lint must begin with n_, w_ or e_")
+		}
+	}
+	s, ok := ruleSeverities[severity]
+	if !ok {
+		return nil, fmt.Errorf("unknown severity %q, expected notice, warn or error", def.Severity)
+	}
+	if !strings.HasPrefix(def.Name, s.prefix) {
+		return nil, fmt.Errorf("the name of a lint with severity %s must begin with %s", severity, s.prefix)
+	}
+	source := def.Source
+	if source == "" {
+		source = Custom
+	}
+	effective, err := parseRuleDate(def.EffectiveDate)
+	if err != nil {
+		return nil, fmt.Errorf("bad effective_date: %w", err)
+	}
+	ineffective, err := parseRuleDate(def.IneffectiveDate)
+	if err != nil {
+		return nil, fmt.Errorf("bad ineffective_date: %w", err)
+	}
+	impl := &ruleLint{status: s.status, details: def.Details}
+	if def.Applies != "" {
+		if impl.applies, err = ParseRuleExpression(def.Applies); err != nil {
+			return nil, fmt.Errorf("bad applies: %w", err)
+		}
+	}
+	if def.Assert == "" {
+		return nil, fmt.Errorf("no assert")
+	}
+	if impl.assert, err = ParseRuleExpression(def.Assert); err != nil {
+		return nil, fmt.Errorf("bad assert: %w", err)
+	}
+	if impl.details == "" {
+		impl.details = fmt.Sprintf("certificate does not satisfy %s", def.Assert)
+	}
+	return &CertificateLint{
+		LintMetadata: LintMetadata{
+			Name:            def.Name,
+			Description:     def.Description,
+			Citation:        def.Citation,
+			Source:          source,
+			Tags:            def.Tags,
+			Remediation:     def.Remediation,
+			EffectiveDate:   effective,
+			IneffectiveDate: ineffective,
+		},
+		Lint: func() CertificateLintInterface { return impl },
+	}, nil
+}
+
+// parseRuleDate parses a date of the form YYYY-MM-DD, or returns the zero time
+// for an empty date.
+func parseRuleDate(date string) (time.Time, error) {
+	if date == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse("2006-01-02", date)
+}
+
+// ruleLint is the implementation of a lint declared within a rule file. It
+// holds no state, so a single instance is shared by every run of the lint.
+type ruleLint struct {
+	applies *RuleExpression
+	assert  *RuleExpression
+	status  LintStatus
+	details string
+}
+
+func (l *ruleLint) CheckApplies(c *x509.Certificate) bool {
+	return l.applies == nil || l.applies.Evaluate(c)
+}
+
+func (l *ruleLint) Execute(c *x509.Certificate) *LintResult {
+	if l.assert.Evaluate(c) {
+		return &LintResult{Status: Pass}
+	}
+	return &LintResult{Status: l.status, Details: l.details}
+}
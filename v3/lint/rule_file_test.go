@@ -0,0 +1,192 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package lint
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewRuleLintsFromJSON(t *testing.T) {
+	lints, err := NewRuleLintsFromJSON(strings.NewReader(`{
+	  "lints": [
+	    {
+	      "name": "e_example_tls_policy_missing",
+	      "description": "TLS subscriber certificates must assert the Example CA TLS policy",
+	      "citation": "Example CA CP/CPS: 7.1.6.4",
+	      "severity": "error",
+	      "remediation": "Add the Example CA TLS policy to the certificate profile.",
+	      "tags": ["policy"],
+	      "effective_date": "2023-06-01",
+	      "applies": "subscriber && \"serverAuth\" in extendedKeyUsage",
+	      "assert": "\"1.3.6.1.4.1.99999.1.2\" in policies"
+	    },
+	    {
+	      "name": "w_example_rsa_key_size",
+	      "description": "RSA keys should be exactly 3072 bits",
+	      "source": "Community",
+	      "applies": "key.algorithm == \"RSA\"",
+	      "assert": "key.size == 3072",
+	      "details": "RSA key is not 3072 bits"
+	    },
+	    {
+	      "name": "n_example_not_yet_effective",
+	      "description": "Never satisfied",
+	      "effective_date": "2030-01-01",
+	      "assert": "false"
+	    }
+	  ]
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lints) != 3 {
+		t.Fatalf("expected 3 lints, got %d", len(lints))
+	}
+
+	policy := lints[0]
+	if policy.Source != Custom || policy.Citation != "Example CA CP/CPS: 7.1.6.4" || policy.Remediation == "" || len(policy.Tags) != 1 {
+		t.Errorf("unexpected metadata %+v", policy.LintMetadata)
+	}
+	if want := time.Date(2023, time.June, 1, 0, 0, 0, 0, time.UTC); !policy.EffectiveDate.Equal(want) {
+		t.Errorf("expected an effective date of %v, got %v", want, policy.EffectiveDate)
+	}
+	c := ruleTestCertificate(t)
+	res := policy.Execute(c, NewEmptyConfig())
+	if res.Status != Error || res.Details != `certificate does not satisfy "1.3.6.1.4.1.99999.1.2" in policies` {
+		t.Errorf("expected the policy lint to fail, got %+v", res)
+	}
+	c.PolicyIdentifiers = nil
+	c.DNSNames = nil
+	c.IsCA = true
+	if res := policy.Execute(c, NewEmptyConfig()); res.Status != NA {
+		t.Errorf("expected the policy lint not to apply to a CA, got %+v", res)
+	}
+
+	size := lints[1]
+	if size.Source != Community {
+		t.Errorf("expected the source Community, got %s", size.Source)
+	}
+	if res := size.Execute(ruleTestCertificate(t), NewEmptyConfig()); res.Status != Pass {
+		t.Errorf("expected the key size lint to pass, got %+v", res)
+	}
+	c = ruleTestCertificate(t)
+	c.PublicKey = nil
+	if res := size.Execute(c, NewEmptyConfig()); res.Status != Warn || res.Details != "RSA key is not 3072 bits" {
+		t.Errorf("expected the key size lint to warn, got %+v", res)
+	}
+
+	if res := lints[2].Execute(ruleTestCertificate(t), NewEmptyConfig()); res.Status != NE {
+		t.Errorf("expected the lint to be not effective, got %+v", res)
+	}
+}
+
+func TestNewRuleLintsFromTOML(t *testing.T) {
+	lints, err := NewRuleLintsFromTOML(strings.NewReader(`
+[[lints]]
+name = "w_example_too_many_sans"
+description = "Certificates should not contain more than one DNS name"
+citation = "Example CA CP/CPS: 7.1.2.7"
+source = "Community"
+assert = "len(san.dns) <= 1"
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lints) != 1 || lints[0].Name != "w_example_too_many_sans" || lints[0].Source != Community {
+		t.Fatalf("unexpected lints %+v", lints)
+	}
+	if res := lints[0].Execute(ruleTestCertificate(t), NewEmptyConfig()); res.Status != Warn {
+		t.Errorf("expected the lint to warn, got %+v", res)
+	}
+
+	_, err = NewRuleLintsFromTOML(strings.NewReader(`
+[[lints]]
+name = "w_example"
+description = "Example"
+source = "Example CA"
+assert = "true"
+`))
+	if err == nil || !strings.Contains(err.Error(), "unknown source") {
+		t.Errorf("expected an unknown source error, got %v", err)
+	}
+}
+
+func TestRegisterRuleLintsFromFiles(t *testing.T) {
+	dir := t.TempDir()
+	jsonPath := filepath.Join(dir, "rules.json")
+	tomlPath := filepath.Join(dir, "rules.toml")
+	if err := os.WriteFile(jsonPath, []byte(`{"lints": [{"name": "e_example_registered_from_json", "description": "a", "assert": "true"}]}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(tomlPath, []byte(`
+[[lints]]
+name = "w_example_registered_from_toml"
+description = "a"
+assert = "true"
+`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	err := RegisterRuleLintsFromFiles([]string{filepath.Join(dir, "missing.json"), jsonPath})
+	if err == nil || !strings.Contains(err.Error(), "missing.json") {
+		t.Errorf("expected an error for the missing file, got %v", err)
+	}
+	if GlobalRegistry().CertificateLints().ByName("e_example_registered_from_json") != nil {
+		t.Error("expected no lints to be registered when a file can not be read")
+	}
+
+	if err := RegisterRuleLintsFromFiles([]string{" " + jsonPath, "", "  ", tomlPath + " "}); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"e_example_registered_from_json", "w_example_registered_from_toml"} {
+		if GlobalRegistry().CertificateLints().ByName(name) == nil {
+			t.Errorf("expected %s to be registered", name)
+		}
+	}
+}
+
+func TestNewRuleLintsErrors(t *testing.T) {
+	testCases := []struct {
+		name    string
+		rules   string
+		wantErr string
+	}{
+		{"unknown field", `{"lints": [{"name": "e_a", "colour": "red"}]}`, "unknown field"},
+		{"no name", `{"lints": [{"description": "a", "assert": "true"}]}`, "has no name"},
+		{"duplicate", `{"lints": [{"name": "e_a", "description": "a", "assert": "true"}, {"name": "e_a", "description": "a", "assert": "true"}]}`, "declared more than once"},
+		{"no description", `{"lints": [{"name": "e_a", "assert": "true"}]}`, "no description"},
+		{"no prefix", `{"lints": [{"name": "a", "description": "a", "assert": "true"}]}`, "must begin with n_, w_ or e_"},
+		{"bad severity", `{"lints": [{"name": "e_a", "description": "a", "severity": "fatal", "assert": "true"}]}`, "unknown severity"},
+		{"wrong prefix", `{"lints": [{"name": "w_a", "description": "a", "severity": "error", "assert": "true"}]}`, "must begin with e_"},
+		{"bad source", `{"lints": [{"name": "e_a", "description": "a", "source": "Example", "assert": "true"}]}`, "unknown LintSource"},
+		{"bad date", `{"lints": [{"name": "e_a", "description": "a", "effective_date": "2024", "assert": "true"}]}`, "bad effective_date"},
+		{"bad applies", `{"lints": [{"name": "e_a", "description": "a", "applies": "colour", "assert": "true"}]}`, "bad applies"},
+		{"no assert", `{"lints": [{"name": "e_a", "description": "a"}]}`, "no assert"},
+		{"bad assert", `{"lints": [{"name": "e_a", "description": "a", "assert": "version"}]}`, "bad assert"},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewRuleLintsFromJSON(strings.NewReader(tc.rules))
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Errorf("expected an error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
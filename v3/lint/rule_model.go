@@ -0,0 +1,299 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package lint
+
+import (
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"time"
+
+	"github.com/zmap/zcrypto/dsa"
+	"github.com/zmap/zcrypto/encoding/asn1"
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zcrypto/x509/pkix"
+	"github.com/zmap/zlint/v3/util"
+	"golang.org/x/crypto/ed25519"
+)
+
+// ruleType is the type of a value within a RuleExpression.
+type ruleType int
+
+const (
+	boolRuleType ruleType = iota
+	intRuleType
+	stringRuleType
+	timeRuleType
+	listRuleType
+)
+
+func (t ruleType) String() string {
+	switch t {
+	case boolRuleType:
+		return "boolean"
+	case intRuleType:
+		return "integer"
+	case stringRuleType:
+		return "string"
+	case timeRuleType:
+		return "date"
+	case listRuleType:
+		return "list"
+	}
+	return "unknown"
+}
+
+// A ruleField is a field of the certificate object model that may be
+// referenced within a RuleExpression. The value returned by get is a bool,
+// int, string, time.Time or []string according to typ.
+type ruleField struct {
+	typ ruleType
+	get func(c *x509.Certificate) interface{}
+}
+
+// ruleFields is the certificate object model described by RuleExpression.
+var ruleFields = map[string]ruleField{
+	"version": {intRuleType, func(c *x509.Certificate) interface{} {
+		return c.Version
+	}},
+	"serial": {stringRuleType, func(c *x509.Certificate) interface{} {
+		if c.SerialNumber == nil {
+			return ""
+		}
+		return c.SerialNumber.Text(16)
+	}},
+	"signatureAlgorithm": {stringRuleType, func(c *x509.Certificate) interface{} {
+		return c.SignatureAlgorithm.String()
+	}},
+	"san.dns": {listRuleType, func(c *x509.Certificate) interface{} {
+		return c.DNSNames
+	}},
+	"san.email": {listRuleType, func(c *x509.Certificate) interface{} {
+		return c.EmailAddresses
+	}},
+	"san.ip": {listRuleType, func(c *x509.Certificate) interface{} {
+		ips := make([]string, 0, len(c.IPAddresses))
+		for _, ip := range c.IPAddresses {
+			ips = append(ips, ip.String())
+		}
+		return ips
+	}},
+	"san.uri": {listRuleType, func(c *x509.Certificate) interface{} {
+		return c.URIs
+	}},
+	"validity.notBefore": {timeRuleType, func(c *x509.Certificate) interface{} {
+		return c.NotBefore
+	}},
+	"validity.notAfter": {timeRuleType, func(c *x509.Certificate) interface{} {
+		return c.NotAfter
+	}},
+	"validity.days": {intRuleType, func(c *x509.Certificate) interface{} {
+		// The NotAfter time is inclusive of its final second.
+		period := c.NotAfter.Sub(c.NotBefore) + time.Second
+		days := int(period / (24 * time.Hour))
+		if period%(24*time.Hour) != 0 {
+			days++
+		}
+		return days
+	}},
+	"key.algorithm": {stringRuleType, func(c *x509.Certificate) interface{} {
+		return c.PublicKeyAlgorithm.String()
+	}},
+	"key.size": {intRuleType, func(c *x509.Certificate) interface{} {
+		switch key := c.PublicKey.(type) {
+		case *rsa.PublicKey:
+			return key.N.BitLen()
+		case *dsa.PublicKey:
+			return key.P.BitLen()
+		case *ecdsa.PublicKey:
+			return key.Curve.Params().BitSize
+		case *x509.AugmentedECDSA:
+			return key.Pub.Curve.Params().BitSize
+		case ed25519.PublicKey:
+			return 256
+		}
+		return 0
+	}},
+	"key.curve": {stringRuleType, func(c *x509.Certificate) interface{} {
+		switch key := c.PublicKey.(type) {
+		case *ecdsa.PublicKey:
+			return key.Curve.Params().Name
+		case *x509.AugmentedECDSA:
+			return key.Pub.Curve.Params().Name
+		}
+		return ""
+	}},
+	"key.exponent": {intRuleType, func(c *x509.Certificate) interface{} {
+		if key, ok := c.PublicKey.(*rsa.PublicKey); ok {
+			return key.E
+		}
+		return 0
+	}},
+	"extensions": {listRuleType, func(c *x509.Certificate) interface{} {
+		oids := make([]string, 0, len(c.Extensions))
+		for _, ext := range c.Extensions {
+			oids = append(oids, ext.Id.String())
+		}
+		return oids
+	}},
+	"criticalExtensions": {listRuleType, func(c *x509.Certificate) interface{} {
+		var oids []string
+		for _, ext := range c.Extensions {
+			if ext.Critical {
+				oids = append(oids, ext.Id.String())
+			}
+		}
+		return oids
+	}},
+	"policies": {listRuleType, func(c *x509.Certificate) interface{} {
+		return oidStrings(c.PolicyIdentifiers)
+	}},
+	"keyUsage": {listRuleType, func(c *x509.Certificate) interface{} {
+		var usages []string
+		for i, name := range keyUsageNames {
+			if c.KeyUsage&(1<<i) != 0 {
+				usages = append(usages, name)
+			}
+		}
+		return usages
+	}},
+	"extendedKeyUsage": {listRuleType, func(c *x509.Certificate) interface{} {
+		ext := util.GetExtFromCert(c, util.EkuSynOid)
+		if ext == nil {
+			return []string(nil)
+		}
+		var oids []asn1.ObjectIdentifier
+		if _, err := asn1.Unmarshal(ext.Value, &oids); err != nil {
+			return []string(nil)
+		}
+		usages := oidStrings(oids)
+		for i, oid := range usages {
+			if name, ok := extKeyUsageNames[oid]; ok {
+				usages[i] = name
+			}
+		}
+		return usages
+	}},
+	"ca": {boolRuleType, func(c *x509.Certificate) interface{} {
+		return c.IsCA
+	}},
+	"pathLen": {intRuleType, func(c *x509.Certificate) interface{} {
+		if c.MaxPathLen == 0 && !c.MaxPathLenZero {
+			return -1
+		}
+		return c.MaxPathLen
+	}},
+	"aia.ocsp": {listRuleType, func(c *x509.Certificate) interface{} {
+		return c.OCSPServer
+	}},
+	"aia.caIssuers": {listRuleType, func(c *x509.Certificate) interface{} {
+		return c.IssuingCertificateURL
+	}},
+	"crlDistributionPoints": {listRuleType, func(c *x509.Certificate) interface{} {
+		return c.CRLDistributionPoints
+	}},
+	"selfSigned": {boolRuleType, func(c *x509.Certificate) interface{} {
+		return util.IsSelfSigned(c)
+	}},
+	"root": {boolRuleType, func(c *x509.Certificate) interface{} {
+		return util.IsRootCA(c)
+	}},
+	"subCA": {boolRuleType, func(c *x509.Certificate) interface{} {
+		return util.IsSubCA(c)
+	}},
+	"subscriber": {boolRuleType, func(c *x509.Certificate) interface{} {
+		return util.IsSubscriberCert(c)
+	}},
+}
+
+// nameAttributes are the attributes of the subject and issuer within the
+// certificate object model.
+var nameAttributes = map[string]func(n *pkix.Name) []string{
+	"commonName": func(n *pkix.Name) []string {
+		if len(n.CommonNames) == 0 && n.CommonName != "" {
+			return []string{n.CommonName}
+		}
+		return n.CommonNames
+	},
+	"serialNumber": func(n *pkix.Name) []string {
+		if len(n.SerialNumbers) == 0 && n.SerialNumber != "" {
+			return []string{n.SerialNumber}
+		}
+		return n.SerialNumbers
+	},
+	"country":                func(n *pkix.Name) []string { return n.Country },
+	"organization":           func(n *pkix.Name) []string { return n.Organization },
+	"organizationalUnit":     func(n *pkix.Name) []string { return n.OrganizationalUnit },
+	"organizationIdentifier": func(n *pkix.Name) []string { return n.OrganizationIDs },
+	"locality":               func(n *pkix.Name) []string { return n.Locality },
+	"province":               func(n *pkix.Name) []string { return n.Province },
+	"streetAddress":          func(n *pkix.Name) []string { return n.StreetAddress },
+	"postalCode":             func(n *pkix.Name) []string { return n.PostalCode },
+	"domainComponent":        func(n *pkix.Name) []string { return n.DomainComponent },
+	"emailAddress":           func(n *pkix.Name) []string { return n.EmailAddress },
+	"givenName":              func(n *pkix.Name) []string { return n.GivenName },
+	"surname":                func(n *pkix.Name) []string { return n.Surname },
+}
+
+func init() {
+	names := map[string]func(c *x509.Certificate) *pkix.Name{
+		"subject": func(c *x509.Certificate) *pkix.Name { return &c.Subject },
+		"issuer":  func(c *x509.Certificate) *pkix.Name { return &c.Issuer },
+	}
+	for prefix, name := range names {
+		name := name
+		ruleFields[prefix+".dn"] = ruleField{stringRuleType, func(c *x509.Certificate) interface{} {
+			return name(c).String()
+		}}
+		for attribute, values := range nameAttributes {
+			values := values
+			ruleFields[prefix+"."+attribute] = ruleField{listRuleType, func(c *x509.Certificate) interface{} {
+				return values(name(c))
+			}}
+		}
+	}
+}
+
+// keyUsageNames names the bits of the KeyUsage extension as RFC 5280 does.
+var keyUsageNames = []string{
+	"digitalSignature",
+	"contentCommitment",
+	"keyEncipherment",
+	"dataEncipherment",
+	"keyAgreement",
+	"keyCertSign",
+	"cRLSign",
+	"encipherOnly",
+	"decipherOnly",
+}
+
+// extKeyUsageNames names the common extended key usages as
+// util.GetEKUString does.
+var extKeyUsageNames = map[string]string{
+	"2.5.29.37.0":       "any",
+	"1.3.6.1.5.5.7.3.1": "serverAuth",
+	"1.3.6.1.5.5.7.3.2": "clientAuth",
+	"1.3.6.1.5.5.7.3.3": "codeSigning",
+	"1.3.6.1.5.5.7.3.4": "emailProtection",
+	"1.3.6.1.5.5.7.3.8": "timeStamping",
+	"1.3.6.1.5.5.7.3.9": "ocspSigning",
+}
+
+func oidStrings(oids []asn1.ObjectIdentifier) []string {
+	strs := make([]string, 0, len(oids))
+	for _, oid := range oids {
+		strs = append(strs, oid.String())
+	}
+	return strs
+}
@@ -28,11 +28,15 @@ type LintSource string
 
 const (
 	UnknownLintSource             LintSource = "Unknown"
+	RFC2986                       LintSource = "RFC2986"
 	RFC3279                       LintSource = "RFC3279"
 	RFC5280                       LintSource = "RFC5280"
 	RFC5480                       LintSource = "RFC5480"
 	RFC5891                       LintSource = "RFC5891"
+	RFC6960                       LintSource = "RFC6960"
+	RFC6962                       LintSource = "RFC6962"
 	RFC8813                       LintSource = "RFC8813"
+	RFC8954                       LintSource = "RFC8954"
 	CABFBaselineRequirements      LintSource = "CABF_BR"
 	CABFSMIMEBaselineRequirements LintSource = "CABF_SMIME_BR"
 	CABFEVGuidelines              LintSource = "CABF_EV"
@@ -43,6 +47,7 @@ const (
 	ATIS1000080                   LintSource = "ATIS1000080"
 	UnitedStatesSHAKENCP          LintSource = "US_SHAKEN_CP"
 	ShakenPKI                     LintSource = "SHAKEN_PKI_BEST_PRACTICES"
+	Custom                        LintSource = "Custom"
 )
 
 // UnmarshalJSON implements the json.Unmarshaler interface. It ensures that the
@@ -54,7 +59,7 @@ func (s *LintSource) UnmarshalJSON(data []byte) error {
 	}
 
 	switch LintSource(throwAway) {
-	case RFC5280, RFC5480, RFC5891, CABFBaselineRequirements, CABFEVGuidelines, CABFSMIMEBaselineRequirements, MozillaRootStorePolicy, AppleRootStorePolicy, Community, EtsiEsi, ATIS1000080, UnitedStatesSHAKENCP, ShakenPKI:
+	case RFC2986, RFC3279, RFC5280, RFC5480, RFC5891, RFC6960, RFC6962, RFC8813, RFC8954, CABFBaselineRequirements, CABFEVGuidelines, CABFSMIMEBaselineRequirements, MozillaRootStorePolicy, AppleRootStorePolicy, Community, EtsiEsi, ATIS1000080, UnitedStatesSHAKENCP, ShakenPKI, Custom:
 		*s = LintSource(throwAway)
 		return nil
 	default:
@@ -72,12 +77,24 @@ func (s *LintSource) FromString(src string) {
 	// Trim space and try to match a known value
 	src = strings.TrimSpace(src)
 	switch LintSource(src) {
+	case RFC2986:
+		*s = RFC2986
+	case RFC3279:
+		*s = RFC3279
 	case RFC5280:
 		*s = RFC5280
 	case RFC5480:
 		*s = RFC5480
 	case RFC5891:
 		*s = RFC5891
+	case RFC6960:
+		*s = RFC6960
+	case RFC6962:
+		*s = RFC6962
+	case RFC8813:
+		*s = RFC8813
+	case RFC8954:
+		*s = RFC8954
 	case CABFBaselineRequirements:
 		*s = CABFBaselineRequirements
 	case CABFEVGuidelines:
@@ -98,6 +115,8 @@ func (s *LintSource) FromString(src string) {
 		*s = UnitedStatesSHAKENCP
 	case ShakenPKI:
 		*s = ShakenPKI
+	case Custom:
+		*s = Custom
 	}
 }
 
@@ -0,0 +1,200 @@
+package lint
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pelletier/go-toml"
+	"github.com/zmap/zcrypto/x509"
+)
+
+// waiverNamespace is the name of the TOML array of tables within a
+// configuration that holds its waivers.
+const waiverNamespace = "Waivers"
+
+// A Waiver is an approved exception to a lint for some set of certificates.
+// Waivers are declared within a configuration as an array of tables, e.g.
+//
+// ```
+//
+//	[[Waivers]]
+//	lint = "e_root_ca_key_usage_must_be_critical"
+//	fingerprint = "0b9fa5a59eed715c26c1020c711b4f6ec42d58b0015e14337a39dad301c5afc3"
+//	justification = "Legacy root, approved by the PKI policy authority in PA-2023-07"
+//	expires = 2027-01-01T00:00:00Z
+//
+// ```
+//
+// A waiver applies to the results of the named lint for every certificate
+// matching all of the Fingerprint, Issuer and Serial that it specifies. A
+// waiver that specifies none of them applies to every certificate. Waivers
+// stop applying once they expire.
+//
+// An applicable waiver either suppresses a finding entirely or, if Severity is
+// set, downgrades it to the given severity.
+type Waiver struct {
+	// Lint is the name of the lint whose findings are waived.
+	Lint string `toml:"lint" json:"lint"`
+	// Fingerprint is the hex encoded SHA-256 fingerprint of the DER encoding
+	// of the certificate. Colons are permitted between octets.
+	Fingerprint string `toml:"fingerprint" json:"fingerprint,omitempty"`
+	// Issuer is the distinguished name of the certificate's issuer, in the
+	// same format as is produced by pkix.Name.String (e.g. "CN=Root, O=Org").
+	Issuer string `toml:"issuer" json:"issuer,omitempty"`
+	// Serial is the hex encoded serial number of the certificate. Colons are
+	// permitted between octets.
+	Serial string `toml:"serial" json:"serial,omitempty"`
+	// Severity, if set, is the severity ("info", "warn" or "error") to which
+	// findings are downgraded. If Severity is empty then findings are
+	// suppressed entirely.
+	Severity string `toml:"severity" json:"severity,omitempty"`
+	// Justification records why the waiver was granted. It is required.
+	Justification string `toml:"justification" json:"justification"`
+	// Expires is the time from which the waiver no longer applies. It is
+	// required.
+	Expires time.Time `toml:"expires" json:"expires"`
+
+	// severity is the parsed form of Severity.
+	severity LintStatus
+}
+
+// parseWaivers extracts and validates the waivers declared within tree. Each
+// waiver must name a lint that is known to the global registry, so that a
+// misspelt name is reported rather than silently never applying.
+func parseWaivers(tree *toml.Tree) ([]Waiver, error) {
+	if tree.Get(waiverNamespace) == nil {
+		return nil, nil
+	}
+	var doc struct {
+		Waivers []Waiver `toml:"Waivers"`
+	}
+	if err := tree.Unmarshal(&doc); err != nil {
+		return nil, fmt.Errorf("failed to parse the [[%s]] of the provided configuration. Error: %s", waiverNamespace, err.Error())
+	}
+	for i := range doc.Waivers {
+		if err := doc.Waivers[i].validate(); err != nil {
+			return nil, fmt.Errorf("waiver %d (%s) is invalid: %s", i+1, doc.Waivers[i].Lint, err.Error())
+		}
+		if _, err := globalRegistry.lintNamesToMap([]string{doc.Waivers[i].Lint}); err != nil {
+			return nil, fmt.Errorf("waiver %d names the unknown lint %q", i+1, doc.Waivers[i].Lint)
+		}
+	}
+	return doc.Waivers, nil
+}
+
+// validate checks that all of the required fields of the waiver are set and
+// normalizes its fingerprint, serial and severity.
+func (w *Waiver) validate() error {
+	if w.Lint == "" {
+		return errors.New("a lint name is required")
+	}
+	if strings.TrimSpace(w.Justification) == "" {
+		return errors.New("a justification is required")
+	}
+	if w.Expires.IsZero() {
+		return errors.New("an expiry date is required")
+	}
+	if w.Fingerprint != "" {
+		w.Fingerprint = normalizeHex(w.Fingerprint)
+		raw, err := hex.DecodeString(w.Fingerprint)
+		if err != nil || len(raw) != sha256.Size {
+			return fmt.Errorf("fingerprint %q is not a hex encoded SHA-256 hash", w.Fingerprint)
+		}
+	}
+	if w.Serial != "" {
+		w.Serial = strings.TrimLeft(normalizeHex(w.Serial), "0")
+		if w.Serial == "" {
+			w.Serial = "0"
+		}
+		if _, err := hex.DecodeString(strings.Repeat("0", len(w.Serial)%2) + w.Serial); err != nil {
+			return fmt.Errorf("serial %q is not hex encoded", w.Serial)
+		}
+	}
+	switch w.Severity {
+	case "":
+		w.severity = Reserved
+	case Notice.String(), Warn.String(), Error.String():
+		w.severity = StatusLabelToLintStatus[w.Severity]
+	default:
+		return fmt.Errorf("severity %q must be one of %q, %q or %q", w.Severity, Notice, Warn, Error)
+	}
+	return nil
+}
+
+// normalizeHex lowercases s and removes any colons separating its octets.
+func normalizeHex(s string) string {
+	return strings.ToLower(strings.ReplaceAll(s, ":", ""))
+}
+
+// Expired returns true if the waiver no longer applies at the time now.
+func (w Waiver) Expired(now time.Time) bool {
+	return !now.Before(w.Expires)
+}
+
+// AppliesTo returns true if the waiver is in force at the time now and covers
+// the findings of the lint named lintName for the certificate c.
+func (w Waiver) AppliesTo(lintName string, c *x509.Certificate, now time.Time) bool {
+	if w.Lint != lintName || w.Expired(now) {
+		return false
+	}
+	if w.Fingerprint != "" {
+		fingerprint := sha256.Sum256(c.Raw)
+		if hex.EncodeToString(fingerprint[:]) != w.Fingerprint {
+			return false
+		}
+	}
+	if w.Issuer != "" && c.Issuer.String() != w.Issuer {
+		return false
+	}
+	if w.Serial != "" && (c.SerialNumber == nil || c.SerialNumber.Text(16) != w.Serial) {
+		return false
+	}
+	return true
+}
+
+// Apply returns the result of waiving res. If the waiver suppresses findings
+// then nil is returned. Otherwise res, and each of its findings, is downgraded
+// to the severity of the waiver, unless it is already no more severe than that.
+func (w Waiver) Apply(res *LintResult) *LintResult {
+	if w.severity == Reserved {
+		return nil
+	}
+	if res.Status <= w.severity {
+		return res
+	}
+	details := fmt.Sprintf("downgraded from %s by waiver", res.Status)
+	if res.Details != "" {
+		details = res.Details + " (" + details + ")"
+	}
+	var findings []Finding
+	for _, f := range res.Findings {
+		if f.Status > w.severity {
+			f.Status = w.severity
+		}
+		findings = append(findings, f)
+	}
+	return &LintResult{
+		Status:       w.severity,
+		Details:      details,
+		Findings:     findings,
+		LintMetadata: res.LintMetadata,
+	}
+}
@@ -0,0 +1,329 @@
+package lint
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zcrypto/x509/pkix"
+)
+
+func init() {
+	// Waivers must name registered lints.
+	for _, name := range []string{"e_some_lint", "w_some_lint"} {
+		RegisterCertificateLint(&CertificateLint{
+			LintMetadata: LintMetadata{
+				Name:        name,
+				Description: "A lint named by the waivers under test",
+				Citation:    "None",
+				Source:      Community,
+				Remediation: "None",
+			},
+			Lint: func() CertificateLintInterface { return &mockLint{} },
+		})
+	}
+}
+
+func TestParseWaivers(t *testing.T) {
+	testCases := []struct {
+		name    string
+		config  string
+		want    int
+		wantErr string
+	}{
+		{
+			name:   "no waivers",
+			config: "[e_some_lint]\nfield = 1\n",
+			want:   0,
+		},
+		{
+			name: "valid waivers",
+			config: `
+[[Waivers]]
+lint = "e_some_lint"
+fingerprint = "00:01:02:03:04:05:06:07:08:09:0A:0B:0C:0D:0E:0F:10:11:12:13:14:15:16:17:18:19:1A:1B:1C:1D:1E:1F"
+justification = "Approved"
+expires = 2027-01-01T00:00:00Z
+
+[[Waivers]]
+lint = "w_some_lint"
+serial = "00:0A"
+severity = "info"
+justification = "Approved"
+expires = 2027-01-01T00:00:00Z
+`,
+			want: 2,
+		},
+		{
+			name: "missing justification",
+			config: `
+[[Waivers]]
+lint = "e_some_lint"
+expires = 2027-01-01T00:00:00Z
+`,
+			wantErr: "a justification is required",
+		},
+		{
+			name: "missing expiry",
+			config: `
+[[Waivers]]
+lint = "e_some_lint"
+justification = "Approved"
+`,
+			wantErr: "an expiry date is required",
+		},
+		{
+			name: "missing lint",
+			config: `
+[[Waivers]]
+justification = "Approved"
+expires = 2027-01-01T00:00:00Z
+`,
+			wantErr: "a lint name is required",
+		},
+		{
+			name: "bad fingerprint",
+			config: `
+[[Waivers]]
+lint = "e_some_lint"
+fingerprint = "abcd"
+justification = "Approved"
+expires = 2027-01-01T00:00:00Z
+`,
+			wantErr: "is not a hex encoded SHA-256 hash",
+		},
+		{
+			name: "bad serial",
+			config: `
+[[Waivers]]
+lint = "e_some_lint"
+serial = "xyz"
+justification = "Approved"
+expires = 2027-01-01T00:00:00Z
+`,
+			wantErr: "is not hex encoded",
+		},
+		{
+			name: "unknown lint",
+			config: `
+[[Waivers]]
+lint = "e_unknown_lint"
+justification = "Approved"
+expires = 2027-01-01T00:00:00Z
+`,
+			wantErr: `waiver 1 names the unknown lint "e_unknown_lint"`,
+		},
+		{
+			name: "bad severity",
+			config: `
+[[Waivers]]
+lint = "e_some_lint"
+severity = "fatal"
+justification = "Approved"
+expires = 2027-01-01T00:00:00Z
+`,
+			wantErr: `severity "fatal" must be one of`,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			config, err := NewConfigFromString(tc.config)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected an error containing %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := len(config.Waivers()); got != tc.want {
+				t.Fatalf("expected %d waivers, got %d", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestWaiverAppliesTo(t *testing.T) {
+	c := &x509.Certificate{
+		Raw:          []byte("certificate"),
+		Issuer:       pkix.Name{CommonName: "Legacy Root"},
+		SerialNumber: big.NewInt(0x0a),
+	}
+	sum := sha256.Sum256(c.Raw)
+	fingerprint := hex.EncodeToString(sum[:])
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	expires := now.Add(time.Hour)
+
+	testCases := []struct {
+		name   string
+		waiver Waiver
+		now    time.Time
+		want   bool
+	}{
+		{
+			name:   "any certificate",
+			waiver: Waiver{Lint: "e_some_lint"},
+			now:    now,
+			want:   true,
+		},
+		{
+			name:   "other lint",
+			waiver: Waiver{Lint: "e_other_lint"},
+			now:    now,
+			want:   false,
+		},
+		{
+			name:   "matching fingerprint",
+			waiver: Waiver{Lint: "e_some_lint", Fingerprint: strings.ToUpper(fingerprint)},
+			now:    now,
+			want:   true,
+		},
+		{
+			name:   "other fingerprint",
+			waiver: Waiver{Lint: "e_some_lint", Fingerprint: strings.Repeat("00", sha256.Size)},
+			now:    now,
+			want:   false,
+		},
+		{
+			name:   "matching issuer",
+			waiver: Waiver{Lint: "e_some_lint", Issuer: "CN=Legacy Root"},
+			now:    now,
+			want:   true,
+		},
+		{
+			name:   "other issuer",
+			waiver: Waiver{Lint: "e_some_lint", Issuer: "CN=Other Root"},
+			now:    now,
+			want:   false,
+		},
+		{
+			name:   "matching serial",
+			waiver: Waiver{Lint: "e_some_lint", Serial: "00:0A"},
+			now:    now,
+			want:   true,
+		},
+		{
+			name:   "matching serial but other issuer",
+			waiver: Waiver{Lint: "e_some_lint", Serial: "0a", Issuer: "CN=Other Root"},
+			now:    now,
+			want:   false,
+		},
+		{
+			name:   "expired",
+			waiver: Waiver{Lint: "e_some_lint"},
+			now:    expires,
+			want:   false,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			tc.waiver.Justification = "Approved"
+			tc.waiver.Expires = expires
+			if err := tc.waiver.validate(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := tc.waiver.AppliesTo("e_some_lint", c, tc.now); got != tc.want {
+				t.Errorf("expected AppliesTo to return %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestWaiverApply(t *testing.T) {
+	testCases := []struct {
+		name        string
+		severity    string
+		status      LintStatus
+		wantNil     bool
+		wantStatus  LintStatus
+		wantDetails string
+	}{
+		{
+			name:     "suppress",
+			status:   Error,
+			wantNil:  true,
+			severity: "",
+		},
+		{
+			name:        "downgrade",
+			severity:    "warn",
+			status:      Error,
+			wantStatus:  Warn,
+			wantDetails: "details (downgraded from error by waiver)",
+		},
+		{
+			name:        "no upgrade",
+			severity:    "warn",
+			status:      Notice,
+			wantStatus:  Notice,
+			wantDetails: "details",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			w := Waiver{
+				Lint:          "e_some_lint",
+				Severity:      tc.severity,
+				Justification: "Approved",
+				Expires:       time.Now().Add(time.Hour),
+			}
+			if err := w.validate(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got := w.Apply(&LintResult{Status: tc.status, Details: "details"})
+			if tc.wantNil {
+				if got != nil {
+					t.Fatalf("expected the finding to be suppressed, got %v", got)
+				}
+				return
+			}
+			if got == nil {
+				t.Fatal("expected a result, got nil")
+			}
+			if got.Status != tc.wantStatus || got.Details != tc.wantDetails {
+				t.Errorf("expected %s %q, got %s %q", tc.wantStatus, tc.wantDetails, got.Status, got.Details)
+			}
+		})
+	}
+}
+
+func TestWaiverApplyFindings(t *testing.T) {
+	w := Waiver{
+		Lint:          "e_some_lint",
+		Severity:      "warn",
+		Justification: "Approved",
+		Expires:       time.Now().Add(time.Hour),
+	}
+	if err := w.validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got := w.Apply(NewResultFromFindings([]Finding{
+		{Path: "a", Status: Error},
+		{Path: "b", Status: Notice},
+	}))
+	if len(got.Findings) != 2 || got.Findings[0].Status != Warn || got.Findings[1].Status != Notice {
+		t.Errorf("expected findings to be capped at %s, got %+v", Warn, got.Findings)
+	}
+}
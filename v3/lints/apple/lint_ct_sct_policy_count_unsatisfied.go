@@ -33,6 +33,7 @@ func init() {
 			Description:   "Check if certificate has enough embedded SCTs to meet Apple CT Policy",
 			Citation:      "https://support.apple.com/en-us/HT205280",
 			Source:        lint.AppleRootStorePolicy,
+			Remediation:   "Submit the precertificate to enough distinct Certificate Transparency logs to meet the Apple CT policy for the lifetime of the certificate, and embed all of the returned SCTs.",
 			EffectiveDate: util.AppleCTPolicyDate,
 		},
 		Lint: NewSctPolicyCount,
@@ -32,6 +32,8 @@ func init() {
 				"00:00 GMT/UTC must not have a validity period greater than 398 days",
 			Citation:      "https://support.apple.com/en-us/HT211025",
 			Source:        lint.AppleRootStorePolicy,
+			Tags:          []string{"time"},
+			Remediation:   "Shorten the validity period of the certificate profile so that notAfter is no more than 398 days after notBefore.",
 			EffectiveDate: util.AppleReducedLifetimeDate,
 		},
 		Lint: NewServerCertValidityTooLong,
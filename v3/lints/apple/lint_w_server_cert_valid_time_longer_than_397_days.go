@@ -32,6 +32,8 @@ func init() {
 				"00:00 GMT/UTC should not have a validity period greater than 397 days",
 			Citation:      "https://support.apple.com/en-us/HT211025",
 			Source:        lint.AppleRootStorePolicy,
+			Tags:          []string{"time"},
+			Remediation:   "Shorten the validity period of the certificate profile to at most 397 days, which leaves a margin below the 398 day limit.",
 			EffectiveDate: util.AppleReducedLifetimeDate,
 		},
 		Lint: NewServerCertValidityAlmostTooLong,
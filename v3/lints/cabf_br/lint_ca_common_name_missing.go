@@ -29,6 +29,7 @@ func init() {
 			Description:   "CA Certificates common name MUST be included.",
 			Citation:      "BRs: 7.1.4.3.1",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Include a commonName in the subject of CA certificates that identifies the CA certificate uniquely.",
 			EffectiveDate: util.CABV148Date,
 		},
 		Lint: NewCaCommonNameMissing,
@@ -37,6 +37,7 @@ func init() {
 			Description:   "Root and Subordinate CA certificates MUST have a two-letter country code specified in ISO 3166-1",
 			Citation:      "BRs: 7.1.2.1",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Set the countryName of the subject of CA certificates to the two letter ISO 3166-1 code of the country of the CA.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewCaCountryNameInvalid,
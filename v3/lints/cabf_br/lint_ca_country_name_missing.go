@@ -37,6 +37,7 @@ func init() {
 			Description:   "Root and Subordinate CA certificates MUST have a countryName present in subject information",
 			Citation:      "BRs: 7.1.2.1",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Include the countryName of the CA in the subject of CA certificates.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewCaCountryNameMissing,
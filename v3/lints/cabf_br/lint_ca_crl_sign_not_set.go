@@ -36,6 +36,7 @@ func init() {
 			Description:   "Root and Subordinate CA certificate keyUsage extension's crlSign bit MUST be set",
 			Citation:      "BRs: 7.1.2.1",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Assert cRLSign in the keyUsage of CA certificates.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewCaCRLSignNotSet,
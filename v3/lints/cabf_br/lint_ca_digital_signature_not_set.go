@@ -39,6 +39,7 @@ func init() {
 			Description:   "Root and Subordinate CA Certificates that wish to use their private key for signing OCSP responses will not be able to without their digital signature set",
 			Citation:      "BRs: 7.1.2.1",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Assert digitalSignature in the keyUsage of CA certificates whose key signs OCSP responses directly.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewCaDigSignNotSet,
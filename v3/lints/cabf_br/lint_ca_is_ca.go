@@ -30,6 +30,7 @@ func init() {
 			Description:   "Root and Sub CA Certificate: The CA field MUST be set to true.",
 			Citation:      "BRs: 7.1.2.1, BRs: 7.1.2.2",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Set the cA field of the basicConstraints extension of CA certificates to true.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewCaIsCA,
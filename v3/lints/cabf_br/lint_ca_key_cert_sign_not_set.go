@@ -35,6 +35,7 @@ func init() {
 			Description:   "Root CA Certificate: Bit positions for keyCertSign and cRLSign MUST be set.",
 			Citation:      "BRs: 7.1.2.1",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Assert keyCertSign in the keyUsage of CA certificates.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewCaKeyCertSignNotSet,
@@ -37,6 +37,7 @@ func init() {
 			Description:   "Root and Subordinate CA certificate keyUsage extension MUST be present",
 			Citation:      "BRs: 7.1.2.1, RFC 5280: 4.2.1.3",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Include a keyUsage extension in CA certificates asserting at least keyCertSign and cRLSign.",
 			EffectiveDate: util.RFC3280Date,
 		},
 		Lint: NewCaKeyUsageMissing,
@@ -35,6 +35,7 @@ func init() {
 			Description:   "Root and Subordinate CA certificate keyUsage extension MUST be marked as critical",
 			Citation:      "BRs: 7.1.2.1",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Mark the keyUsage extension of CA certificates as critical.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewCaKeyUsageNotCrit,
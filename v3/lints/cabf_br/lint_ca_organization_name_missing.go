@@ -34,6 +34,7 @@ func init() {
 			Description:   "Root and Subordinate CA certificates MUST have a organizationName present in subject information",
 			Citation:      "BRs: 7.1.2.1",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Include the organizationName of the CA in the subject of CA certificates.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewCaOrganizationNameMissing,
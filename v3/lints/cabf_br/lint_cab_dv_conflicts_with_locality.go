@@ -30,6 +30,7 @@ func init() {
 			Description:   "If certificate policy 2.23.140.1.2.1 (CA/B BR domain validated) is included, locality name MUST NOT be included in subject",
 			Citation:      "BRs: 7.1.6.1",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Remove the localityName from the subject of domain validated certificates, or issue under the OV or IV policy once the locality has been validated.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewCertPolicyConflictsWithLocality,
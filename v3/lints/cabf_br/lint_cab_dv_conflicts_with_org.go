@@ -39,6 +39,7 @@ func init() {
 			Description:   "If certificate policy 2.23.140.1.2.1 (CA/B BR domain validated) is included, organization name MUST NOT be included in subject",
 			Citation:      "BRs: 7.1.6.4",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Remove the organizationName from the subject of domain validated certificates, or issue under the OV policy once the organization has been validated.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewCertPolicyConflictsWithOrg,
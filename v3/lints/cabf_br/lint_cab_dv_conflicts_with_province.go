@@ -39,6 +39,7 @@ func init() {
 			Description:   "If certificate policy 2.23.140.1.2.1 (CA/B BR domain validated) is included, stateOrProvinceName MUST NOT be included in subject",
 			Citation:      "BRs: 7.1.6.4",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Remove the stateOrProvinceName from the subject of domain validated certificates, or issue under the OV or IV policy once the address has been validated.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewCertPolicyConflictsWithProvince,
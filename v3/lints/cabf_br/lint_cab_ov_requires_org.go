@@ -39,6 +39,7 @@ func init() {
 			Description:   "If certificate policy 2.23.140.1.2.2 is included, organizationName MUST be included in subject",
 			Citation:      "BRs: 7.1.6.4",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Include the validated organizationName in the subject of organization validated certificates.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewCertPolicyRequiresOrg,
@@ -29,6 +29,7 @@ func init() {
 			Description:   "If present, CRL Reason Code extension MUST NOT be marked critical.",
 			Citation:      "BRs: 7.2.2",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Mark the reasonCode CRL entry extension as non-critical.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewCrlReasonCodeNotCritical,
@@ -29,6 +29,7 @@ func init() {
 			Description:   "Only the following CRLReasons MAY be present: 1, 3, 4, 5, 9.",
 			Citation:      "BRs: 7.2.2",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Revoke certificates with only the reasons keyCompromise (1), affiliationChanged (3), superseded (4), cessationOfOperation (5) or privilegeWithdrawn (9), and omit the reasonCode for unspecified.",
 			EffectiveDate: util.CABFBRs_1_8_7_Date,
 		},
 		Lint: NewCrlHasValidReasonCode,
@@ -40,6 +40,7 @@ func init() {
 			Description:   "If certificate policy 2.23.140.1.2.3 is included, countryName MUST be included in subject",
 			Citation:      "BRs: 7.1.6.4",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Include the validated countryName in the subject of individual validated certificates.",
 			EffectiveDate: util.CABV131Date,
 		},
 		Lint: NewCertPolicyIVRequiresCountry,
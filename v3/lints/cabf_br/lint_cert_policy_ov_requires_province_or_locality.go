@@ -41,6 +41,7 @@ func init() {
 			Description:   "If certificate policy 2.23.140.1.2.2 is included, localityName or stateOrProvinceName MUST be included in subject",
 			Citation:      "BRs: 7.1.6.4",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Include the validated localityName or stateOrProvinceName in the subject of organization validated certificates.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewCertPolicyOVRequiresProvinceOrLocal,
@@ -0,0 +1,72 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"crypto/ecdsa"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+)
+
+type csrECImproperCurves struct{}
+
+/************************************************
+BRs: 6.1.5
+Certificates MUST meet the following requirements for algorithm type and key size.
+ECC Curve: NIST P-256, P-384, or P-521
+************************************************/
+
+func init() {
+	lint.RegisterCertificateRequestLint(&lint.CertificateRequestLint{
+		LintMetadata: lint.LintMetadata{
+			Name:          "e_csr_ec_improper_curves",
+			Description:   "Certificate requests using an ECDSA key MUST use one of NIST P-256, P-384, or P-521",
+			Citation:      "BRs: 6.1.5",
+			Source:        lint.CABFBaselineRequirements,
+			Tags:          []string{"key"},
+			Remediation:   "Reject the request and ask the subscriber for a key on the P-256, P-384 or P-521 curve.",
+			EffectiveDate: util.ZeroDate,
+		},
+		Lint: NewCSRECImproperCurves,
+	})
+}
+
+func NewCSRECImproperCurves() lint.CertificateRequestLintInterface {
+	return &csrECImproperCurves{}
+}
+
+func (l *csrECImproperCurves) CheckApplies(r *x509.CertificateRequest) bool {
+	return r.PublicKeyAlgorithm == x509.ECDSA
+}
+
+func (l *csrECImproperCurves) Execute(r *x509.CertificateRequest) *lint.LintResult {
+	var key *ecdsa.PublicKey
+	switch keyType := r.PublicKey.(type) {
+	case *x509.AugmentedECDSA:
+		key = keyType.Pub
+	case *ecdsa.PublicKey:
+		key = keyType
+	default:
+		return &lint.LintResult{Status: lint.Fatal, Details: "certificate request has an unparsable ECDSA public key"}
+	}
+	switch key.Curve.Params().Name {
+	case "P-256", "P-384", "P-521":
+		return &lint.LintResult{Status: lint.Pass}
+	default:
+		return &lint.LintResult{Status: lint.Error}
+	}
+}
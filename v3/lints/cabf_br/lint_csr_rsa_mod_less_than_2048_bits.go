@@ -0,0 +1,64 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"crypto/rsa"
+	"fmt"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+)
+
+type csrRSAModLessThan2048Bits struct{}
+
+/************************************************
+BRs: 6.1.5
+Certificates MUST meet the following requirements for algorithm type and key size.
+RSA: Minimum Modulus Size (bits) 2048
+************************************************/
+
+func init() {
+	lint.RegisterCertificateRequestLint(&lint.CertificateRequestLint{
+		LintMetadata: lint.LintMetadata{
+			Name:          "e_csr_rsa_mod_less_than_2048_bits",
+			Description:   "Certificate requests using the RSA public key algorithm MUST have a modulus of at least 2048 bits",
+			Citation:      "BRs: 6.1.5",
+			Source:        lint.CABFBaselineRequirements,
+			Tags:          []string{"key"},
+			Remediation:   "Reject the request and ask the subscriber for an RSA key of at least 2048 bits.",
+			EffectiveDate: util.ZeroDate,
+		},
+		Lint: NewCSRRSAModLessThan2048Bits,
+	})
+}
+
+func NewCSRRSAModLessThan2048Bits() lint.CertificateRequestLintInterface {
+	return &csrRSAModLessThan2048Bits{}
+}
+
+func (l *csrRSAModLessThan2048Bits) CheckApplies(r *x509.CertificateRequest) bool {
+	_, ok := r.PublicKey.(*rsa.PublicKey)
+	return ok && r.PublicKeyAlgorithm == x509.RSA
+}
+
+func (l *csrRSAModLessThan2048Bits) Execute(r *x509.CertificateRequest) *lint.LintResult {
+	key := r.PublicKey.(*rsa.PublicKey)
+	if key.N.BitLen() < 2048 {
+		return &lint.LintResult{Status: lint.Error, Details: fmt.Sprintf("RSA modulus is %d bits", key.N.BitLen())}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
@@ -0,0 +1,58 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/test"
+)
+
+func TestCSRRSAModLessThan2048Bits(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name string
+		path string
+		want lint.LintStatus
+	}{
+		{
+			name: "2048 bit modulus",
+			path: "csrValidRSA.pem",
+			want: lint.Pass,
+		},
+		{
+			name: "1024 bit modulus",
+			path: "csrRSA1024.pem",
+			want: lint.Error,
+		},
+		{
+			name: "ECDSA key",
+			path: "csrValidECDSA.pem",
+			want: lint.NA,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			result := test.TestCertificateRequestLint(t, "e_csr_rsa_mod_less_than_2048_bits", tc.path)
+			if result.Status != tc.want {
+				t.Errorf("expected %s, got %s: %s", tc.want, result.Status, result.Details)
+			}
+		})
+	}
+}
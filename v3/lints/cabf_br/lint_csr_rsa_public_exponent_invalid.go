@@ -0,0 +1,64 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"crypto/rsa"
+	"fmt"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+)
+
+type csrRSAPublicExponentInvalid struct{}
+
+/************************************************
+BRs: 6.1.6
+RSA: The CA SHALL confirm that the value of the public exponent is an odd
+number equal to 3 or more.
+************************************************/
+
+func init() {
+	lint.RegisterCertificateRequestLint(&lint.CertificateRequestLint{
+		LintMetadata: lint.LintMetadata{
+			Name:          "e_csr_rsa_public_exponent_invalid",
+			Description:   "The RSA public exponent of a certificate request MUST be an odd number equal to 3 or more",
+			Citation:      "BRs: 6.1.6",
+			Source:        lint.CABFBaselineRequirements,
+			Tags:          []string{"key"},
+			Remediation:   "Reject the request and ask the subscriber for an RSA key with an odd public exponent of 3 or more, such as 65537.",
+			EffectiveDate: util.ZeroDate,
+		},
+		Lint: NewCSRRSAPublicExponentInvalid,
+	})
+}
+
+func NewCSRRSAPublicExponentInvalid() lint.CertificateRequestLintInterface {
+	return &csrRSAPublicExponentInvalid{}
+}
+
+func (l *csrRSAPublicExponentInvalid) CheckApplies(r *x509.CertificateRequest) bool {
+	_, ok := r.PublicKey.(*rsa.PublicKey)
+	return ok && r.PublicKeyAlgorithm == x509.RSA
+}
+
+func (l *csrRSAPublicExponentInvalid) Execute(r *x509.CertificateRequest) *lint.LintResult {
+	key := r.PublicKey.(*rsa.PublicKey)
+	if key.E < 3 || key.E%2 == 0 {
+		return &lint.LintResult{Status: lint.Error, Details: fmt.Sprintf("RSA public exponent is %d", key.E)}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
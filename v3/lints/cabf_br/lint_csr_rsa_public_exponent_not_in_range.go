@@ -0,0 +1,71 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"crypto/rsa"
+	"fmt"
+	"math/big"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+)
+
+type csrRSAPublicExponentNotInRange struct {
+	upperBound *big.Int
+}
+
+/************************************************
+BRs: 6.1.6
+RSA: ... Additionally, the public exponent SHOULD be in the range between
+2^16+1 and 2^256-1.
+************************************************/
+
+func init() {
+	lint.RegisterCertificateRequestLint(&lint.CertificateRequestLint{
+		LintMetadata: lint.LintMetadata{
+			Name:          "w_csr_rsa_public_exponent_not_in_range",
+			Description:   "The RSA public exponent of a certificate request SHOULD be in the range between 2^16 + 1 and 2^256 - 1",
+			Citation:      "BRs: 6.1.6",
+			Source:        lint.CABFBaselineRequirements,
+			Tags:          []string{"key"},
+			Remediation:   "Ask the subscriber for an RSA key with a public exponent between 2^16+1 and 2^256-1, such as 65537.",
+			EffectiveDate: util.ZeroDate,
+		},
+		Lint: NewCSRRSAPublicExponentNotInRange,
+	})
+}
+
+func NewCSRRSAPublicExponentNotInRange() lint.CertificateRequestLintInterface {
+	l := &csrRSAPublicExponentNotInRange{}
+	l.upperBound = &big.Int{}
+	l.upperBound.Exp(big.NewInt(2), big.NewInt(256), nil)
+	return l
+}
+
+func (l *csrRSAPublicExponentNotInRange) CheckApplies(r *x509.CertificateRequest) bool {
+	_, ok := r.PublicKey.(*rsa.PublicKey)
+	return ok && r.PublicKeyAlgorithm == x509.RSA
+}
+
+func (l *csrRSAPublicExponentNotInRange) Execute(r *x509.CertificateRequest) *lint.LintResult {
+	key := r.PublicKey.(*rsa.PublicKey)
+	const lowerBound = 65537 // 2^16 + 1
+	if key.E >= lowerBound && l.upperBound.Cmp(big.NewInt(int64(key.E))) == 1 {
+		return &lint.LintResult{Status: lint.Pass}
+	}
+	return &lint.LintResult{Status: lint.Warn, Details: fmt.Sprintf("RSA public exponent is %d", key.E)}
+}
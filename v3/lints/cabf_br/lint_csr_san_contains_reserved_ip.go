@@ -0,0 +1,62 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"fmt"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+)
+
+type csrSANContainsReservedIP struct{}
+
+/************************************************
+BRs: 7.1.4.2.1
+CAs SHALL NOT issue certificates with a subjectAltName extension or
+subject:commonName field containing a Reserved IP Address or Internal Name.
+************************************************/
+
+func init() {
+	lint.RegisterCertificateRequestLint(&lint.CertificateRequestLint{
+		LintMetadata: lint.LintMetadata{
+			Name:          "e_csr_san_contains_reserved_ip",
+			Description:   "Certificate requests MUST NOT request a subjectAltName containing a Reserved IP Address",
+			Citation:      "BRs: 7.1.4.2.1",
+			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Reject the request, or remove the reserved IP addresses from the names to be certified.",
+			EffectiveDate: util.NoReservedIP,
+		},
+		Lint: NewCSRSANContainsReservedIP,
+	})
+}
+
+func NewCSRSANContainsReservedIP() lint.CertificateRequestLintInterface {
+	return &csrSANContainsReservedIP{}
+}
+
+func (l *csrSANContainsReservedIP) CheckApplies(r *x509.CertificateRequest) bool {
+	return len(r.IPAddresses) > 0
+}
+
+func (l *csrSANContainsReservedIP) Execute(r *x509.CertificateRequest) *lint.LintResult {
+	for _, ip := range r.IPAddresses {
+		if util.IsIANAReserved(ip) {
+			return &lint.LintResult{Status: lint.Error, Details: fmt.Sprintf("requested IP address %s is reserved", ip)}
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
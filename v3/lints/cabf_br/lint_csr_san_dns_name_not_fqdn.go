@@ -0,0 +1,71 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+)
+
+type csrSANDNSNameNotFQDN struct{}
+
+/************************************************
+BRs: 7.1.4.2.1
+Entries in the dNSName MUST be in the "preferred name syntax", as specified in
+RFC 5280, and thus MUST NOT contain underscore characters. ... CAs SHALL NOT
+issue certificates with a subjectAltName extension or subject:commonName field
+containing a Reserved IP Address or Internal Name.
+************************************************/
+
+func init() {
+	lint.RegisterCertificateRequestLint(&lint.CertificateRequestLint{
+		LintMetadata: lint.LintMetadata{
+			Name:          "e_csr_san_dns_name_not_fqdn",
+			Description:   "Every dNSName requested in a certificate request MUST be a fully-qualified domain name",
+			Citation:      "BRs: 7.1.4.2.1",
+			Source:        lint.CABFBaselineRequirements,
+			Tags:          []string{"dns"},
+			Remediation:   "Reject the request, or remove the names that are not fully-qualified domain names from the names to be certified.",
+			EffectiveDate: util.CABEffectiveDate,
+		},
+		Lint: NewCSRSANDNSNameNotFQDN,
+	})
+}
+
+func NewCSRSANDNSNameNotFQDN() lint.CertificateRequestLintInterface {
+	return &csrSANDNSNameNotFQDN{}
+}
+
+func (l *csrSANDNSNameNotFQDN) CheckApplies(r *x509.CertificateRequest) bool {
+	return len(r.DNSNames) > 0
+}
+
+func (l *csrSANDNSNameNotFQDN) Execute(r *x509.CertificateRequest) *lint.LintResult {
+	for _, name := range r.DNSNames {
+		// util.IsFQDN accepts underscores, which the preferred name syntax
+		// does not.
+		if strings.Contains(name, "_") {
+			return &lint.LintResult{Status: lint.Error, Details: fmt.Sprintf("requested dNSName %q contains an underscore (_) character", name)}
+		}
+		if !util.IsFQDN(name) {
+			return &lint.LintResult{Status: lint.Error, Details: fmt.Sprintf("requested dNSName %q is not a fully-qualified domain name", name)}
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
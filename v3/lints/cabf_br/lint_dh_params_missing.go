@@ -31,6 +31,8 @@ func init() {
 			Description:     "DSA: Certificates MUST include all domain parameters",
 			Citation:        "BRs v1.7.0: 6.1.6",
 			Source:          lint.CABFBaselineRequirements,
+			Tags:            []string{"key"},
+			Remediation:     "Include the domain parameters p, q and g of DSA keys in the subjectPublicKeyInfo, or certify an RSA or ECDSA key instead.",
 			EffectiveDate:   util.CABEffectiveDate,
 			IneffectiveDate: util.CABFBRs_1_7_1_Date,
 		},
@@ -33,6 +33,8 @@ func init() {
 			Description:   "Characters in labels of DNSNames MUST be alphanumeric, - , _ or *",
 			Citation:      "BRs: 7.1.4.2",
 			Source:        lint.CABFBaselineRequirements,
+			Tags:          []string{"dns"},
+			Remediation:   "Remove characters other than letters, digits and hyphens from the labels of the dNSName, using the A-label form for internationalized names.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewDNSNameProperCharacters,
@@ -31,6 +31,8 @@ func init() {
 			Description:   "Wildcards in the left label of DNSName should only be *",
 			Citation:      "BRs: 1.6.1, Wildcard Certificate and Wildcard Domain Name",
 			Source:        lint.CABFBaselineRequirements,
+			Tags:          []string{"dns"},
+			Remediation:   "Use \"*\" alone as the left-most label of wildcard names, rather than partial wildcards such as \"w*.example.com\".",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewDNSNameLeftLabelWildcardCheck,
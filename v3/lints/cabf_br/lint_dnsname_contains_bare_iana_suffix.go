@@ -29,6 +29,8 @@ func init() {
 			Description:   "DNSNames should not contain a bare IANA suffix.",
 			Citation:      "BRs: 1.6.1, Base Domain Name",
 			Source:        lint.CABFBaselineRequirements,
+			Tags:          []string{"dns"},
+			Remediation:   "Replace the bare public suffix with a name registered under it.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewDnsNameContainsBareIANASuffix,
@@ -31,6 +31,8 @@ func init() {
 			Description:   "DNSNames should not have an empty label.",
 			Citation:      "BRs: 7.1.4.2",
 			Source:        lint.CABFBaselineRequirements,
+			Tags:          []string{"dns"},
+			Remediation:   "Remove the empty label, such as that between consecutive periods or after a trailing period, from the dNSName.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewDNSNameEmptyLabel,
@@ -29,6 +29,8 @@ func init() {
 			Description:   "FQDNs MUST consist solely of Domain Labels that are P‐Labels or Non‐Reserved LDH Labels",
 			Citation:      "BRs: 7.1.4.2.1",
 			Source:        lint.CABFBaselineRequirements,
+			Tags:          []string{"dns"},
+			Remediation:   "Replace labels beginning with two characters followed by \"--\" with valid IDNA A-labels beginning with \"xn--\", or remove them.",
 			EffectiveDate: util.NoReservedDomainLabelsDate,
 		},
 		Lint: NewDNSNameContainsProhibitedReservedLabel,
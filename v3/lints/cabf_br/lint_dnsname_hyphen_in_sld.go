@@ -31,6 +31,8 @@ func init() {
 			Description:   "DNSName should not have a hyphen beginning or ending the SLD",
 			Citation:      "BRs 7.1.4.2",
 			Source:        lint.CABFBaselineRequirements,
+			Tags:          []string{"dns"},
+			Remediation:   "Remove the hyphen from the start or end of the second level domain label of the dNSName.",
 			EffectiveDate: util.RFC5280Date,
 		},
 		Lint: NewDNSNameHyphenInSLD,
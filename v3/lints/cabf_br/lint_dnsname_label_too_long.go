@@ -31,6 +31,8 @@ func init() {
 			Description:   "DNSName labels MUST be less than or equal to 63 characters",
 			Citation:      "RFC 1035",
 			Source:        lint.CABFBaselineRequirements,
+			Tags:          []string{"dns"},
+			Remediation:   "Shorten each label of the dNSName to no more than 63 characters.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewDNSNameLabelLengthTooLong,
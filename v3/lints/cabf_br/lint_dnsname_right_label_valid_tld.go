@@ -29,6 +29,8 @@ func init() {
 			Description:   "DNSNames must have a valid TLD.",
 			Citation:      "BRs: 3.2.2.4",
 			Source:        lint.CABFBaselineRequirements,
+			Tags:          []string{"dns"},
+			Remediation:   "Correct the dNSName to end in a top level domain delegated in the IANA root zone at the time of issuance.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewDNSNameValidTLD,
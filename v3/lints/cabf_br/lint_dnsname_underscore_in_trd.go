@@ -31,6 +31,8 @@ func init() {
 			Description:   "DNSName MUST NOT contain underscore characters",
 			Citation:      "BRs: 7.1.4.2.1",
 			Source:        lint.CABFBaselineRequirements,
+			Tags:          []string{"dns"},
+			Remediation:   "Remove the underscore from the labels of the dNSName to the left of the registered domain.",
 			EffectiveDate: util.RFC5280Date,
 		},
 		Lint: NewDNSNameUnderscoreInTRD,
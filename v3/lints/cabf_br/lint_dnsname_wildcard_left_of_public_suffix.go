@@ -29,6 +29,8 @@ func init() {
 			Description:   "the CA MUST establish and follow a documented procedure[^pubsuffix] that determines if the wildcard character occurs in the first label position to the left of a “registry‐controlled” label or “public suffix”",
 			Citation:      "BRs: 3.2.2.6",
 			Source:        lint.CABFBaselineRequirements,
+			Tags:          []string{"dns"},
+			Remediation:   "Replace the wildcard immediately to the left of a public suffix with a name registered under the suffix, e.g. \"*.example.com\" rather than \"*.com\".",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewDNSNameWildcardLeftofPublicSuffix,
@@ -31,6 +31,8 @@ func init() {
 			Description:   "DNSName should not have wildcards except in the left-most label",
 			Citation:      "BRs: 1.6.1, Wildcard Domain Name",
 			Source:        lint.CABFBaselineRequirements,
+			Tags:          []string{"dns"},
+			Remediation:   "Place the wildcard only as the entire left-most label of the dNSName.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewDNSNameWildcardOnlyInLeftlabel,
@@ -33,6 +33,8 @@ func init() {
 			Description:   "DSA: Public key value has the unique correct representation in the field, and that the key has the correct order in the subgroup",
 			Citation:      "BRs v1.7.0: 6.1.6",
 			Source:        lint.CABFBaselineRequirements,
+			Tags:          []string{"key"},
+			Remediation:   "Reject the DSA key, whose public value is not of the order of the subgroup, and certify an RSA or ECDSA key instead.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewDsaSubgroup,
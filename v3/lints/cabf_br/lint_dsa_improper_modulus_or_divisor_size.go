@@ -31,6 +31,8 @@ func init() {
 			Description:   "Certificates MUST meet the following requirements for DSA algorithm type and key size: L=2048 and N=224,256 or L=3072 and N=256",
 			Citation:      "BRs v1.7.0: 6.1.5",
 			Source:        lint.CABFBaselineRequirements,
+			Tags:          []string{"key"},
+			Remediation:   "Reject the DSA key, whose sizes are not L=2048 and N=224 or 256 or L=3072 and N=256, and certify an RSA or ECDSA key instead.",
 			EffectiveDate: util.ZeroDate,
 		},
 		Lint: NewDsaImproperSize,
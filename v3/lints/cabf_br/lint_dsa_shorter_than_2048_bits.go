@@ -32,6 +32,8 @@ func init() {
 			Citation:    "BRs v1.7.0: 6.1.5",
 			// Refer to BRs: 6.1.5, taking the statement "Before 31 Dec 2010" literally
 			Source:        lint.CABFBaselineRequirements,
+			Tags:          []string{"key"},
+			Remediation:   "Reject DSA keys smaller than 2048 bits, and certify an RSA or ECDSA key instead.",
 			EffectiveDate: util.ZeroDate,
 		},
 		Lint: NewDsaTooShort,
@@ -37,6 +37,7 @@ func init() {
 			Description:     "Subordinate CA Certificate: authorityInformationAccess MUST be present, with the exception of stapling.",
 			Citation:        "BRs: 7.1.2.2",
 			Source:          lint.CABFBaselineRequirements,
+			Remediation:     "Include an authorityInformationAccess extension with the HTTP URL of the OCSP responder of the issuing CA in subordinate CA certificates.",
 			EffectiveDate:   util.CABEffectiveDate,
 			IneffectiveDate: util.CABFBRs_1_7_1_Date,
 		},
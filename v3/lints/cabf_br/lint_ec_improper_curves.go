@@ -37,6 +37,8 @@ func init() {
 			Description: "Only one of NIST P‐256, P‐384, or P‐521 can be used",
 			Citation:    "BRs: 6.1.5",
 			Source:      lint.CABFBaselineRequirements,
+			Tags:        []string{"key"},
+			Remediation: "Certify only keys on the P-256, P-384 or P-521 named curves.",
 			// Refer to BRs: 6.1.5, taking the statement "Before 31 Dec 2010" literally
 			EffectiveDate: util.ZeroDate,
 		},
@@ -40,6 +40,7 @@ func init() {
 			Description:   "iPAddress name constraint intersects an IANA reserved network",
 			Citation:      "BRs: 7.1.5 / 7.1.4.2.1",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Remove the reserved IP address ranges from the permittedSubtrees of the nameConstraints extension.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewNCReservedIPNet,
@@ -29,6 +29,7 @@ func init() {
 			Description:   "CAs SHALL NOT issue certificates with a subjectAltName extension or subject:commonName field containing a Reserved IP Address or Internal Name.",
 			Citation:      "BRs: 7.1.4.2.1",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Remove the reserved IP addresses from the subjectAltName and commonName of the certificate.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewSANReservedIP,
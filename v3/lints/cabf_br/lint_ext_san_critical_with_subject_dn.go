@@ -40,6 +40,7 @@ func init() {
 			Description:   "If the subject contains a distinguished name, subjectAlternateName SHOULD be non-critical",
 			Citation:      "RFC 5280: 4.2.1.6",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Mark the subjectAltName extension as non-critical when the subject is not empty.",
 			EffectiveDate: util.RFC5280Date,
 		},
 		Lint: NewExtSANCriticalWithSubjectDN,
@@ -36,6 +36,7 @@ func init() {
 			Description:   "Subscriber certificates MUST contain the Subject Alternate Name extension",
 			Citation:      "BRs: 7.1.4.2.1",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Include a subjectAltName extension listing every domain name or IP address of the subscriber certificate.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewSANMissing,
@@ -40,6 +40,7 @@ func init() {
 			Description:   "The Subject Alternate Name extension MUST contain only 'dnsName' and 'ipaddress' name types.",
 			Citation:      "BRs: 7.1.4.2.1",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Remove the otherName from the subjectAltName, which may contain only dNSName and iPAddress entries.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewSANOtherName,
@@ -33,6 +33,8 @@ func init() {
 			Description:   "certificates with v2 .onion names need valid TorServiceDescriptors in extension",
 			Citation:      "BRs: Ballot 201, Ballot SC27",
 			Source:        lint.CABFBaselineRequirements,
+			Tags:          []string{"dns"},
+			Remediation:   "Include a TorServiceDescriptor extension holding a valid descriptor hash for each version 2 .onion name, or use version 3 .onion names.",
 			EffectiveDate: util.CABV201Date,
 		},
 		Lint: NewTorServiceDescHashInvalid,
@@ -29,6 +29,7 @@ func init() {
 			Description:   "if present the subject commonName field MUST contain a single IP address or Fully-Qualified Domain Name",
 			Citation:      "BRs: 7.1.4.2.2",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Include at most one commonName in the subject, holding a single name from the subjectAltName extension.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewExtraSubjectCommonNames,
@@ -33,6 +33,7 @@ func init() {
 			Description:   "Certificates MUST be of type X.590 v3",
 			Citation:      "BRs: 7.1.1",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Issue the certificate as an X.509 version 3 certificate, with a version field of 2.",
 			EffectiveDate: util.CABV130Date,
 		},
 		Lint: NewInvalidCertificateVersion,
@@ -0,0 +1,67 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"bytes"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+)
+
+type issuerDNNotByteIdenticalToIssuerSubject struct{}
+
+/************************************************
+BRs: 7.1.4.1
+For every valid Certification Path (as defined by RFC 5280, Section 6):
+  - For each Certificate in the Certification Path, the encoded content of the
+    Issuer Distinguished Name field of a Certificate SHALL be byte-for-byte
+    identical with the encoded form of the Subject Distinguished Name field of
+    the Issuing CA certificate.
+************************************************/
+
+func init() {
+	lint.RegisterChainLint(&lint.ChainLint{
+		LintMetadata: lint.LintMetadata{
+			Name:          "e_issuer_dn_not_byte_identical_to_issuer_subject",
+			Description:   "The encoded Issuer Distinguished Name of a certificate SHALL be byte-for-byte identical to the encoded Subject Distinguished Name of the Issuing CA certificate",
+			Citation:      "BRs: 7.1.4.1",
+			Source:        lint.CABFBaselineRequirements,
+			Tags:          []string{"encoding"},
+			Remediation:   "Copy the encoded subject of the issuing CA certificate into the issuer field byte for byte, rather than re-encoding it.",
+			EffectiveDate: util.SC62EffectiveDate,
+		},
+		Lint: NewIssuerDNNotByteIdenticalToIssuerSubject,
+	})
+}
+
+func NewIssuerDNNotByteIdenticalToIssuerSubject() lint.ChainLintInterface {
+	return &issuerDNNotByteIdenticalToIssuerSubject{}
+}
+
+func (l *issuerDNNotByteIdenticalToIssuerSubject) CheckApplies(c *x509.Certificate, issuer *x509.Certificate) bool {
+	return true
+}
+
+func (l *issuerDNNotByteIdenticalToIssuerSubject) Execute(c *x509.Certificate, issuer *x509.Certificate) *lint.LintResult {
+	if !bytes.Equal(c.RawIssuer, issuer.RawSubject) {
+		return &lint.LintResult{
+			Status:  lint.Error,
+			Details: "the encoded issuer name is not byte-for-byte identical to the encoded subject name of the issuing CA",
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
@@ -0,0 +1,55 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/test"
+)
+
+func TestIssuerDNNotByteIdenticalToIssuerSubject(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name   string
+		path   string
+		issuer string
+		want   lint.LintStatus
+	}{
+		{
+			name:   "issuer name byte identical",
+			path:   "chainLeafValid.pem",
+			issuer: "chainIssuingCA.pem",
+			want:   lint.Pass,
+		},
+		{
+			name:   "issuer name re-encoded as UTF8String",
+			path:   "chainLeafIssuerNotByteIdentical.pem",
+			issuer: "chainIssuingCA.pem",
+			want:   lint.Error,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got := test.TestChainLint(t, "e_issuer_dn_not_byte_identical_to_issuer_subject", tc.path, tc.issuer).Status
+			if tc.want != got {
+				t.Errorf("%s: expected %s, got %s", tc.path, tc.want, got)
+			}
+		})
+	}
+}
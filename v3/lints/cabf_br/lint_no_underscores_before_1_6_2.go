@@ -30,6 +30,8 @@ func init() {
 			Description:     "Before explicitly stating as such in CABF 1.6.2, the stance of RFC5280 is adopted that DNSNames MUST NOT contain an underscore character.",
 			Citation:        "BR 7.1.4.2.1",
 			Source:          lint.CABFBaselineRequirements,
+			Tags:            []string{"dns"},
+			Remediation:     "Remove the underscore from the dNSName.",
 			EffectiveDate:   util.ZeroDate,
 			IneffectiveDate: util.CABFBRs_1_6_2_Date,
 		},
@@ -30,6 +30,7 @@ func init() {
 				" defined by RFC6960",
 			Citation:      "BRs: 4.9.9",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Include the id-pkix-ocsp-nocheck extension in delegated OCSP responder certificates.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewOCSPIDPKIXOCSPNocheckExtNotIncludedServerAuth,
@@ -0,0 +1,83 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"fmt"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zcrypto/x509/revocation/ocsp"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+)
+
+type ocspSignatureAlgorithmNotAllowed struct{}
+
+/************************************************
+BRs: 7.1.3.2
+All objects signed by a CA Private Key MUST conform to these requirements on
+the use of the AlgorithmIdentifier or AlgorithmIdentifier-derived types in the
+following contexts:
+  - In a Certificate or Precertificate.
+  - In a CRL.
+  - In an OCSP response.
+
+Only the RSASSA-PKCS1-v1_5, RSASSA-PSS and ECDSA algorithms with SHA-256,
+SHA-384 or SHA-512 are permitted.
+************************************************/
+
+func init() {
+	lint.RegisterOCSPResponseLint(&lint.OCSPResponseLint{
+		LintMetadata: lint.LintMetadata{
+			Name:          "e_ocsp_signature_algorithm_not_allowed",
+			Description:   "OCSP responses MUST be signed with an RSA or ECDSA algorithm using SHA-256, SHA-384 or SHA-512",
+			Citation:      "BRs: 7.1.3.2",
+			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Sign OCSP responses with RSA or ECDSA using SHA-256, SHA-384 or SHA-512.",
+			EffectiveDate: util.CABFBRs_1_7_1_Date,
+		},
+		Lint: NewOCSPSignatureAlgorithmNotAllowed,
+	})
+}
+
+func NewOCSPSignatureAlgorithmNotAllowed() lint.OCSPResponseLintInterface {
+	return &ocspSignatureAlgorithmNotAllowed{}
+}
+
+var allowedOCSPSignatureAlgorithms = map[x509.SignatureAlgorithm]bool{
+	x509.SHA256WithRSA:    true,
+	x509.SHA384WithRSA:    true,
+	x509.SHA512WithRSA:    true,
+	x509.SHA256WithRSAPSS: true,
+	x509.SHA384WithRSAPSS: true,
+	x509.SHA512WithRSAPSS: true,
+	x509.ECDSAWithSHA256:  true,
+	x509.ECDSAWithSHA384:  true,
+	x509.ECDSAWithSHA512:  true,
+}
+
+func (l *ocspSignatureAlgorithmNotAllowed) CheckApplies(r *ocsp.Response) bool {
+	return true
+}
+
+func (l *ocspSignatureAlgorithmNotAllowed) Execute(r *ocsp.Response) *lint.LintResult {
+	if !allowedOCSPSignatureAlgorithms[r.SignatureAlgorithm] {
+		return &lint.LintResult{
+			Status:  lint.Error,
+			Details: fmt.Sprintf("OCSP response is signed with %s, which is not an allowed signature algorithm", r.SignatureAlgorithm),
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
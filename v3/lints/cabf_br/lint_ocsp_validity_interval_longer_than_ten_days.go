@@ -0,0 +1,72 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/zmap/zcrypto/x509/revocation/ocsp"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+)
+
+type ocspValidityIntervalLongerThanTenDays struct{}
+
+/************************************************
+BRs: 4.9.10
+For the status of Subscriber Certificates:
+  ...
+  2. OCSP responses MUST have a validity interval less than or equal to ten
+     days;
+
+The validity interval of an OCSP response is the difference in time between
+the thisUpdate and nextUpdate field, inclusive.
+************************************************/
+
+func init() {
+	lint.RegisterOCSPResponseLint(&lint.OCSPResponseLint{
+		LintMetadata: lint.LintMetadata{
+			Name:          "e_ocsp_validity_interval_longer_than_ten_days",
+			Description:   "OCSP responses MUST have a validity interval less than or equal to ten days",
+			Citation:      "BRs: 4.9.10",
+			Source:        lint.CABFBaselineRequirements,
+			Tags:          []string{"time"},
+			Remediation:   "Set nextUpdate of OCSP responses to no more than ten days after thisUpdate.",
+			EffectiveDate: util.CABFBRs_1_7_1_Date,
+		},
+		Lint: NewOCSPValidityIntervalLongerThanTenDays,
+	})
+}
+
+func NewOCSPValidityIntervalLongerThanTenDays() lint.OCSPResponseLintInterface {
+	return &ocspValidityIntervalLongerThanTenDays{}
+}
+
+func (l *ocspValidityIntervalLongerThanTenDays) CheckApplies(r *ocsp.Response) bool {
+	return !r.NextUpdate.IsZero()
+}
+
+func (l *ocspValidityIntervalLongerThanTenDays) Execute(r *ocsp.Response) *lint.LintResult {
+	// The validity interval is inclusive of both thisUpdate and nextUpdate.
+	interval := r.NextUpdate.Sub(r.ThisUpdate) + time.Second
+	if interval > 10*24*time.Hour {
+		return &lint.LintResult{
+			Status:  lint.Error,
+			Details: fmt.Sprintf("OCSP response has a validity interval of %s, which is longer than ten days", interval),
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
@@ -31,6 +31,8 @@ func init() {
 			Description:   "In a validity period beginning on or before 31 Dec 2010, root CA certificates using RSA public key algorithm MUST use a 2048 bit modulus",
 			Citation:      "BRs: 6.1.5",
 			Source:        lint.CABFBaselineRequirements,
+			Tags:          []string{"key"},
+			Remediation:   "Replace the root CA key with an RSA key of at least 2048 bits.",
 			EffectiveDate: util.ZeroDate,
 		},
 		Lint: NewRootCaModSize,
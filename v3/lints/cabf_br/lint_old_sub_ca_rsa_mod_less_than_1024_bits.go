@@ -33,6 +33,8 @@ func init() {
 			Description: "In a validity period beginning on or before 31 Dec 2010 and ending on or before 31 Dec 2013, subordinate CA certificates using RSA public key algorithm MUST use a 1024 bit modulus",
 			Citation:    "BRs: 6.1.5",
 			Source:      lint.CABFBaselineRequirements,
+			Tags:        []string{"key"},
+			Remediation: "Replace the subordinate CA key with an RSA key of at least 1024 bits, or 2048 bits for certificates valid after 2013.",
 			// since effective date should be checked against end date in this specific case, putting time check into checkApplies instead, ZeroDate here to automatically pass NE test
 			EffectiveDate: util.ZeroDate,
 		},
@@ -31,6 +31,8 @@ func init() {
 			Description: "In a validity period ending on or before 31 Dec 2013, subscriber certificates using RSA public key algorithm MUST use a 1024 bit modulus",
 			Citation:    "BRs: 6.1.5",
 			Source:      lint.CABFBaselineRequirements,
+			Tags:        []string{"key"},
+			Remediation: "Reject RSA keys smaller than 1024 bits, and require the subscriber to generate a new key of at least 2048 bits.",
 			// since effective date should be checked against end date in this specific case, putting time check into checkApplies instead, ZeroDate here to automatically pass NE test
 			EffectiveDate: util.ZeroDate,
 		},
@@ -27,6 +27,7 @@ func init() {
 			Description:   "OrganizationalUnitName is prohibited if...the certificate was issued on or after September 1, 2022",
 			Citation:      "BRs: 7.1.4.2.2-i",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Remove the organizationalUnitName from the subject of subscriber certificates.",
 			EffectiveDate: util.CABFBRs_OU_Prohibited_Date,
 		},
 		Lint: NewOrganizationalUnitNameProhibited,
@@ -29,6 +29,8 @@ func init() {
 			Description:   "DSA was removed from the Baseline Requirements as a valid signature algorithm in 1.7.1.",
 			Citation:      "BRs: v1.7.1",
 			Source:        lint.CABFBaselineRequirements,
+			Tags:          []string{"key"},
+			Remediation:   "Sign with an RSA or ECDSA key instead of DSA, and do not certify DSA public keys.",
 			EffectiveDate: util.CABFBRs_1_7_1_Date,
 		},
 		Lint: NewProhibitDSAUsage,
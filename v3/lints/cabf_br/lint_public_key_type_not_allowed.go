@@ -29,6 +29,8 @@ func init() {
 			Description:   "Certificates MUST have RSA, DSA, or ECDSA public key type",
 			Citation:      "BRs: 6.1.5",
 			Source:        lint.CABFBaselineRequirements,
+			Tags:          []string{"key"},
+			Remediation:   "Certify only RSA or ECDSA public keys.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewPublicKeyAllowed,
@@ -36,6 +36,7 @@ func init() {
 			Description:   "Root CA certificate basicConstraint extension pathLenConstraint field SHOULD NOT be present",
 			Citation:      "BRs: 7.1.2.1",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Remove the pathLenConstraint from the basicConstraints extension of root CA certificates.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewRootCaPathLenPresent,
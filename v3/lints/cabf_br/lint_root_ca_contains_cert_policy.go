@@ -34,6 +34,7 @@ func init() {
 			Description:   "Root CA Certificate: certificatePolicies SHOULD NOT be present.",
 			Citation:      "BRs: 7.1.2.1",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Remove the certificatePolicies extension from root CA certificates.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewRootCAContainsCertPolicy,
@@ -34,6 +34,7 @@ func init() {
 			Description:   "Root CA Certificate: extendedKeyUsage MUST NOT be present.t",
 			Citation:      "BRs: 7.1.2.1",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Remove the extKeyUsage extension from root CA certificates.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewRootCAContainsEKU,
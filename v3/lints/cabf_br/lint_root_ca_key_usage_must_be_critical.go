@@ -29,6 +29,7 @@ func init() {
 			Description:   "Root CA certificates MUST have Key Usage Extension marked critical",
 			Citation:      "BRs: 7.1.2.1",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Mark the keyUsage extension of root CA certificates as critical.",
 			EffectiveDate: util.RFC2459Date,
 		},
 		Lint: NewRootCAKeyUsageMustBeCritical,
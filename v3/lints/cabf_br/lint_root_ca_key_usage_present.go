@@ -29,6 +29,7 @@ func init() {
 			Description:   "Root CA certificates MUST have Key Usage Extension Present",
 			Citation:      "BRs: 7.1.2.1",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Include a keyUsage extension in root CA certificates asserting keyCertSign and cRLSign.",
 			EffectiveDate: util.RFC2459Date,
 		},
 		Lint: NewRootCAKeyUsagePresent,
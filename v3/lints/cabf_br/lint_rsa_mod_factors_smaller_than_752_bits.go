@@ -36,6 +36,8 @@ func init() {
 			Description:   "RSA: Modulus SHOULD also have the following characteristics: no factors smaller than 752",
 			Citation:      "BRs: 6.1.6",
 			Source:        lint.CABFBaselineRequirements,
+			Tags:          []string{"key"},
+			Remediation:   "Reject the RSA key, whose modulus has a small factor, and require the subscriber to generate a new key with a secure key generator.",
 			EffectiveDate: util.CABV113Date,
 		},
 		Lint: NewRsaModSmallFactor,
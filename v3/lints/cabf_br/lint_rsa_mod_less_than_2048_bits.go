@@ -31,6 +31,8 @@ func init() {
 			Description:   "For certificates valid after 31 Dec 2013, all certificates using RSA public key algorithm MUST have 2048 bits of modulus",
 			Citation:      "BRs: 6.1.5",
 			Source:        lint.CABFBaselineRequirements,
+			Tags:          []string{"key"},
+			Remediation:   "Reject RSA keys smaller than 2048 bits, and require the subscriber to generate a new key of at least 2048 bits.",
 			EffectiveDate: util.ZeroDate,
 		},
 		Lint: NewRsaParsedTestsKeySize,
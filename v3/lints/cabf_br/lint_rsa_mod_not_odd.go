@@ -37,6 +37,8 @@ func init() {
 			Description:   "RSA: Modulus SHOULD also have the following characteristics: an odd number",
 			Citation:      "BRs: 6.1.6",
 			Source:        lint.CABFBaselineRequirements,
+			Tags:          []string{"key"},
+			Remediation:   "Reject the RSA key, whose modulus is even, and require the subscriber to generate a new key with a secure key generator.",
 			EffectiveDate: util.CABV113Date,
 		},
 		Lint: NewRsaParsedTestsKeyModOdd,
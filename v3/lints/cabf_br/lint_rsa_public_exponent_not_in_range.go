@@ -39,6 +39,8 @@ func init() {
 			Description:   "RSA: Public exponent SHOULD be in the range between 2^16 + 1 and 2^256 - 1",
 			Citation:      "BRs: 6.1.6",
 			Source:        lint.CABFBaselineRequirements,
+			Tags:          []string{"key"},
+			Remediation:   "Certify only RSA keys with a public exponent between 2^16+1 and 2^256-1, such as 65537.",
 			EffectiveDate: util.CABV113Date,
 		},
 		Lint: NewRsaParsedTestsExpInRange,
@@ -36,6 +36,8 @@ func init() {
 			Description:   "RSA: Value of public exponent is an odd number equal to 3 or more.",
 			Citation:      "BRs: 6.1.6",
 			Source:        lint.CABFBaselineRequirements,
+			Tags:          []string{"key"},
+			Remediation:   "Reject RSA keys with a public exponent smaller than 3, and require the subscriber to generate a new key with a standard exponent such as 65537.",
 			EffectiveDate: util.CABV113Date,
 		},
 		Lint: NewRsaParsedTestsExpBounds,
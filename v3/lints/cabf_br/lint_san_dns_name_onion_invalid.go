@@ -84,6 +84,8 @@ func init() {
 			Description:   "certificates with a .onion subject name must be issued in accordance with the Tor address/rendezvous specification",
 			Citation:      "RFC 7686, EVGs v1.7.2: Appendix F, BRs v1.6.9: Appendix C",
 			Source:        lint.CABFBaselineRequirements,
+			Tags:          []string{"dns"},
+			Remediation:   "Correct the .onion name to a valid version 2 or version 3 Tor hidden service address.",
 			EffectiveDate: util.OnionOnlyEVDate,
 		},
 		Lint: NewOnionNotValid,
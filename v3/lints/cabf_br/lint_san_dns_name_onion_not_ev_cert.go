@@ -31,6 +31,8 @@ func init() {
 			Description:   "certificates with a .onion subject name must be issued in accordance with EV Guidelines",
 			Citation:      "CABF Ballot 144",
 			Source:        lint.CABFBaselineRequirements,
+			Tags:          []string{"dns", "ev"},
+			Remediation:   "Issue certificates for .onion names as EV certificates, or validate them as described in Appendix C of the Baseline Requirements.",
 			EffectiveDate: util.OnionOnlyEVDate,
 		},
 		Lint: NewOnionNotEV,
@@ -61,6 +61,7 @@ func init() {
 			Description:   "Certificates MUST meet the following requirements for algorithm Source: SHA-1*, SHA-256, SHA-384, SHA-512",
 			Citation:      "BRs: 6.1.5",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Sign certificates with RSA or ECDSA using SHA-256, SHA-384 or SHA-512.",
 			EffectiveDate: util.ZeroDate,
 		},
 		Lint: NewSignatureAlgorithmNotSupported,
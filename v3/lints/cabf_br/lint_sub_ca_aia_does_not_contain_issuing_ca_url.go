@@ -39,6 +39,7 @@ func init() {
 			Description:   "Subordinate CA Certificate: authorityInformationAccess SHOULD also contain the HTTP URL of the Issuing CA's certificate.",
 			Citation:      "BRs: 7.1.2.2",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Include an accessDescription with the id-ad-caIssuers method and the HTTP URL of the issuing CA certificate in the authorityInformationAccess extension.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewSubCaIssuerUrl,
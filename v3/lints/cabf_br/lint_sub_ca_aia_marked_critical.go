@@ -29,6 +29,7 @@ func init() {
 			Description:   "Subordinate CA Certificate: authorityInformationAccess MUST NOT be marked critical",
 			Citation:      "BRs: 7.1.2.2",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Mark the authorityInformationAccess extension of subordinate CA certificates as non-critical.",
 			EffectiveDate: util.ZeroDate,
 		},
 		Lint: NewSubCaAIAMarkedCritical,
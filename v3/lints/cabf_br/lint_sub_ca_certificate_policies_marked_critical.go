@@ -34,6 +34,7 @@ func init() {
 			Description:   "Subordinate CA certificates certificatePolicies extension should not be marked as critical",
 			Citation:      "BRs: 7.1.2.2",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Mark the certificatePolicies extension of subordinate CA certificates as non-critical.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewSubCACertPolicyCrit,
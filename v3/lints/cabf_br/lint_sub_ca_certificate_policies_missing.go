@@ -34,6 +34,7 @@ func init() {
 			Description:   "Subordinate CA certificates must have a certificatePolicies extension",
 			Citation:      "BRs: 7.1.2.2",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Include a certificatePolicies extension in subordinate CA certificates, listing the policies under which the CA issues.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewSubCACertPolicyMissing,
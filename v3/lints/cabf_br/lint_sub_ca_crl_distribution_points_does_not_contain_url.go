@@ -37,6 +37,7 @@ func init() {
 			Description:   "Subordinate CA Certificate: cRLDistributionPoints MUST contain the HTTP URL of the CA's CRL service.",
 			Citation:      "BRs: 7.1.2.2",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Include the HTTP URL of the CRL of the issuing CA within the cRLDistributionPoints extension of subordinate CA certificates.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewSubCACRLDistNoUrl,
@@ -35,6 +35,7 @@ func init() {
 			Description:   "Subordinate CA Certificate: cRLDistributionPoints MUST be present and MUST NOT be marked critical.",
 			Citation:      "BRs: 7.1.2.2",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Mark the cRLDistributionPoints extension of subordinate CA certificates as non-critical.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewSubCACRLDistCrit,
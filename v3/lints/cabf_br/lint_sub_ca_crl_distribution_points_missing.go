@@ -35,6 +35,7 @@ func init() {
 			Description:   "Subordinate CA Certificate: cRLDistributionPoints MUST be present and MUST NOT be marked critical.",
 			Citation:      "BRs: 7.1.2.2",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Include a cRLDistributionPoints extension with the HTTP URL of the CRL of the issuing CA in subordinate CA certificates.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewSubCACRLDistMissing,
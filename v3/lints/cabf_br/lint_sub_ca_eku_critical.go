@@ -37,6 +37,7 @@ func init() {
 			Description:   "Subordinate CA certificate extkeyUsage extension should be marked non-critical if present",
 			Citation:      "BRs: 7.1.2.2",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Mark the extKeyUsage extension of subordinate CA certificates as non-critical.",
 			EffectiveDate: util.CABV116Date,
 		},
 		Lint: NewSubCAEKUCrit,
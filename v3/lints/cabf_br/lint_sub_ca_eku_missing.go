@@ -29,6 +29,7 @@ func init() {
 			Description:   "To be considered Technically Constrained, the Subordinate CA certificate MUST have extkeyUsage extension",
 			Citation:      "BRs: 7.1.5",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Include an extKeyUsage extension in subordinate CA certificates that are meant to be technically constrained.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewSubCAEKUMissing,
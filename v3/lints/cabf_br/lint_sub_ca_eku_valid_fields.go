@@ -29,6 +29,7 @@ func init() {
 			Description:   "Subordinate CA extkeyUsage, either id-kp-serverAuth or id-kp-clientAuth or both values MUST be present to be technically constrained.",
 			Citation:      "BRs: 7.1.2.2",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Assert id-kp-serverAuth, id-kp-clientAuth or both in the extKeyUsage of subordinate CA certificates that are meant to be technically constrained.",
 			EffectiveDate: util.CABV116Date,
 		},
 		Lint: NewSubCAEKUValidFields,
@@ -40,6 +40,7 @@ func init() {
 			Description:   "Subordinate CA Certificate: NameConstraints if present, SHOULD be marked critical.",
 			Citation:      "BRs: 7.1.2.2",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Mark the nameConstraints extension of subordinate CA certificates as critical.",
 			EffectiveDate: util.CABV102Date,
 		},
 		Lint: NewSubCANameConstraintsNotCritical,
@@ -42,6 +42,8 @@ func init() {
 			Description:   "Subscriber certificates authorityInformationAccess extension should contain the HTTP URL of the issuing CA’s certificate, for public certificates this should not be an internal name",
 			Citation:      "BRs: 7.1.2.10.3",
 			Source:        lint.CABFBaselineRequirements,
+			Tags:          []string{"dns"},
+			Remediation:   "Replace internal host names within the authorityInformationAccess extension with publicly resolvable HTTP URLs.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewSubCertAIAInternalName,
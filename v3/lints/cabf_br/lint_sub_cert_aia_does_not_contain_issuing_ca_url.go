@@ -38,6 +38,7 @@ func init() {
 			Description:   "Subscriber certificates authorityInformationAccess extension should contain the HTTP URL of the issuing CA’s certificate",
 			Citation:      "BRs: 7.1.2.3",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Include an accessDescription with the id-ad-caIssuers method and the HTTP URL of the issuing CA certificate in the authorityInformationAccess extension.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewSubCertIssuerUrl,
@@ -40,6 +40,7 @@ func init() {
 			Description:   "Subscriber Certificate: authorityInformationAccess MUST contain the HTTP URL of the Issuing CA's OSCP responder.",
 			Citation:      "BRs: 7.1.2.3",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Include an accessDescription with the id-ad-ocsp method and the HTTP URL of the OCSP responder of the issuing CA in the authorityInformationAccess extension.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewSubCertOcspUrl,
@@ -29,6 +29,7 @@ func init() {
 			Description:   "Subscriber Certificate: authorityInformationAccess MUST NOT be marked critical",
 			Citation:      "BRs: 7.1.2.3",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Mark the authorityInformationAccess extension of subscriber certificates as non-critical.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewSubCertAiaMarkedCritical,
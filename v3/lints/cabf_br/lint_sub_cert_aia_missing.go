@@ -38,6 +38,7 @@ func init() {
 			Description:   "Subscriber Certificate: authorityInformationAccess MUST be present.",
 			Citation:      "BRs: 7.1.2.3",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Include an authorityInformationAccess extension with the HTTP URLs of the OCSP responder and the certificate of the issuing CA in subscriber certificates.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewSubCertAiaMissing,
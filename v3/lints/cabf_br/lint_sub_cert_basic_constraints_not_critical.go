@@ -39,6 +39,7 @@ func init() {
 			Description:   "basicConstraints MAY appear in the certificate, and when it is included MUST be marked as critical",
 			Citation:      "CA/Browser Forum BRs: 7.1.2.7.6",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Mark the basicConstraints extension as critical, or omit it from subscriber certificates.",
 			EffectiveDate: util.SC62EffectiveDate,
 		},
 		Lint: NewSubCertBasicConstCrit,
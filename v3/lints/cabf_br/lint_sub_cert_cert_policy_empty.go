@@ -29,6 +29,7 @@ func init() {
 			Description:   "Subscriber certificates must contain at least one policy identifier that indicates adherence to CAB standards",
 			Citation:      "BRs: 7.1.2.3",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Include the CA/Browser Forum reserved policy OID for the validation performed, e.g. 2.23.140.1.2.1 for domain validation, in the certificatePolicies extension.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewSubCertPolicyEmpty,
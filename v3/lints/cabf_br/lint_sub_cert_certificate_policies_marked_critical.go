@@ -35,6 +35,7 @@ func init() {
 			Description:   "Subscriber Certificate: certificatePolicies MUST be present and SHOULD NOT be marked critical.",
 			Citation:      "BRs: 7.1.2.3",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Mark the certificatePolicies extension of subscriber certificates as non-critical.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewSubCertPolicyCrit,
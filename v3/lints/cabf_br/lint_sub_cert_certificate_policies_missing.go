@@ -35,6 +35,7 @@ func init() {
 			Description:   "Subscriber Certificate: certificatePolicies MUST be present and SHOULD NOT be marked critical.",
 			Citation:      "BRs: 7.1.2.3",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Include a certificatePolicies extension holding the CA/Browser Forum reserved policy OID for the validation performed in subscriber certificates.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewSubCertPolicy,
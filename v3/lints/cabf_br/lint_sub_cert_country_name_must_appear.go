@@ -29,6 +29,7 @@ func init() {
 			Description:   "Subscriber Certificate: subject:countryName MUST appear if the subject:organizationName field, subject:givenName field, or subject:surname fields are present.",
 			Citation:      "BRs: 7.1.4.2.2",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Include the validated countryName in the subject whenever it contains an organizationName, givenName or surname.",
 			EffectiveDate: util.CABGivenNameDate,
 		},
 		Lint: NewSubCertCountryNameMustAppear,
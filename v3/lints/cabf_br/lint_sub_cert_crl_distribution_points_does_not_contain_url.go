@@ -38,6 +38,7 @@ func init() {
 			Description:   "Subscriber certificate cRLDistributionPoints extension must contain the HTTP URL of the CA’s CRL service",
 			Citation:      "BRs: 7.1.2.3",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Include the HTTP URL of the CRL of the issuing CA within the cRLDistributionPoints extension.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewSubCRLDistNoURL,
@@ -36,6 +36,7 @@ func init() {
 			Description:   "Subscriber Certificate: cRLDistributionPoints MUST NOT be marked critical, and MUST contain the HTTP URL of the CA's CRL service.",
 			Citation:      "BRs: 7.1.2.3",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Mark the cRLDistributionPoints extension of subscriber certificates as non-critical.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewSubCrlDistCrit,
@@ -38,6 +38,7 @@ func init() {
 			Description:   "Subscriber Certificate: extKeyUsage values other than id-kp-serverAuth, id-kp-clientAuth, and id-kp-emailProtection SHOULD NOT be present.",
 			Citation:      "BRs: 7.1.2.3",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Limit the extKeyUsage of subscriber certificates to id-kp-serverAuth, id-kp-clientAuth and id-kp-emailProtection.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewSubExtKeyUsageLegalUsage,
@@ -37,6 +37,7 @@ func init() {
 			Description:   "Subscriber certificates MUST have the extended key usage extension present",
 			Citation:      "BRs: 7.1.2.3",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Include an extKeyUsage extension in subscriber certificates, asserting id-kp-serverAuth for TLS server certificates.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewSubExtKeyUsage,
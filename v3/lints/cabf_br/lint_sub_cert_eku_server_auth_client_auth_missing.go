@@ -38,6 +38,7 @@ func init() {
 			Description:   "Subscriber certificates MUST have either id-kp-serverAuth or id-kp-clientAuth or both present in extKeyUsage",
 			Citation:      "BRs: 7.1.2.3",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Assert id-kp-serverAuth, id-kp-clientAuth or both in the extKeyUsage of subscriber certificates.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewSubExtKeyUsageClientOrServer,
@@ -29,6 +29,7 @@ func init() {
 			Description:   "Subscriber Certificate: A certificate containing a subject:givenName field or subject:surname field MUST contain the (2.23.140.1.2.3) certPolicy OID.",
 			Citation:      "BRs: 7.1.4.2.2",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Include the individual validated policy OID 2.23.140.1.2.3 in certificates whose subject contains a givenName or surname, or remove those attributes.",
 			EffectiveDate: util.CABGivenNameDate,
 		},
 		Lint: NewSubCertSubjectGnOrSnContainsPolicy,
@@ -30,6 +30,7 @@ func init() {
 			Description:   "Subscriber Certificate: basicContrainsts cA field MUST NOT be true.",
 			Citation:      "BRs: 7.1.2.3",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Set the cA field of the basicConstraints extension of subscriber certificates to false, or omit the extension.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewSubCertNotCA,
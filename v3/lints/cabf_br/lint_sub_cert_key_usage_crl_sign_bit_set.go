@@ -35,6 +35,7 @@ func init() {
 			Description:   "Subscriber Certificate: keyUsage if present, bit positions for keyCertSign and cRLSign MUST NOT be set.",
 			Citation:      "BRs: 7.1.2.3",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Remove cRLSign from the keyUsage of subscriber certificates.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewSubCrlSignAllowed,
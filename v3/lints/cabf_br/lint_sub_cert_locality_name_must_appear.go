@@ -29,6 +29,7 @@ func init() {
 			Description:   "Subscriber Certificate: subject:localityName MUST appear if subject:organizationName, subject:givenName, or subject:surname fields are present but the subject:stateOrProvinceName field is absent.",
 			Citation:      "BRs: 7.1.4.2.2",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Include the validated localityName in the subject whenever it contains an organizationName, givenName or surname but no stateOrProvinceName.",
 			EffectiveDate: util.CABGivenNameDate,
 		},
 		Lint: NewSubCertLocalityNameMustAppear,
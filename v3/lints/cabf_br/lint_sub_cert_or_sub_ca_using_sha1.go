@@ -34,6 +34,7 @@ func init() {
 			Description:   "CAs MUST NOT issue any new Subscriber certificates or Subordinate CA certificates using SHA-1 after 1 January 2016",
 			Citation:      "BRs: 7.1.3",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Sign subscriber and subordinate CA certificates with SHA-256 or a stronger hash instead of SHA-1.",
 			EffectiveDate: util.NO_SHA1,
 		},
 		Lint: NewSigAlgTestsSHA1,
@@ -29,6 +29,7 @@ func init() {
 			Description:   "Subscriber Certificate: subject:stateOrProvinceName MUST appear if the subject:organizationName, subject:givenName, or subject:surname fields are present and subject:localityName is absent.",
 			Citation:      "BRs: 7.1.4.2.2",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Include the validated stateOrProvinceName in the subject whenever it contains an organizationName, givenName or surname but no localityName.",
 			EffectiveDate: util.CABGivenNameDate,
 		},
 		Lint: NewSubCertProvinceMustAppear,
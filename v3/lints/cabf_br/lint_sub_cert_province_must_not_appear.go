@@ -29,6 +29,7 @@ func init() {
 			Description:   "Subscriber Certificate: subject:stateOrProvinceName MUST NOT appear if the subject:organizationName, subject:givenName, and subject:surname fields are absent.",
 			Citation:      "BRs: 7.1.4.2.2",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Remove the stateOrProvinceName from subjects that contain no organizationName, givenName or surname.",
 			EffectiveDate: util.CABGivenNameDate,
 		},
 		Lint: NewSubCertProvinceMustNotAppear,
@@ -38,6 +38,8 @@ func init() {
 			Description:   "Subscriber certificates using the SHA-1 algorithm SHOULD NOT have an expiration date later than 1 Jan 2017",
 			Citation:      "BRs: 7.1.3",
 			Source:        lint.CABFBaselineRequirements,
+			Tags:          []string{"time"},
+			Remediation:   "Sign with SHA-256 or a stronger hash, or limit SHA-1 certificates to expire before 1 January 2017.",
 			EffectiveDate: util.CABFBRs_1_2_1_Date,
 		},
 		Lint: NewSha1ExpireLong,
@@ -29,6 +29,7 @@ func init() {
 			Description:   "Subscriber Certificate: subject:streetAddress MUST NOT appear if subject:organizationName, subject:givenName, and subject:surname fields are absent.",
 			Citation:      "BRs: 7.1.4.2.2",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Remove the streetAddress from subjects that contain no organizationName, givenName or surname.",
 			EffectiveDate: util.CABGivenNameDate,
 		},
 		Lint: NewSubCertStreetAddressShouldNotExist,
@@ -29,6 +29,8 @@ func init() {
 			Description:   "Subscriber Certificates issued after 1 July 2016 but prior to 1 March 2018 MUST have a Validity Period no greater than 39 months.",
 			Citation:      "BRs: 6.3.2",
 			Source:        lint.CABFBaselineRequirements,
+			Tags:          []string{"time"},
+			Remediation:   "Shorten the validity period of the subscriber certificate profile to no more than 39 months.",
 			EffectiveDate: util.SubCert39Month,
 		},
 		Lint: NewSubCertValidTimeLongerThan39Months,
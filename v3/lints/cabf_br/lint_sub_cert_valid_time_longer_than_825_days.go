@@ -29,6 +29,8 @@ func init() {
 			Description:   "Subscriber Certificates issued after 1 March 2018, but prior to 1 September 2020, MUST NOT have a Validity Period greater than 825 days.",
 			Citation:      "BRs: 6.3.2",
 			Source:        lint.CABFBaselineRequirements,
+			Tags:          []string{"time"},
+			Remediation:   "Shorten the validity period of the subscriber certificate profile to no more than 825 days.",
 			EffectiveDate: util.SubCert825Days,
 		},
 		Lint: NewSubCertValidTimeLongerThan825Days,
@@ -34,6 +34,7 @@ func init() {
 			Description:     "Subscriber Certificate: commonName is deprecated.",
 			Citation:        "BRs: 7.1.4.2.2",
 			Source:          lint.CABFBaselineRequirements,
+			Remediation:     "Omit the commonName from subscriber certificates, relying on the subjectAltName extension instead.",
 			EffectiveDate:   util.CABEffectiveDate,
 			IneffectiveDate: util.SC62EffectiveDate,
 		},
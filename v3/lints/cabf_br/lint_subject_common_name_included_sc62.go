@@ -34,6 +34,7 @@ func init() {
 			Description:   "Subscriber Certificate: commonName is NOT RECOMMENDED.",
 			Citation:      "BRs: 7.1.2.7.1",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Omit the commonName from subscriber certificates, relying on the subjectAltName extension instead.",
 			EffectiveDate: util.SC62EffectiveDate,
 		},
 		Lint: NewCommonNamesSC62,
@@ -40,6 +40,7 @@ func init() {
 			Description:   "The common name field in subscriber certificates must include only names from the SAN extension",
 			Citation:      "BRs: 7.1.4.2.2",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Set the commonName to exactly one of the names of the subjectAltName extension, with the same case and encoding, or omit it.",
 			EffectiveDate: util.CABFBRs_1_8_0_Date,
 		},
 		Lint: NewSubjectCommonNameNotExactlyFromSAN,
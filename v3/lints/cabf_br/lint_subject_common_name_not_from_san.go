@@ -38,6 +38,7 @@ func init() {
 			Description:     "The common name field in subscriber certificates must include only names from the SAN extension",
 			Citation:        "BRs: 7.1.4.2.2",
 			Source:          lint.CABFBaselineRequirements,
+			Remediation:     "Set the commonName to one of the names of the subjectAltName extension, or omit it.",
 			EffectiveDate:   util.CABEffectiveDate,
 			IneffectiveDate: util.CABFBRs_1_8_0_Date,
 		},
@@ -48,6 +48,7 @@ func init() {
 			// [0]: https://github.com/cabforum/documents/issues/153
 			Citation:      "BRs: 3.2.2.6",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Correct the reverse DNS name in the .arpa zone to the complete name of a single valid IPv4 or IPv6 address.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewArpaMalformedIP,
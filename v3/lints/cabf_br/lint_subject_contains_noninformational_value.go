@@ -40,6 +40,7 @@ func init() {
 			Description:   "Subject name fields must not contain '.','-',' ' or any other indication that the field has been omitted",
 			Citation:      "BRs: 7.1.4.2.2",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Omit subject attributes that have no value rather than filling them with placeholders such as \".\", \"-\" or \" \".",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewIllegalChar,
@@ -38,6 +38,7 @@ func init() {
 			Description:   "If a subject organization name is absent then an organizational unit name MUST NOT be included in subject",
 			Citation:      "BRs: 7.1.4.2.2",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Remove the organizationalUnitName from subjects that contain no organizationName.",
 			EffectiveDate: util.CABFBRs_1_7_9_Date,
 		},
 		Lint: NewSubjectContainsOrganizationalUnitNameButNoOrganizationName,
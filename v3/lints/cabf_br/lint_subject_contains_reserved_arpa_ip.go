@@ -61,6 +61,7 @@ func init() {
 			Description:   "Checks no subject domain name contains a rDNS entry in an .arpa zone specifying a reserved IP address",
 			Citation:      "BRs: 7.1.4.2.1",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Remove the reverse DNS names in the .arpa zone that correspond to reserved IP addresses.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewArpaReservedIP,
@@ -40,6 +40,7 @@ func init() {
 			Description:   "Certificates expiring later than 11 Jan 2015 MUST NOT contain a reserved IP address in the common name field",
 			Citation:      "BRs: 7.1.4.2.1",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Remove the reserved IP address from the commonName of the subject.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewSubjectReservedIP,
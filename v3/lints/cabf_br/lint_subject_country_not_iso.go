@@ -39,6 +39,7 @@ func init() {
 			Description:   "The country name field MUST contain the two-letter ISO code for the country or XX",
 			Citation:      "BRs: 7.1.4.2.2",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Set the countryName of the subject to a two letter ISO 3166-1 code, or to \"XX\" where no country applies.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewCountryNotIso,
@@ -49,6 +49,8 @@ func init() {
 				"MUST comply with specified byte sequences.",
 			Citation:      "BRs: 7.1.3.1",
 			Source:        lint.CABFBaselineRequirements,
+			Tags:          []string{"encoding"},
+			Remediation:   "Encode the AlgorithmIdentifier of the subjectPublicKeyInfo with one of the byte sequences permitted by the Baseline Requirements, e.g. rsaEncryption with NULL parameters.",
 			EffectiveDate: util.CABFBRs_1_7_1_Date,
 		},
 		Lint: NewAlgorithmObjectIdentifierEncoding,
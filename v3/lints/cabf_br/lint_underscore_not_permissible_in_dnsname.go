@@ -31,6 +31,8 @@ func init() {
 			Description:   "DNSNames MUST NOT contain underscore characters",
 			Citation:      "BR 7.1.4.2.1",
 			Source:        lint.CABFBaselineRequirements,
+			Tags:          []string{"dns"},
+			Remediation:   "Remove the underscore from the dNSName.",
 			EffectiveDate: util.CABFBRs_1_6_2_UnderscorePermissibilitySunsetDate,
 		},
 		Lint: func() lint.LintInterface { return &UnderscoreNotPermissibleInDNSName{} },
@@ -30,6 +30,8 @@ func init() {
 			Description:     "From December 10th 2018 to April 1st 2019 DNSNames may contain underscores if-and-only-if every label within each DNS name is a valid LDH label after replacing all underscores with hyphens",
 			Citation:        "BR 7.1.4.2.1",
 			Source:          lint.CABFBaselineRequirements,
+			Tags:            []string{"dns"},
+			Remediation:     "Remove the underscore from the dNSName, or ensure that each label would be a valid LDH label with the underscores replaced by hyphens.",
 			EffectiveDate:   util.CABFBRs_1_6_2_Date,
 			IneffectiveDate: util.CABFBRs_1_6_2_UnderscorePermissibilitySunsetDate,
 		},
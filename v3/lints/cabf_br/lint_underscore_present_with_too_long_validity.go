@@ -30,6 +30,8 @@ func init() {
 			Description:     "From 2018-12-10 to 2019-04-01, DNSNames may contain underscores if-and-only-if the certificate is valid for less than thirty days.",
 			Citation:        "BR 7.1.4.2.1",
 			Source:          lint.CABFBaselineRequirements,
+			Tags:            []string{"dns", "time"},
+			Remediation:     "Remove the underscore from the dNSName, or limit the validity of the certificate to less than 30 days.",
 			EffectiveDate:   util.CABFBRs_1_6_2_Date,
 			IneffectiveDate: util.CABFBRs_1_6_2_UnderscorePermissibilitySunsetDate,
 		},
@@ -37,6 +37,7 @@ func init() {
 			Description:   "Subordinate CA Certificate: authorityInformationAccess SHOULD be present.",
 			Citation:      "BRs: 7.1.2.2",
 			Source:        lint.CABFBaselineRequirements,
+			Remediation:   "Include an authorityInformationAccess extension in subordinate CA certificates.",
 			EffectiveDate: util.CABFBRs_1_7_1_Date,
 		},
 		Lint: NewCaAiaShouldNotBeMissing,
@@ -29,6 +29,8 @@ func init() {
 			Description:   "EV certificates must include businessCategory in subject",
 			Citation:      "EVGs: 9.2.3",
 			Source:        lint.CABFEVGuidelines,
+			Tags:          []string{"ev"},
+			Remediation:   "Include a businessCategory attribute in the subject of EV certificates, set to Private Organization, Government Entity, Business Entity or Non-Commercial Entity as verified.",
 			EffectiveDate: util.ZeroDate,
 		},
 		Lint: NewEvNoBiz,
@@ -29,6 +29,8 @@ func init() {
 			Description:   "EV certificates must include countryName in subject",
 			Citation:      "EVGs: 9.2.4",
 			Source:        lint.CABFEVGuidelines,
+			Tags:          []string{"ev"},
+			Remediation:   "Include the countryName of the verified jurisdiction or place of business in the subject of EV certificates.",
 			EffectiveDate: util.ZeroDate,
 		},
 		Lint: NewEvCountryMissing,
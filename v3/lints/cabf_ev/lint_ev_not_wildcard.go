@@ -30,6 +30,8 @@ func init() {
 			Description:   "Wildcard certificates are not allowed for EV Certificates except for those with .onion as the TLD.",
 			Citation:      "CABF EV Guidelines 1.7.8 Section 9.8.1",
 			Source:        lint.CABFEVGuidelines,
+			Tags:          []string{"dns", "ev"},
+			Remediation:   "Remove wildcard names from EV certificates and list each fully qualified domain name explicitly, unless the name is under the .onion TLD.",
 			EffectiveDate: util.OnionOnlyEVDate,
 		},
 		Lint: NewEvNotWildCard,
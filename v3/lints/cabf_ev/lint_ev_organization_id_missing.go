@@ -30,6 +30,8 @@ func init() {
 				"present, this [cabfOrganizationIdentifier] field MUST be present.",
 			Citation:      "CA/Browser Forum EV Guidelines v1.7.0, Sec. 9.8.2",
 			Source:        lint.CABFEVGuidelines,
+			Tags:          []string{"ev"},
+			Remediation:   "Include the cabfOrganizationIdentifier extension, holding the same registration reference, whenever the subject contains an organizationIdentifier.",
 			EffectiveDate: util.CABFEV_9_8_2,
 		},
 		Lint: NewEvOrgIdExtMissing,
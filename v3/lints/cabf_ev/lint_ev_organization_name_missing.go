@@ -29,6 +29,8 @@ func init() {
 			Description:   "EV certificates must include organizationName in subject",
 			Citation:      "EVGs: 9.2.1",
 			Source:        lint.CABFEVGuidelines,
+			Tags:          []string{"ev"},
+			Remediation:   "Include the verified legal name of the subject organization as the organizationName of EV certificates.",
 			EffectiveDate: util.ZeroDate,
 		},
 		Lint: NewEvOrgMissing,
@@ -27,6 +27,8 @@ func init() {
 			Description:   "The Subject Alternate Name extension MUST contain only 'dnsName' name types.",
 			Citation:      "CABF EV Guidelines 1.7.8 Section 9.8.1",
 			Source:        lint.CABFEVGuidelines,
+			Tags:          []string{"ev"},
+			Remediation:   "Remove IP addresses from the subjectAltName of EV certificates, which may contain only dNSName entries.",
 			EffectiveDate: util.ZeroDate,
 		},
 		Lint: NewEvSanIpAddressPresent,
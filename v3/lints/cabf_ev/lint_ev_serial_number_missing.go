@@ -29,6 +29,8 @@ func init() {
 			Description:   "EV certificates must include serialNumber in subject",
 			Citation:      "EVGs: 9.2.6",
 			Source:        lint.CABFEVGuidelines,
+			Tags:          []string{"ev"},
+			Remediation:   "Include the registration number of the subject organization as the serialNumber attribute of the subject of EV certificates.",
 			EffectiveDate: util.ZeroDate,
 		},
 		Lint: NewEvSNMissing,
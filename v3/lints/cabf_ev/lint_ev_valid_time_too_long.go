@@ -29,6 +29,8 @@ func init() {
 			Description:   "EV certificates must be 27 months in validity or less",
 			Citation:      "EVGs 1.0: 8(a), EVGs 1.6.1: 9.4",
 			Source:        lint.CABFEVGuidelines,
+			Tags:          []string{"time", "ev"},
+			Remediation:   "Shorten the validity period of the EV certificate profile to no more than the maximum permitted by the EV Guidelines in force at issuance.",
 			EffectiveDate: util.ZeroDate,
 		},
 		Lint: NewEvValidTooLong,
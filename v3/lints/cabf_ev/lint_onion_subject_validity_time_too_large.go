@@ -41,6 +41,8 @@ func init() {
 				maxOnionValidityMonths),
 			Citation:      "EVGs: Appendix F",
 			Source:        lint.CABFEVGuidelines,
+			Tags:          []string{"dns", "time", "ev"},
+			Remediation:   "Shorten the validity period of certificates containing .onion names to no more than 15 months.",
 			EffectiveDate: util.OnionOnlyEVDate,
 		},
 		Lint: NewTorValidityTooLarge,
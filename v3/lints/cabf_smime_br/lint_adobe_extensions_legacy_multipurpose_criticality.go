@@ -28,6 +28,8 @@ func init() {
 			Description:   "If present, Adobe Time‐stamp X509 extension (1.2.840.113583.1.1.9.1) or the Adobe ArchiveRevInfo extension (1.2.840.113583.1.1.9.2) SHALL NOT be marked as critical for multipurpose/legacy SMIME certificates",
 			Citation:      "7.1.2.3.m",
 			Source:        lint.CABFSMIMEBaselineRequirements,
+			Tags:          []string{"smime"},
+			Remediation:   "Mark the Adobe Time-stamp and ArchiveRevInfo extensions as non-critical in legacy and multipurpose S/MIME certificate profiles.",
 			EffectiveDate: util.CABF_SMIME_BRs_1_0_0_Date,
 		},
 		Lint: NewAdobeExtensionsLegacyMultipurposeCriticality,
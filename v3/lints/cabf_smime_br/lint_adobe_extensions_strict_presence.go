@@ -28,6 +28,8 @@ func init() {
 			Description:   "Adobe Time‐stamp X509 extension (1.2.840.113583.1.1.9.1) and the Adobe ArchiveRevInfo extension (1.2.840.113583.1.1.9.2) are prohibited for strict SMIME certificates",
 			Citation:      "7.1.2.3.m",
 			Source:        lint.CABFSMIMEBaselineRequirements,
+			Tags:          []string{"smime"},
+			Remediation:   "Remove the Adobe Time-stamp and ArchiveRevInfo extensions from strict S/MIME certificate profiles.",
 			EffectiveDate: util.CABF_SMIME_BRs_1_0_0_Date,
 		},
 		Lint: NewAdobeExtensionsStrictPresence,
@@ -27,6 +27,8 @@ func init() {
 			Description:   "For signing only, bit positions SHALL be set for digitalSignature and MAY be set for nonRepudiation. For key management only, bit positions SHALL be set for keyEncipherment.For dual use, bit positions SHALL be set for digitalSignature and keyEncipherment and MAY be set for nonRepudiation.",
 			Citation:      "7.1.2.3.e",
 			Source:        lint.CABFSMIMEBaselineRequirements,
+			Tags:          []string{"key", "smime"},
+			Remediation:   "Set the keyUsage of S/MIME certificates for elliptic curve keys to digitalSignature, optionally with nonRepudiation, for signing, to keyAgreement for key management, or to both for dual use.",
 			EffectiveDate: util.CABF_SMIME_BRs_1_0_0_Date,
 		},
 		Lint: NewECPublicKeyKeyUsages,
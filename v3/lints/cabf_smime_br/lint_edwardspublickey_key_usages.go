@@ -27,6 +27,8 @@ func init() {
 			Description:   "Bit positions SHALL be set for digitalSignature and MAY be set for nonRepudiation.",
 			Citation:      "7.1.2.3.e",
 			Source:        lint.CABFSMIMEBaselineRequirements,
+			Tags:          []string{"key", "smime"},
+			Remediation:   "Set the keyUsage of S/MIME certificates for Edwards curve keys to digitalSignature, optionally with nonRepudiation.",
 			EffectiveDate: util.CABF_SMIME_BRs_1_0_0_Date,
 		},
 		Lint: NewEdwardsPublicKeyKeyUsages,
@@ -27,6 +27,8 @@ func init() {
 			Description:   "keyUsage... This extension SHOULD be marked critical",
 			Citation:      "7.1.2.3.e",
 			Source:        lint.CABFSMIMEBaselineRequirements,
+			Tags:          []string{"smime"},
+			Remediation:   "Mark the keyUsage extension of S/MIME certificates as critical.",
 			EffectiveDate: util.CABF_SMIME_BRs_1_0_0_Date,
 		},
 		Lint: NewKeyUsageCriticality,
@@ -27,6 +27,8 @@ func init() {
 			Description:   "keyUsage (SHALL be present)",
 			Citation:      "7.1.2.3.e",
 			Source:        lint.CABFSMIMEBaselineRequirements,
+			Tags:          []string{"smime"},
+			Remediation:   "Include the keyUsage extension in S/MIME subscriber certificates.",
 			EffectiveDate: util.CABF_SMIME_BRs_1_0_0_Date,
 		},
 		Lint: NewKeyUsagePresence,
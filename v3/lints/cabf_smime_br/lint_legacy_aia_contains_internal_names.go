@@ -44,6 +44,8 @@ func init() {
 			Description:   "SMIME Legacy certificates authorityInformationAccess When provided, at least one accessMethod SHALL have the URI scheme HTTP. Other schemes (LDAP, FTP, ...) MAY be present.",
 			Citation:      "BRs: 7.1.2.3c",
 			Source:        lint.CABFSMIMEBaselineRequirements,
+			Tags:          []string{"dns", "smime"},
+			Remediation:   "Include at least one accessLocation with an HTTP URL for each accessMethod of the authorityInformationAccess extension.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewSMIMELegacyAIAInternalName,
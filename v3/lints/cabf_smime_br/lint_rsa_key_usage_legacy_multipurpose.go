@@ -29,6 +29,8 @@ func init() {
 			Description:   "For signing only, bit positions SHALL be set for digitalSignature and MAY be set for nonRepudiation. For key management only, bit positions SHALL be set for keyEncipherment and MAY be set for dataEncipherment. For dual use, bit positions SHALL be set for digitalSignature and keyEncipherment and MAY be set for nonRepudiation and dataEncipherment.",
 			Citation:      "7.1.2.3.e",
 			Source:        lint.CABFSMIMEBaselineRequirements,
+			Tags:          []string{"key", "smime"},
+			Remediation:   "Set the keyUsage of S/MIME certificates for RSA keys to digitalSignature, optionally with nonRepudiation, for signing, to keyEncipherment, optionally with dataEncipherment, for key management, or to both for dual use.",
 			EffectiveDate: util.CABF_SMIME_BRs_1_0_0_Date,
 		},
 		Lint: NewRSAKeyUsageLegacyMultipurpose,
@@ -29,6 +29,8 @@ func init() {
 			Description:   "Other bit positions SHALL NOT be set.",
 			Citation:      "7.1.2.3.e",
 			Source:        lint.CABFSMIMEBaselineRequirements,
+			Tags:          []string{"key", "smime"},
+			Remediation:   "Assert only digitalSignature, nonRepudiation, keyEncipherment or dataEncipherment in the keyUsage of S/MIME certificates for RSA keys.",
 			EffectiveDate: util.CABF_SMIME_BRs_1_0_0_Date,
 		},
 		Lint: NewRSAOtherKeyUsages,
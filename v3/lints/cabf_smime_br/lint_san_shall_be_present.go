@@ -27,6 +27,8 @@ func init() {
 			Description:   "Subject alternative name SHALL be present",
 			Citation:      "7.1.2.3.h",
 			Source:        lint.CABFSMIMEBaselineRequirements,
+			Tags:          []string{"smime"},
+			Remediation:   "Include a subjectAltName extension holding the mailbox addresses of the S/MIME certificate.",
 			EffectiveDate: util.CABF_SMIME_BRs_1_0_0_Date,
 		},
 		Lint: NewSubjectAlternativeNameShallBePresent,
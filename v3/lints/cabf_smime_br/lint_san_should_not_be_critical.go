@@ -30,6 +30,8 @@ func init() {
 			Description:   "subjectAlternativeName SHOULD NOT be marked critical unless the subject field is an empty sequence.",
 			Citation:      "7.1.2.3.h",
 			Source:        lint.CABFSMIMEBaselineRequirements,
+			Tags:          []string{"smime"},
+			Remediation:   "Mark the subjectAltName extension as non-critical unless the subject is empty.",
 			EffectiveDate: util.CABF_SMIME_BRs_1_0_0_Date,
 		},
 		Lint: NewSubjectAlternativeNameNotCritical,
@@ -29,6 +29,8 @@ func init() {
 			Description:   "If present, the subject:emailAddress SHALL contain a single Mailbox Address",
 			Citation:      "7.1.4.2.h",
 			Source:        lint.CABFSMIMEBaselineRequirements,
+			Tags:          []string{"smime"},
+			Remediation:   "Include a single mailbox address in the emailAddress attribute of the subject, and list any others in the subjectAltName.",
 			EffectiveDate: util.CABF_SMIME_BRs_1_0_0_Date,
 		},
 		Lint: func() lint.LintInterface { return &singleEmailIfPresent{} },
@@ -44,6 +44,8 @@ func init() {
 			Description:   "SMIME Strict certificates authorityInformationAccess When provided, every accessMethod SHALL have the URI scheme HTTP. Other schemes SHALL NOT be present.",
 			Citation:      "BRs: 7.1.2.3c",
 			Source:        lint.CABFSMIMEBaselineRequirements,
+			Tags:          []string{"dns", "smime"},
+			Remediation:   "Use only HTTP URLs as the accessLocations of the authorityInformationAccess extension of strict S/MIME certificates.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewSMIMEStrictAIAInternalName,
@@ -27,6 +27,8 @@ func init() {
 			Description:   "cRLDistributionPoints SHALL be present.",
 			Citation:      "7.1.2.3.b",
 			Source:        lint.CABFSMIMEBaselineRequirements,
+			Tags:          []string{"smime"},
+			Remediation:   "Include a cRLDistributionPoints extension with an HTTP URL of the CRL covering the certificate.",
 			EffectiveDate: util.CABF_SMIME_BRs_1_0_0_Date,
 		},
 		Lint: NewSubscriberCrlDistributionPoints,
@@ -35,6 +35,8 @@ func init() {
 			Description:   "SMIME certificates complying to mailbox validated profiles MAY only contain commonName, serialNumber or emailAddress attributes in the Subject DN",
 			Citation:      "SMIME BRs: 7.1.4.2.3",
 			Source:        lint.CABFSMIMEBaselineRequirements,
+			Tags:          []string{"smime"},
+			Remediation:   "Limit the subject of mailbox-validated S/MIME certificates to the commonName, serialNumber and emailAddress attributes.",
 			EffectiveDate: util.CABF_SMIME_BRs_1_0_0_Date,
 		},
 		Lint: func() lint.CertificateLintInterface {
@@ -31,6 +31,8 @@ func init() {
 			Description:   "Strict: id-kp-emailProtection SHALL be present.  Other values SHALL NOT be present",
 			Citation:      "SMIME BRs: 7.1.2.3.f",
 			Source:        lint.CABFSMIMEBaselineRequirements,
+			Tags:          []string{"smime"},
+			Remediation:   "Set the extKeyUsage of strict S/MIME certificates to id-kp-emailProtection alone.",
 			EffectiveDate: util.CABF_SMIME_BRs_1_0_0_Date,
 		},
 		Lint: NewStrictEKUCheck,
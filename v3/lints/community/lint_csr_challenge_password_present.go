@@ -0,0 +1,63 @@
+package community
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+)
+
+type csrChallengePasswordPresent struct{}
+
+/************************************************
+The PKCS#9 challengePassword attribute (RFC 2985 section 5.4.1) is carried in
+the clear inside the certificate request, which is routinely logged, shared and
+archived. Secrets used to authenticate a request should be exchanged over the
+enrollment protocol instead.
+************************************************/
+
+func init() {
+	lint.RegisterCertificateRequestLint(&lint.CertificateRequestLint{
+		LintMetadata: lint.LintMetadata{
+			Name:          "w_csr_challenge_password_present",
+			Description:   "Certificate requests should not contain a challengePassword attribute since its value is not protected",
+			Citation:      "RFC 2985: 5.4.1",
+			Source:        lint.Community,
+			Remediation:   "Remove the challengePassword attribute from the certificate request, or use a revocation secret that is not sent in the clear.",
+			EffectiveDate: util.ZeroDate,
+		},
+		Lint: NewCSRChallengePasswordPresent,
+	})
+}
+
+func NewCSRChallengePasswordPresent() lint.CertificateRequestLintInterface {
+	return &csrChallengePasswordPresent{}
+}
+
+func (l *csrChallengePasswordPresent) CheckApplies(r *x509.CertificateRequest) bool {
+	return true
+}
+
+func (l *csrChallengePasswordPresent) Execute(r *x509.CertificateRequest) *lint.LintResult {
+	attr, err := util.GetCSRAttribute(r, util.ChallengePasswordOID)
+	if err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: "failed to parse certificate request attributes"}
+	}
+	if attr != nil {
+		return &lint.LintResult{Status: lint.Warn, Details: "certificate request contains a challengePassword attribute"}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
@@ -31,6 +31,8 @@ func init() {
 			Description:   "A wildcard MUST be accompanied by other data to its right (Only checks IANDNSNames)",
 			Citation:      "awslabs certlint",
 			Source:        lint.Community,
+			Tags:          []string{"dns"},
+			Remediation:   "Remove the bare \"*\" from the issuerAltName dNSNames, or qualify it with the domain it applies to, e.g. \"*.example.com\".",
 			EffectiveDate: util.ZeroDate,
 		},
 		Lint: NewBrIANBareWildcard,
@@ -29,6 +29,8 @@ func init() {
 			Description:   "DNSName MUST NOT include a null character",
 			Citation:      "awslabs certlint",
 			Source:        lint.Community,
+			Tags:          []string{"dns", "encoding"},
+			Remediation:   "Remove the null character from the issuerAltName dNSName, which can cause the name to be truncated by some software.",
 			EffectiveDate: util.ZeroDate,
 		},
 		Lint: NewIANDNSNull,
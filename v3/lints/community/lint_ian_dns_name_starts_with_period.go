@@ -31,6 +31,8 @@ func init() {
 			Description:   "DNSName MUST NOT start with a period",
 			Citation:      "awslabs certlint",
 			Source:        lint.Community,
+			Tags:          []string{"dns"},
+			Remediation:   "Remove the leading period from the issuerAltName dNSName.",
 			EffectiveDate: util.ZeroDate,
 		},
 		Lint: NewIANDNSPeriod,
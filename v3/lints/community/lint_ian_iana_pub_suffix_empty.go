@@ -31,6 +31,8 @@ func init() {
 			Description:   "Domain SHOULD NOT have a bare public suffix",
 			Citation:      "awslabs certlint",
 			Source:        lint.Community,
+			Tags:          []string{"dns"},
+			Remediation:   "Replace the bare public suffix in the issuerAltName with a name registered under it.",
 			EffectiveDate: util.ZeroDate,
 		},
 		Lint: NewIANPubSuffix,
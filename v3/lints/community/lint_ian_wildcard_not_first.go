@@ -29,6 +29,8 @@ func init() {
 			Description:   "A wildcard MUST be in the first label of FQDN (ie not: www.*.com) (Only checks IANDNSNames)",
 			Citation:      "awslabs certlint",
 			Source:        lint.Community,
+			Tags:          []string{"dns"},
+			Remediation:   "Place the wildcard of an issuerAltName dNSName only as the entire left-most label, e.g. \"*.example.com\".",
 			EffectiveDate: util.ZeroDate,
 		},
 		Lint: NewBrIANWildcardFirst,
@@ -30,6 +30,8 @@ func init() {
 			Name:          "n_contains_redacted_dnsname",
 			Description:   "Some precerts are redacted and of the form ?.?.a.com or *.?.a.com",
 			Source:        lint.Community,
+			Tags:          []string{"dns"},
+			Remediation:   "Include the complete domain names in the precertificate, as redaction is not supported by Certificate Transparency logs or browsers.",
 			Citation:      "IETF Draft: https://tools.ietf.org/id/draft-strad-trans-redaction-00.html",
 			EffectiveDate: util.ZeroDate,
 		},
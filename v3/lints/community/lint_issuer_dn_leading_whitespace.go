@@ -29,6 +29,8 @@ func init() {
 			Description:   "AttributeValue in issuer RelativeDistinguishedName sequence SHOULD NOT have leading whitespace",
 			Citation:      "lint.AWSLabs certlint",
 			Source:        lint.Community,
+			Tags:          []string{"encoding"},
+			Remediation:   "Remove the leading whitespace from the attribute values of the issuer, which must match the subject of the issuing CA certificate exactly.",
 			EffectiveDate: util.ZeroDate,
 		},
 		Lint: NewIssuerDNLeadingSpace,
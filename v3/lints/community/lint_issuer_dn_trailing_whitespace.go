@@ -29,6 +29,8 @@ func init() {
 			Description:   "AttributeValue in issuer RelativeDistinguishedName sequence SHOULD NOT have trailing whitespace",
 			Citation:      "lint.AWSLabs certlint",
 			Source:        lint.Community,
+			Tags:          []string{"encoding"},
+			Remediation:   "Remove the trailing whitespace from the attribute values of the issuer, which must match the subject of the issuing CA certificate exactly.",
 			EffectiveDate: util.ZeroDate,
 		},
 		Lint: NewIssuerDNTrailingSpace,
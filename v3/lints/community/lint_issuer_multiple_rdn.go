@@ -31,6 +31,7 @@ func init() {
 			Description:   "Certificates should not have multiple attributes in a single RDN (issuer)",
 			Citation:      "awslabs certlint",
 			Source:        lint.Community,
+			Remediation:   "Encode each issuer attribute within its own RelativeDistinguishedName, matching the subject of the issuing CA certificate.",
 			EffectiveDate: util.ZeroDate,
 		},
 		Lint: NewIssuerRDNHasMultipleAttribute,
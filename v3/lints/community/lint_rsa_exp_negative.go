@@ -31,6 +31,8 @@ func init() {
 			Description:   "RSA public key exponent MUST be positive",
 			Citation:      "awslabs certlint",
 			Source:        lint.Community,
+			Tags:          []string{"key"},
+			Remediation:   "Reject RSA keys with a negative public exponent, and require the subscriber to generate a new key with a standard exponent such as 65537.",
 			EffectiveDate: util.ZeroDate,
 		},
 		Lint: NewRsaExpNegative,
@@ -37,6 +37,8 @@ func init() {
 				"and https://fermatattack.secvuln.info/)",
 			Citation:      "Pierre de Fermat",
 			Source:        lint.Community,
+			Tags:          []string{"key", "expensive"},
+			Remediation:   "Revoke the certificate and require the subscriber to generate a new RSA key with a secure key generator, as the private key can be recovered by Fermat factorization.",
 			EffectiveDate: util.ZeroDate,
 		},
 		Lint: NewFermatFactorization,
@@ -31,6 +31,8 @@ func init() {
 			Description:   "The RSA public key should be present",
 			Citation:      "awslabs certlint",
 			Source:        lint.Community,
+			Tags:          []string{"key"},
+			Remediation:   "Include the RSA modulus and public exponent within the subjectPublicKeyInfo of the certificate.",
 			EffectiveDate: util.ZeroDate,
 		},
 		Lint: NewRsaParsedPubKeyExist,
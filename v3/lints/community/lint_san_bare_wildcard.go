@@ -31,6 +31,8 @@ func init() {
 			Description:   "A wildcard MUST be accompanied by other data to its right (Only checks DNSName)",
 			Citation:      "awslabs certlint",
 			Source:        lint.Community,
+			Tags:          []string{"dns"},
+			Remediation:   "Remove the bare \"*\" from the subjectAltName dNSNames, or qualify it with the domain it applies to, e.g. \"*.example.com\".",
 			EffectiveDate: util.ZeroDate,
 		},
 		Lint: NewBrSANBareWildcard,
@@ -46,10 +48,12 @@ func (l *brSANBareWildcard) CheckApplies(c *x509.Certificate) bool {
 }
 
 func (l *brSANBareWildcard) Execute(c *x509.Certificate) *lint.LintResult {
-	for _, dns := range c.DNSNames {
+	var findings []lint.Finding
+	paths := util.SANDNSNamePaths(c)
+	for i, dns := range c.DNSNames {
 		if strings.HasSuffix(dns, "*") {
-			return &lint.LintResult{Status: lint.Error}
+			findings = append(findings, lint.Finding{Path: paths[i], Value: dns, Expected: "a name not ending in a bare wildcard", Status: lint.Error})
 		}
 	}
-	return &lint.LintResult{Status: lint.Pass}
+	return lint.NewResultFromFindings(findings)
 }
@@ -31,6 +31,8 @@ func init() {
 			Description:   "SAN DNSName contains duplicate values",
 			Citation:      "awslabs certlint",
 			Source:        lint.Community,
+			Tags:          []string{"dns"},
+			Remediation:   "Remove the repeated dNSNames from the subjectAltName extension so that each name is listed once.",
 			EffectiveDate: util.ZeroDate,
 		},
 		Lint: NewSANDNSDuplicate,
@@ -46,15 +48,18 @@ func (l *SANDNSDuplicate) CheckApplies(c *x509.Certificate) bool {
 }
 
 func (l *SANDNSDuplicate) Execute(c *x509.Certificate) *lint.LintResult {
-	checkedDNSNames := map[string]struct{}{}
-	for _, dns := range c.DNSNames {
+	var findings []lint.Finding
+	paths := util.SANDNSNamePaths(c)
+	checkedDNSNames := map[string]int{}
+	for i, dns := range c.DNSNames {
 		normalizedDNSName := strings.ToLower(dns)
-		if _, isPresent := checkedDNSNames[normalizedDNSName]; isPresent {
-			return &lint.LintResult{Status: lint.Notice}
+		if first, isPresent := checkedDNSNames[normalizedDNSName]; isPresent {
+			findings = append(findings, lint.Finding{Path: paths[i], Value: dns, Expected: "a name not duplicating " + paths[first], Status: lint.Notice})
+			continue
 		}
 
-		checkedDNSNames[normalizedDNSName] = struct{}{}
+		checkedDNSNames[normalizedDNSName] = i
 	}
 
-	return &lint.LintResult{Status: lint.Pass}
+	return lint.NewResultFromFindings(findings)
 }
@@ -28,4 +28,12 @@ func TestBrSANDNSDuplicate(t *testing.T) {
 	if out.Status != expected {
 		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
 	}
+	for _, f := range out.Findings {
+		if f.Status != expected || f.Path == "" || f.Value == "" {
+			t.Errorf("%s: expected a located %s finding, got %+v", inputPath, expected, f)
+		}
+	}
+	if len(out.Findings) == 0 {
+		t.Errorf("%s: expected findings for the duplicated names", inputPath)
+	}
 }
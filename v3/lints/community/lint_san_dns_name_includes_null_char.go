@@ -15,6 +15,8 @@ package community
  */
 
 import (
+	"strings"
+
 	"github.com/zmap/zcrypto/x509"
 	"github.com/zmap/zlint/v3/lint"
 	"github.com/zmap/zlint/v3/util"
@@ -29,6 +31,8 @@ func init() {
 			Description:   "DNSName MUST NOT include a null character",
 			Citation:      "awslabs certlint",
 			Source:        lint.Community,
+			Tags:          []string{"dns", "encoding"},
+			Remediation:   "Remove the null character from the subjectAltName dNSName, which can cause the name to be truncated by some software.",
 			EffectiveDate: util.ZeroDate,
 		},
 		Lint: NewSANDNSNull,
@@ -44,12 +48,12 @@ func (l *SANDNSNull) CheckApplies(c *x509.Certificate) bool {
 }
 
 func (l *SANDNSNull) Execute(c *x509.Certificate) *lint.LintResult {
-	for _, dns := range c.DNSNames {
-		for i := 0; i < len(dns); i++ {
-			if dns[i] == 0 {
-				return &lint.LintResult{Status: lint.Error}
-			}
+	var findings []lint.Finding
+	paths := util.SANDNSNamePaths(c)
+	for i, dns := range c.DNSNames {
+		if strings.IndexByte(dns, 0) >= 0 {
+			findings = append(findings, lint.Finding{Path: paths[i], Value: dns, Expected: "a name without NUL characters", Status: lint.Error})
 		}
 	}
-	return &lint.LintResult{Status: lint.Pass}
+	return lint.NewResultFromFindings(findings)
 }
@@ -31,6 +31,8 @@ func init() {
 			Description:   "DNSName MUST NOT start with a period",
 			Citation:      "awslabs certlint",
 			Source:        lint.Community,
+			Tags:          []string{"dns"},
+			Remediation:   "Remove the leading period from the subjectAltName dNSName.",
 			EffectiveDate: util.ZeroDate,
 		},
 		Lint: NewSANDNSPeriod,
@@ -46,10 +48,12 @@ func (l *SANDNSPeriod) CheckApplies(c *x509.Certificate) bool {
 }
 
 func (l *SANDNSPeriod) Execute(c *x509.Certificate) *lint.LintResult {
-	for _, dns := range c.DNSNames {
+	var findings []lint.Finding
+	paths := util.SANDNSNamePaths(c)
+	for i, dns := range c.DNSNames {
 		if strings.HasPrefix(dns, ".") {
-			return &lint.LintResult{Status: lint.Error}
+			findings = append(findings, lint.Finding{Path: paths[i], Value: dns, Expected: "a name not starting with a period", Status: lint.Error})
 		}
 	}
-	return &lint.LintResult{Status: lint.Pass}
+	return lint.NewResultFromFindings(findings)
 }
@@ -32,6 +32,8 @@ func init() {
 			Description:   "The domain SHOULD NOT have a bare public suffix",
 			Citation:      "awslabs certlint",
 			Source:        lint.Community,
+			Tags:          []string{"dns"},
+			Remediation:   "Replace the bare public suffix in the subjectAltName with a name registered under it, as a certificate for a public suffix such as \"com\" would apply to every domain beneath it.",
 			EffectiveDate: util.ZeroDate,
 		},
 		Lint: NewPubSuffix,
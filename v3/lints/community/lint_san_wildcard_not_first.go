@@ -15,6 +15,8 @@ package community
  */
 
 import (
+	"strings"
+
 	"github.com/zmap/zcrypto/x509"
 	"github.com/zmap/zlint/v3/lint"
 	"github.com/zmap/zlint/v3/util"
@@ -29,6 +31,8 @@ func init() {
 			Description:   "A wildcard MUST be in the first label of FQDN (ie not: www.*.com) (Only checks DNSName)",
 			Citation:      "awslabs certlint",
 			Source:        lint.Community,
+			Tags:          []string{"dns"},
+			Remediation:   "Place the wildcard of a subjectAltName dNSName only as the entire left-most label, e.g. \"*.example.com\".",
 			EffectiveDate: util.ZeroDate,
 		},
 		Lint: NewSANWildCardFirst,
@@ -44,12 +48,12 @@ func (l *SANWildCardFirst) CheckApplies(c *x509.Certificate) bool {
 }
 
 func (l *SANWildCardFirst) Execute(c *x509.Certificate) *lint.LintResult {
-	for _, dns := range c.DNSNames {
-		for i := 1; i < len(dns); i++ {
-			if dns[i] == '*' {
-				return &lint.LintResult{Status: lint.Error}
-			}
+	var findings []lint.Finding
+	paths := util.SANDNSNamePaths(c)
+	for i, dns := range c.DNSNames {
+		if len(dns) > 1 && strings.Contains(dns[1:], "*") {
+			findings = append(findings, lint.Finding{Path: paths[i], Value: dns, Expected: "a wildcard only as the first character", Status: lint.Error})
 		}
 	}
-	return &lint.LintResult{Status: lint.Pass}
+	return lint.NewResultFromFindings(findings)
 }
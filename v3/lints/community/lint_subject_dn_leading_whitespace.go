@@ -29,6 +29,8 @@ func init() {
 			Description:   "AttributeValue in subject RelativeDistinguishedName sequence SHOULD NOT have leading whitespace",
 			Citation:      "lint.AWSLabs certlint",
 			Source:        lint.Community,
+			Tags:          []string{"encoding"},
+			Remediation:   "Remove the leading whitespace from the attribute values of the subject.",
 			EffectiveDate: util.ZeroDate,
 		},
 		Lint: NewSubjectDNLeadingSpace,
@@ -29,6 +29,8 @@ func init() {
 			Description:   "AttributeValue in subject RelativeDistinguishedName sequence SHOULD NOT have trailing whitespace",
 			Citation:      "lint.AWSLabs certlint",
 			Source:        lint.Community,
+			Tags:          []string{"encoding"},
+			Remediation:   "Remove the trailing whitespace from the attribute values of the subject.",
 			EffectiveDate: util.ZeroDate,
 		},
 		Lint: NewSubjectDNTrailingSpace,
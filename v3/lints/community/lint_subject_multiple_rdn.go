@@ -31,6 +31,7 @@ func init() {
 			Description:   "Certificates typically do not have multiple attributes in a single RDN (subject). This may be an error.",
 			Citation:      "lint.AWSLabs certlint",
 			Source:        lint.Community,
+			Remediation:   "Encode each subject attribute within its own RelativeDistinguishedName unless a multi-valued RDN is intended.",
 			EffectiveDate: util.ZeroDate,
 		},
 		Lint: NewSubjectRDNHasMultipleAttribute,
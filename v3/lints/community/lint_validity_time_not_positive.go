@@ -29,6 +29,8 @@ func init() {
 			Description:   "Certificates MUST have a positive time for which they are valid",
 			Citation:      "lint.AWSLabs certlint",
 			Source:        lint.Community,
+			Tags:          []string{"time"},
+			Remediation:   "Set notAfter to a time later than notBefore.",
 			EffectiveDate: util.ZeroDate,
 		},
 		Lint: NewValidityNegative,
@@ -32,6 +32,7 @@ func init() {
 			Description:   "Checks for erroneous QC Statement OID that actually are represented by ETSI ESI QC type OID.",
 			Citation:      "ETSI EN 319 412 - 5 V2.2.1 (2017 - 11) / Section 4.2.3",
 			Source:        lint.EtsiEsi,
+			Remediation:   "Place the QcType OIDs (id-etsi-qct-*) within an id-etsi-qcs-QcType statement rather than using them as statement identifiers.",
 			EffectiveDate: util.EtsiEn319_412_5_V2_2_1_Date,
 		},
 		Lint: NewQcStatemEtsiTypeAsStatem,
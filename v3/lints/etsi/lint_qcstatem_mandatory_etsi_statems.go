@@ -30,6 +30,7 @@ func init() {
 			Description:   "Checks that a QC Statement that contains at least one of the ETSI ESI statements, also features the set of mandatory ETSI ESI QC statements.",
 			Citation:      "ETSI EN 319 412 - 5 V2.2.1 (2017 - 11) / Section 5",
 			Source:        lint.EtsiEsi,
+			Remediation:   "Include the QcCompliance statement alongside any other ETSI QC statements in the qcStatements extension.",
 			EffectiveDate: util.EtsiEn319_412_5_V2_2_1_Date,
 		},
 		Lint: NewQcStatemQcmandatoryEtsiStatems,
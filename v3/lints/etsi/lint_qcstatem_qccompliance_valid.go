@@ -30,6 +30,7 @@ func init() {
 			Description:   "Checks that a QC Statement of the type id-etsi-qcs-QcCompliance has the correct form",
 			Citation:      "ETSI EN 319 412 - 5 V2.2.1 (2017 - 11) / Section 4.2.1",
 			Source:        lint.EtsiEsi,
+			Remediation:   "Encode the id-etsi-qcs-QcCompliance statement with no statementInfo.",
 			EffectiveDate: util.EtsiEn319_412_5_V2_2_1_Date,
 		},
 		Lint: NewQcStatemQcComplianceValid,
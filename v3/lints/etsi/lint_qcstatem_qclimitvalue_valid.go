@@ -32,6 +32,7 @@ func init() {
 			Description:   "Checks that a QC Statement of the type id-etsi-qcs-QcLimitValue has the correct form",
 			Citation:      "ETSI EN 319 412 - 5 V2.2.1 (2017 - 11) / Section 4.3.2",
 			Source:        lint.EtsiEsi,
+			Remediation:   "Encode the id-etsi-qcs-QcLimitValue statement as a MonetaryValue with an ISO 4217 currency code, an amount and an exponent.",
 			EffectiveDate: util.EtsiEn319_412_5_V2_2_1_Date,
 		},
 		Lint: NewQcStatemQcLimitValueValid,
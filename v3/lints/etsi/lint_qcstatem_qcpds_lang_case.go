@@ -33,6 +33,7 @@ func init() {
 			Description:   "Checks that a QC Statement of the type id-etsi-qcs-QcPDS features a language code comprised of only lower case letters",
 			Citation:      "ETSI EN 319 412 - 5 V2.2.1 (2017 - 11) / Section 4.3.4",
 			Source:        lint.EtsiEsi,
+			Remediation:   "Write the language code of each PdsLocation of the QcPDS statement in lower case, e.g. \"en\".",
 			EffectiveDate: util.EtsiEn319_412_5_V2_2_1_Date,
 		},
 		Lint: NewQcStatemQcPdsLangCase,
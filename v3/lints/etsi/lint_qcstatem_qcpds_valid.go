@@ -33,6 +33,7 @@ func init() {
 			Description:   "Checks that a QC Statement of the type id-etsi-qcs-QcPDS has the correct form",
 			Citation:      "ETSI EN 319 412 - 5 V2.2.1 (2017 - 11) / Section 4.3.4",
 			Source:        lint.EtsiEsi,
+			Remediation:   "Encode the id-etsi-qcs-QcPDS statement as a non-empty sequence of PdsLocations, each with an HTTPS URL and a two letter ISO 639-1 language code, including one in English.",
 			EffectiveDate: util.EtsiEn319_412_5_V2_2_1_Date,
 		},
 		Lint: NewQcStatemQcPdsValid,
@@ -41,6 +41,8 @@ func init() {
 			Description:   "DSA is not an explicitly allowed signature algorithm, therefore it is forbidden.",
 			Citation:      "Mozilla Root Store Policy / Section 5.1",
 			Source:        lint.MozillaRootStorePolicy,
+			Tags:          []string{"key"},
+			Remediation:   "Sign with an RSA or ECDSA key instead of DSA, and do not certify DSA public keys.",
 			EffectiveDate: util.MozillaPolicy241Date,
 		},
 		Lint: NewProhibitDSAUsage,
@@ -43,6 +43,7 @@ func init() {
 			Description:   "A SubCA certificate must not have key usage that allows for both server auth and email protection, and must not use anyExtendedKeyUsage",
 			Citation:      "Mozilla Root Store Policy / Section 5.3",
 			Source:        lint.MozillaRootStorePolicy,
+			Remediation:   "Issue separate subordinate CA certificates for TLS and S/MIME, so that no certificate asserts both id-kp-serverAuth and id-kp-emailProtection, and do not assert anyExtendedKeyUsage.",
 			EffectiveDate: time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC),
 		},
 		Lint: NewAllowedEKU,
@@ -45,6 +45,7 @@ func init() {
 			Description:   "CAs MUST NOT issue certificates that have authority key IDs that include both the key ID and the issuer's issuer name and serial number",
 			Citation:      "Mozilla Root Store Policy / Section 5.2",
 			Source:        lint.MozillaRootStorePolicy,
+			Remediation:   "Include only the keyIdentifier in the authorityKeyIdentifier extension, omitting authorityCertIssuer and authorityCertSerialNumber.",
 			EffectiveDate: util.MozillaPolicy22Date,
 		},
 		Lint: NewAuthorityKeyIdentifierCorrect,
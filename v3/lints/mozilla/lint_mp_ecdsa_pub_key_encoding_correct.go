@@ -50,6 +50,8 @@ func init() {
 			Description:   "The encoded algorithm identifiers for ECDSA public keys MUST match specific bytes",
 			Citation:      "Mozilla Root Store Policy / Section 5.1.2",
 			Source:        lint.MozillaRootStorePolicy,
+			Tags:          []string{"key", "encoding"},
+			Remediation:   "Encode the AlgorithmIdentifier of the ECDSA public key with one of the byte sequences given by the Mozilla Root Store Policy, naming the P-256 or P-384 named curve.",
 			EffectiveDate: util.MozillaPolicy27Date,
 		},
 		Lint: NewEcdsaPubKeyAidEncoding,
@@ -51,6 +51,8 @@ func init() {
 			Description:   "The encoded algorithm identifiers for ECDSA signatures MUST match specific hex-encoded bytes",
 			Citation:      "Mozilla Root Store Policy / Section 5.1.2",
 			Source:        lint.MozillaRootStorePolicy,
+			Tags:          []string{"encoding"},
+			Remediation:   "Encode the signature AlgorithmIdentifier as ecdsa-with-SHA256 or ecdsa-with-SHA384 without parameters, using the byte sequences given by the Mozilla Root Store Policy.",
 			EffectiveDate: util.MozillaPolicy27Date,
 		},
 		Lint: NewEcdsaSignatureAidEncoding,
@@ -37,6 +37,8 @@ func init() {
 			Description:   "CAs MUST NOT issue certificates that have invalid public keys (e.g., RSA certificates with public exponent equal to 1)",
 			Citation:      "Mozilla Root Store Policy / Section 5.2",
 			Source:        lint.MozillaRootStorePolicy,
+			Tags:          []string{"key"},
+			Remediation:   "Issue the certificate only for an RSA key with a valid public exponent, such as 65537, and reject keys with an exponent of 1.",
 			EffectiveDate: util.MozillaPolicy24Date,
 		},
 		Lint: NewExponentCannotBeOne,
@@ -36,6 +36,8 @@ func init() {
 			Description:   "RSA keys must have modulus size of at least 2048 bits",
 			Citation:      "Mozilla Root Store Policy / Section 5.1",
 			Source:        lint.MozillaRootStorePolicy,
+			Tags:          []string{"key"},
+			Remediation:   "Reject RSA keys with a modulus smaller than 2048 bits, and require the subscriber to generate a new key of at least 2048 bits.",
 			EffectiveDate: util.MozillaPolicy24Date,
 		},
 		Lint: NewModulus2048OrMore,
@@ -36,6 +36,8 @@ func init() {
 			Description:   "RSA keys must have a modulus size divisible by 8",
 			Citation:      "Mozilla Root Store Policy / Section 5.1",
 			Source:        lint.MozillaRootStorePolicy,
+			Tags:          []string{"key"},
+			Remediation:   "Reject RSA keys whose modulus size is not a multiple of 8 bits, and require the subscriber to generate a new key of a standard size.",
 			EffectiveDate: util.MozillaPolicy24Date,
 		},
 		Lint: NewModulusDivisibleBy8,
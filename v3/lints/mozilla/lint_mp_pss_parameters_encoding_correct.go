@@ -64,6 +64,8 @@ func init() {
 			Description:   "The encoded AlgorithmIdentifier for RSASSA-PSS in the signature algorithm MUST match specific bytes",
 			Citation:      "Mozilla Root Store Policy / Section 5.1.1",
 			Source:        lint.MozillaRootStorePolicy,
+			Tags:          []string{"encoding"},
+			Remediation:   "Encode the RSASSA-PSS signature AlgorithmIdentifier with one of the byte sequences given by the Mozilla Root Store Policy, for SHA-256, SHA-384 or SHA-512 with a matching MGF1 hash and salt length.",
 			EffectiveDate: util.MozillaPolicy27Date,
 		},
 		Lint: NewRsaPssAidEncoding,
@@ -39,6 +39,8 @@ func init() {
 			Description:   "CAs MUST NOT use the id-RSASSA-PSS OID (1.2.840.113549.1.1.10) within a SubjectPublicKeyInfo to represent a RSA key.",
 			Citation:      "Mozilla Root Store Policy / Section 5.1.1",
 			Source:        lint.MozillaRootStorePolicy,
+			Tags:          []string{"key"},
+			Remediation:   "Encode RSA public keys with the rsaEncryption OID (1.2.840.113549.1.1.1) in the subjectPublicKeyInfo.",
 			EffectiveDate: util.MozillaPolicy27Date,
 		},
 		Lint: NewRsaPssInSPKI,
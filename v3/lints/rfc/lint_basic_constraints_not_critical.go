@@ -41,6 +41,7 @@ func init() {
 			Description:   "basicConstraints MUST appear as a critical extension",
 			Citation:      "RFC 5280: 4.2.1.9",
 			Source:        lint.RFC5280,
+			Remediation:   "Mark the basicConstraints extension of CA certificates as critical.",
 			EffectiveDate: util.RFC2459Date,
 		},
 		Lint: NewBasicConstCrit,
@@ -41,6 +41,7 @@ func init() {
 			Description:   "The subject field of a CA certificate MUST have a non-empty distinguished name",
 			Citation:      "RFC 5280: 4.1.2.6",
 			Source:        lint.RFC5280,
+			Remediation:   "Give CA certificates a subject with a non-empty distinguished name, which certificates they issue will use as their issuer.",
 			EffectiveDate: util.RFC2459Date,
 		},
 		Lint: NewCaSubjectEmpty,
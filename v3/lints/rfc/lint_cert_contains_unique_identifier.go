@@ -41,6 +41,7 @@ func init() {
 			Name:          "e_cert_contains_unique_identifier",
 			Description:   "CAs MUST NOT generate certificate with unique identifiers",
 			Source:        lint.RFC5280,
+			Remediation:   "Remove the issuerUniqueID and subjectUniqueID fields from the certificate.",
 			Citation:      "RFC 5280: 4.1.2.8",
 			EffectiveDate: util.RFC5280Date,
 		},
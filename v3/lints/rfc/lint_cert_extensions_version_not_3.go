@@ -48,6 +48,7 @@ func init() {
 			Description:   "The extensions field MUST only appear in version 3 certificates",
 			Citation:      "RFC 5280: 4.1.2.9",
 			Source:        lint.RFC5280,
+			Remediation:   "Issue certificates that contain extensions as version 3 certificates, with a version field of 2.",
 			EffectiveDate: util.RFC2459Date,
 		},
 		Lint: NewCertExtensionsVersonNot3,
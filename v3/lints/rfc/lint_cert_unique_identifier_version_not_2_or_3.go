@@ -43,6 +43,7 @@ func init() {
 			Description:   "Unique identifiers MUST only appear if the X.509 version is 2 or 3",
 			Citation:      "RFC 5280: 4.1.2.8",
 			Source:        lint.RFC5280,
+			Remediation:   "Remove the unique identifiers, or issue the certificate as version 3.",
 			EffectiveDate: util.RFC5280Date,
 		},
 		Lint: NewCertUniqueIdVersion,
@@ -34,6 +34,8 @@ func init() {
 			Description:   "Conforming CRL issuers MUST include the nextUpdate field in all CRLs.",
 			Citation:      "RFC 5280: 5.1.2.5",
 			Source:        lint.RFC5280,
+			Tags:          []string{"time"},
+			Remediation:   "Include a nextUpdate time in every CRL, giving the date by which the next CRL will be issued.",
 			EffectiveDate: util.RFC5280Date,
 		},
 		Lint: NewCrlHasNextUpdate,
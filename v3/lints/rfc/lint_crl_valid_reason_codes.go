@@ -42,6 +42,7 @@ func init() {
 			Description:   "If a CRL entry has a reason code, it MUST be in RFC5280 section 5.3.1 and SHOULD be absent instead of using unspecified (0)",
 			Citation:      "RFC 5280: 5.3.1",
 			Source:        lint.RFC5280,
+			Remediation:   "Use only the reason codes defined in RFC 5280 section 5.3.1 other than 7, and omit the reasonCode extension rather than using unspecified (0).",
 			EffectiveDate: util.RFC5280Date,
 		},
 		Lint: NewCrlHasValidReasonCode,
@@ -0,0 +1,61 @@
+package rfc
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"fmt"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+)
+
+type csrSignatureInvalid struct{}
+
+/************************************************
+RFC 2986: 3
+The certification request information shall be signed by the subject
+entity... The signature on the certification request prevents an entity from
+requesting a certificate with another party's public key.
+************************************************/
+
+func init() {
+	lint.RegisterCertificateRequestLint(&lint.CertificateRequestLint{
+		LintMetadata: lint.LintMetadata{
+			Name:          "e_csr_signature_invalid",
+			Description:   "The signature of a certificate request MUST verify with the public key it contains",
+			Citation:      "RFC 2986: 3",
+			Source:        lint.RFC2986,
+			Remediation:   "Regenerate the certificate request, signing it with the private key corresponding to the public key it contains.",
+			EffectiveDate: util.ZeroDate,
+		},
+		Lint: NewCSRSignatureInvalid,
+	})
+}
+
+func NewCSRSignatureInvalid() lint.CertificateRequestLintInterface {
+	return &csrSignatureInvalid{}
+}
+
+func (l *csrSignatureInvalid) CheckApplies(r *x509.CertificateRequest) bool {
+	return true
+}
+
+func (l *csrSignatureInvalid) Execute(r *x509.CertificateRequest) *lint.LintResult {
+	if err := r.CheckSignature(); err != nil {
+		return &lint.LintResult{Status: lint.Error, Details: fmt.Sprintf("certificate request signature does not verify: %v", err)}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
@@ -55,6 +55,7 @@ func init() {
 			Description:   "A DistributionPoint from the CRLDistributionPoints extension MUST NOT consist of only the reasons field; either distributionPoint or CRLIssuer must be present",
 			Citation:      "RFC 5280: 4.2.1.13",
 			Source:        lint.RFC5280,
+			Remediation:   "Include a distributionPoint or cRLIssuer in each DistributionPoint of the cRLDistributionPoints extension.",
 			EffectiveDate: util.RFC3280Date,
 		},
 		Lint: NewDpIncomplete,
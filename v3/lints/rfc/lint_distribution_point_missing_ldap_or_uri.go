@@ -36,6 +36,7 @@ func init() {
 			Description:   "When present in the CRLDistributionPoints extension, DistributionPointName SHOULD include at least one LDAP or HTTP URI",
 			Citation:      "RFC 5280: 4.2.1.13",
 			Source:        lint.RFC5280,
+			Remediation:   "Include an HTTP or LDAP URI in each DistributionPointName of the cRLDistributionPoints extension.",
 			EffectiveDate: util.RFC5280Date,
 		},
 		Lint: NewDistribNoLDAPorURI,
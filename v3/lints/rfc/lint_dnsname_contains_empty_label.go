@@ -31,6 +31,8 @@ func init() {
 			Description:   "DNSNames should not have an empty label.",
 			Citation:      "RFC5280: 4.2.1.6",
 			Source:        lint.RFC5280,
+			Tags:          []string{"dns"},
+			Remediation:   "Remove the empty labels, such as those from consecutive or trailing periods, from the dNSName.",
 			EffectiveDate: util.RFC5280Date,
 		},
 		Lint: NewDNSNameEmptyLabel,
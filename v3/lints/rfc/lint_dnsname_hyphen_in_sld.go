@@ -31,6 +31,8 @@ func init() {
 			Description:   "DNSName should not have a hyphen beginning or ending the SLD",
 			Citation:      "RFC5280: 4.2.1.6",
 			Source:        lint.RFC5280,
+			Tags:          []string{"dns"},
+			Remediation:   "Remove the leading or trailing hyphen from the second level label of the dNSName.",
 			EffectiveDate: util.RFC5280Date,
 		},
 		Lint: NewDNSNameHyphenInSLD,
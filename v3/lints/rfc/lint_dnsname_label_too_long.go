@@ -31,6 +31,8 @@ func init() {
 			Description:   "DNSName labels MUST be less than or equal to 63 characters",
 			Citation:      "RFC 5280: 4.2.1.6, citing RFC 1035",
 			Source:        lint.RFC5280,
+			Tags:          []string{"dns"},
+			Remediation:   "Shorten each label of the dNSName to no more than 63 characters.",
 			EffectiveDate: util.RFC5280Date,
 		},
 		Lint: NewDNSNameLabelLengthTooLong,
@@ -31,6 +31,8 @@ func init() {
 			Description:   "DNSName MUST NOT contain underscore characters",
 			Citation:      "RFC5280: 4.2.1.6",
 			Source:        lint.RFC5280,
+			Tags:          []string{"dns"},
+			Remediation:   "Remove the underscore from the second level label of the dNSName.",
 			EffectiveDate: util.RFC5280Date,
 		},
 		Lint: NewDNSNameUnderscoreInSLD,
@@ -31,6 +31,8 @@ func init() {
 			Description:   "DNSName MUST NOT contain underscore characters",
 			Citation:      "RFC5280: 4.1.2.6",
 			Source:        lint.RFC5280,
+			Tags:          []string{"dns"},
+			Remediation:   "Remove the underscores from the labels of the dNSName below the registered domain.",
 			EffectiveDate: util.RFC5280Date,
 		},
 		Lint: NewDNSNameUnderscoreInTRD,
@@ -46,6 +46,8 @@ func init() {
 			Description:   "Key usage values keyEncipherment or dataEncipherment MUST NOT be present in certificates with ECDSA public keys",
 			Citation:      "RFC 8813 Section 3",
 			Source:        lint.RFC8813,
+			Tags:          []string{"key"},
+			Remediation:   "Remove keyEncipherment and dataEncipherment from the keyUsage of certificates for ECDSA keys.",
 			EffectiveDate: util.RFC8813Date,
 		},
 		Lint: NewEcdsaAllowedKU,
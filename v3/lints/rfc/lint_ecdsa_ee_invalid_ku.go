@@ -33,6 +33,8 @@ func init() {
 			Description:   "ECDSA end-entity certificates MAY have key usages: digitalSignature, nonRepudiation and keyAgreement",
 			Citation:      "RFC 5480 Section 3",
 			Source:        lint.RFC5480,
+			Tags:          []string{"key"},
+			Remediation:   "Assert only digitalSignature, nonRepudiation and keyAgreement in the keyUsage of end entity certificates for ECDSA keys.",
 			EffectiveDate: util.CABEffectiveDate,
 		},
 		Lint: NewEcdsaInvalidKU,
@@ -42,6 +42,7 @@ func init() {
 			Description:   "Conforming CAs SHOULD NOT mark extended key usage extension as critical if the anyExtendedKeyUsage KeyPurposedID is present",
 			Citation:      "RFC 5280: 4.2.1.12",
 			Source:        lint.RFC5280,
+			Remediation:   "Mark the extKeyUsage extension as non-critical when it includes anyExtendedKeyUsage.",
 			EffectiveDate: util.RFC3280Date,
 		},
 		Lint: NewEkuBadCritical,
@@ -42,6 +42,7 @@ func init() {
 			Description:   "When the id-ad-caIssuers accessMethod is used, at least one instance SHOULD specify an accessLocation that is an HTTP or LDAP URI",
 			Citation:      "RFC 5280: 4.2.2.1",
 			Source:        lint.RFC5280,
+			Remediation:   "Include an HTTP or LDAP URI among the id-ad-caIssuers access locations of the authorityInformationAccess extension.",
 			EffectiveDate: util.RFC5280Date,
 		},
 		Lint: NewAiaNoHTTPorLDAP,
@@ -35,6 +35,7 @@ func init() {
 			Description:   "Conforming CAs must mark the Authority Information Access extension as non-critical",
 			Citation:      "RFC 5280: 4.2.2.1",
 			Source:        lint.RFC5280,
+			Remediation:   "Mark the authorityInformationAccess extension as non-critical.",
 			EffectiveDate: util.RFC2459Date,
 		},
 		Lint: NewExtAiaMarkedCritical,
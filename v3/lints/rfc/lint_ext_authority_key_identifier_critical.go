@@ -34,6 +34,7 @@ func init() {
 			Description:   "The authority key identifier extension must be non-critical",
 			Citation:      "RFC 5280: 4.2.1.1",
 			Source:        lint.RFC5280,
+			Remediation:   "Mark the authorityKeyIdentifier extension as non-critical.",
 			EffectiveDate: util.RFC2459Date,
 		},
 		Lint: NewAuthorityKeyIdCritical,
@@ -0,0 +1,70 @@
+package rfc
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+)
+
+type authorityKeyIdMismatchIssuerSKI struct{}
+
+/***********************************************************************
+RFC 5280: 4.2.1.2
+   For CA certificates, subject key identifiers SHOULD be derived from
+   the public key or a method that generates unique values.  [...]
+   The value of the subject key identifier MUST be the value placed in
+   the key identifier field of the authority key identifier extension
+   (Section 4.2.1.1) of certificates issued by the subject of this
+   certificate.
+***********************************************************************/
+
+func init() {
+	lint.RegisterChainLint(&lint.ChainLint{
+		LintMetadata: lint.LintMetadata{
+			Name:          "e_ext_authority_key_identifier_mismatch_issuer_ski",
+			Description:   "The keyIdentifier of the authority key identifier extension MUST match the subject key identifier of the issuer",
+			Citation:      "RFC 5280: 4.2.1.1 & 4.2.1.2",
+			Source:        lint.RFC5280,
+			Remediation:   "Set the keyIdentifier of the authorityKeyIdentifier to the subjectKeyIdentifier of the issuing CA certificate.",
+			EffectiveDate: util.RFC2459Date,
+		},
+		Lint: NewAuthorityKeyIdMismatchIssuerSKI,
+	})
+}
+
+func NewAuthorityKeyIdMismatchIssuerSKI() lint.ChainLintInterface {
+	return &authorityKeyIdMismatchIssuerSKI{}
+}
+
+func (l *authorityKeyIdMismatchIssuerSKI) CheckApplies(c *x509.Certificate, issuer *x509.Certificate) bool {
+	return len(c.AuthorityKeyId) > 0 && len(issuer.SubjectKeyId) > 0
+}
+
+func (l *authorityKeyIdMismatchIssuerSKI) Execute(c *x509.Certificate, issuer *x509.Certificate) *lint.LintResult {
+	if !bytes.Equal(c.AuthorityKeyId, issuer.SubjectKeyId) {
+		return &lint.LintResult{
+			Status: lint.Error,
+			Details: fmt.Sprintf("authority key identifier %s does not match issuer subject key identifier %s",
+				hex.EncodeToString(c.AuthorityKeyId), hex.EncodeToString(issuer.SubjectKeyId)),
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
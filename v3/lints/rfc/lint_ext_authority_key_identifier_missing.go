@@ -43,6 +43,7 @@ func init() {
 		Description:   "CAs must support key identifiers and include them in all certificates",
 		Citation:      "RFC 5280: 4.2 & 4.2.1.1",
 		Source:        lint.RFC5280,
+		Remediation:   "Include an authorityKeyIdentifier extension identifying the key of the issuing CA.",
 		EffectiveDate: util.RFC2459Date,
 		Lint:          NewAuthorityKeyIdMissing,
 	})
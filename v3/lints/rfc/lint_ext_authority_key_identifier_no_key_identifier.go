@@ -44,6 +44,7 @@ func init() {
 			Description:   "CAs must include keyIdentifer field of AKI in all non-self-issued certificates",
 			Citation:      "RFC 5280: 4.2.1.1",
 			Source:        lint.RFC5280,
+			Remediation:   "Include the keyIdentifier field in the authorityKeyIdentifier extension of certificates that are not self-issued.",
 			EffectiveDate: util.RFC2459Date,
 		},
 		Lint: NewAuthorityKeyIdNoKeyIdField,
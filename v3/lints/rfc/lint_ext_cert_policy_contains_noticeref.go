@@ -35,6 +35,7 @@ func init() {
 			Description:   "Compliant certificates SHOULD NOT use the noticeRef option",
 			Citation:      "RFC 5280: 4.2.1.4",
 			Source:        lint.RFC5280,
+			Remediation:   "Remove the noticeRef from the user notices of the certificatePolicies extension, using explicitText instead.",
 			EffectiveDate: util.RFC5280Date,
 		},
 		Lint: NewNoticeRefPres,
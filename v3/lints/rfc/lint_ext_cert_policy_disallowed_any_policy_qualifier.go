@@ -48,6 +48,7 @@ func init() {
 			Description:   "When qualifiers are used with the special policy anyPolicy, they must be limited to qualifiers identified in this section: (4.2.1.4)",
 			Citation:      "RFC 5280: 4.2.1.4",
 			Source:        lint.RFC5280,
+			Remediation:   "Use only the CPS pointer and user notice qualifiers with the anyPolicy policy.",
 			EffectiveDate: util.RFC3280Date,
 		},
 		Lint: NewUnrecommendedQualifier,
@@ -37,6 +37,7 @@ func init() {
 			Description:   "A certificate policy OID must not appear more than once in the extension",
 			Citation:      "RFC 5280: 4.2.1.4",
 			Source:        lint.RFC5280,
+			Remediation:   "List each policy OID once within the certificatePolicies extension.",
 			EffectiveDate: util.RFC5280Date,
 		},
 		Lint: NewExtCertPolicyDuplicate,
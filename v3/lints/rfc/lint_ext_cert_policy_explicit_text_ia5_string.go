@@ -43,6 +43,8 @@ func init() {
 			Description:   "Compliant certificates must not encode explicitTest as an IA5String",
 			Citation:      "RFC 6818: 3",
 			Source:        lint.RFC5280,
+			Tags:          []string{"encoding"},
+			Remediation:   "Encode the explicitText of user notices as a UTF8String.",
 			EffectiveDate: util.RFC6818Date,
 		},
 		Lint: NewExplicitTextIA5String,
@@ -41,6 +41,8 @@ func init() {
 			Description:   "Explicit text should not include any control characters",
 			Citation:      "RFC 6818: 3",
 			Source:        lint.RFC5280,
+			Tags:          []string{"encoding"},
+			Remediation:   "Remove the control characters from the explicitText of user notices.",
 			EffectiveDate: util.RFC6818Date,
 		},
 		Lint: NewControlChar,
@@ -35,6 +35,8 @@ func init() {
 			Description:   "When utf8string or bmpstring encoding is used for explicitText field in certificate policy, it SHOULD be normalized by NFC format",
 			Citation:      "RFC6181 3",
 			Source:        lint.RFC5280,
+			Tags:          []string{"encoding"},
+			Remediation:   "Normalize the explicitText of user notices with Unicode Normalization Form C.",
 			EffectiveDate: util.RFC6818Date,
 		},
 		Lint: NewExtCertPolicyExplicitTextNotNFC,
@@ -44,6 +44,8 @@ func init() {
 			Description:   "Compliant certificates should use the utf8string encoding for explicitText",
 			Citation:      "RFC 6818: 3",
 			Source:        lint.RFC5280,
+			Tags:          []string{"encoding"},
+			Remediation:   "Encode the explicitText of user notices as a UTF8String.",
 			EffectiveDate: util.RFC6818Date,
 		},
 		Lint: NewExplicitTextUtf8,
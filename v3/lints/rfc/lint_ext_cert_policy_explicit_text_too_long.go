@@ -42,6 +42,7 @@ func init() {
 			Description:   "Explicit text has a maximum size of 200 characters",
 			Citation:      "RFC 6818: 3",
 			Source:        lint.RFC5280,
+			Remediation:   "Shorten the explicitText of user notices to no more than 200 characters.",
 			EffectiveDate: util.RFC6818Date,
 		},
 		Lint: NewExplicitTextTooLong,
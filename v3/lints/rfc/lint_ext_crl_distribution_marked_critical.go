@@ -33,6 +33,7 @@ func init() {
 			Description:   "If included, the CRL Distribution Points extension SHOULD NOT be marked critical",
 			Citation:      "RFC 5280: 4.2.1.13",
 			Source:        lint.RFC5280,
+			Remediation:   "Mark the cRLDistributionPoints extension as non-critical.",
 			EffectiveDate: util.RFC2459Date,
 		},
 		Lint: NewExtCrlDistributionMarkedCritical,
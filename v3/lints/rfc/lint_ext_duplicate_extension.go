@@ -36,6 +36,7 @@ func init() {
 			Description:   "A certificate MUST NOT include more than one instance of a particular extension",
 			Citation:      "RFC 5280: 4.2",
 			Source:        lint.RFC5280,
+			Remediation:   "Include each extension at most once in the certificate.",
 			EffectiveDate: util.RFC2459Date,
 		},
 		Lint: NewExtDuplicateExtension,
@@ -34,6 +34,7 @@ func init() {
 			Description:   "Freshest CRL MUST be marked as non-critical by conforming CAs",
 			Citation:      "RFC 5280: 4.2.1.15",
 			Source:        lint.RFC5280,
+			Remediation:   "Mark the freshestCRL extension as non-critical.",
 			EffectiveDate: util.RFC3280Date,
 		},
 		Lint: NewExtFreshestCrlMarkedCritical,
@@ -35,6 +35,7 @@ func init() {
 			Description:   "Issuer alternate name should be marked as non-critical",
 			Citation:      "RFC 5280: 4.2.1.7",
 			Source:        lint.RFC5280,
+			Remediation:   "Mark the issuerAltName extension as non-critical.",
 			EffectiveDate: util.RFC2459Date,
 		},
 		Lint: NewExtIANCritical,
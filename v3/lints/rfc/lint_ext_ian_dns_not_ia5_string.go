@@ -45,6 +45,8 @@ func init() {
 			Description:   "DNSNames MUST be IA5 strings",
 			Citation:      "RFC 5280: 4.2.1.7",
 			Source:        lint.RFC5280,
+			Tags:          []string{"dns", "encoding"},
+			Remediation:   "Encode the dNSNames of the issuerAltName as IA5Strings, using the A-label form for internationalized names.",
 			EffectiveDate: util.RFC2459Date,
 		},
 		Lint: NewIANDNSNotIA5String,
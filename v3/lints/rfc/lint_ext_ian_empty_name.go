@@ -42,6 +42,7 @@ func init() {
 			Description:   "General name fields must not be empty in IAN",
 			Citation:      "RFC 5280: 4.2.1.7",
 			Source:        lint.RFC5280,
+			Remediation:   "Remove the empty names from the issuerAltName extension.",
 			EffectiveDate: util.RFC2459Date,
 		},
 		Lint: NewIANEmptyName,
@@ -41,6 +41,7 @@ func init() {
 			Description:   "If present, the IAN extension must contain at least one entry",
 			Citation:      "RFC 5280: 4.2.1.7",
 			Source:        lint.RFC5280,
+			Remediation:   "Include at least one name in the issuerAltName extension, or omit the extension.",
 			EffectiveDate: util.RFC2459Date,
 		},
 		Lint: NewIANNoEntry,
@@ -43,6 +43,8 @@ func init() {
 			Description:   "Email must not be surrounded with `<>`, and there MUST NOT be trailing comments in `()`",
 			Citation:      "RFC 5280: 4.2.1.7",
 			Source:        lint.RFC5280,
+			Tags:          []string{"encoding"},
+			Remediation:   "Write the rfc822Names of the issuerAltName as a bare mailbox, e.g. \"user@example.com\", without angle brackets or comments.",
 			EffectiveDate: util.RFC2459Date,
 		},
 		Lint: NewIANEmail,
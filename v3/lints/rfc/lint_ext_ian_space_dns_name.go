@@ -45,6 +45,8 @@ func init() {
 			Description:   "dNSName ' ' MUST NOT be used",
 			Citation:      "RFC 5280: 4.2.1.6",
 			Source:        lint.RFC5280,
+			Tags:          []string{"dns", "encoding"},
+			Remediation:   "Remove the dNSName consisting of a single space from the issuerAltName extension.",
 			EffectiveDate: util.RFC2459Date,
 		},
 		Lint: NewIANSpace,
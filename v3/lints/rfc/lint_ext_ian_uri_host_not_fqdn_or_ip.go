@@ -43,6 +43,8 @@ func init() {
 			Description:   "URIs that include an authority ([RFC3986], Section 3.2) MUST include a fully qualified domain name or IP address as the host",
 			Citation:      "RFC 5280: 4.2.1.6",
 			Source:        lint.RFC5280,
+			Tags:          []string{"dns"},
+			Remediation:   "Use a fully qualified domain name or IP address as the host of each URI of the issuerAltName.",
 			EffectiveDate: util.RFC5280Date,
 		},
 		Lint: NewIANURIFQDNOrIP,
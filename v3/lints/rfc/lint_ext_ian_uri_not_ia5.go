@@ -36,6 +36,8 @@ func init() {
 			Description:   "When issuer alternative name contains a URI, the name MUST be an IA5 string",
 			Citation:      "RFC5280: 4.2.1.7",
 			Source:        lint.RFC5280,
+			Tags:          []string{"encoding"},
+			Remediation:   "Encode the URIs of the issuerAltName as IA5Strings, percent-encoding non-ASCII characters.",
 			EffectiveDate: util.RFC5280Date,
 		},
 		Lint: NewIANURIIA5String,
@@ -43,6 +43,7 @@ func init() {
 			Description:   "When issuerAltName extension is present and the URI is used, the name MUST NOT be a relative URI",
 			Citation:      "RFC 5280: 4.2.1.7",
 			Source:        lint.RFC5280,
+			Remediation:   "Replace relative URIs in the issuerAltName with absolute URIs.",
 			EffectiveDate: util.RFC5280Date,
 		},
 		Lint: NewUriRelative,
@@ -40,6 +40,7 @@ func init() {
 			Description:   "if the keyCertSign bit is asserted, then the cA bit in the basic constraints extension MUST also be asserted",
 			Citation:      "RFC 5280: 4.2.1.3 & 4.2.1.9",
 			Source:        lint.RFC5280,
+			Remediation:   "Remove keyCertSign from the keyUsage, or issue the certificate as a CA certificate with the cA field of basicConstraints set to true.",
 			EffectiveDate: util.RFC3280Date,
 		},
 		Lint: NewKeyUsageCertSignNoCa,
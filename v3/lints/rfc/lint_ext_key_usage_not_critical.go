@@ -31,6 +31,7 @@ func init() {
 			Description:   "The keyUsage extension SHOULD be critical",
 			Citation:      "RFC 5280: 4.2.1.3",
 			Source:        lint.RFC5280,
+			Remediation:   "Mark the keyUsage extension as critical.",
 			EffectiveDate: util.RFC2459Date,
 		},
 		Lint: NewCheckKeyUsageCritical,
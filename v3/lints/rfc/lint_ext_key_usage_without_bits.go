@@ -38,6 +38,7 @@ func init() {
 			Description:   "When the keyUsage extension is included, at least one bit MUST be set to 1",
 			Citation:      "RFC 5280: 4.2.1.3",
 			Source:        lint.RFC5280,
+			Remediation:   "Assert at least one usage in the keyUsage extension, or omit the extension.",
 			EffectiveDate: util.RFC5280Date,
 		},
 		Lint: NewKeyUsageBitsSet,
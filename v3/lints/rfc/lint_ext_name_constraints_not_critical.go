@@ -41,6 +41,7 @@ func init() {
 			Description:   "If it is included, conforming CAs MUST mark the name constraints extension as critical",
 			Citation:      "RFC 5280: 4.2.1.10",
 			Source:        lint.RFC5280,
+			Remediation:   "Mark the nameConstraints extension as critical.",
 			EffectiveDate: util.RFC2459Date,
 		},
 		Lint: NewNameConstraintCrit,
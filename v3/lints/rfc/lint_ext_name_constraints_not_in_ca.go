@@ -40,6 +40,7 @@ func init() {
 			Description:   "The name constraints extension MUST only be used in CA certificates",
 			Citation:      "RFC 5280: 4.2.1.10",
 			Source:        lint.RFC5280,
+			Remediation:   "Remove the nameConstraints extension from certificates that are not CA certificates.",
 			EffectiveDate: util.RFC2459Date,
 		},
 		Lint: NewNameConstraintNotCa,
@@ -39,6 +39,7 @@ func init() {
 			Description:   "Conforming CAs MUST NOT issue certificates where policy constraints is an empty sequence. That is, either the inhibitPolicyMapping field or the requireExplicityPolicy field MUST be present",
 			Citation:      "RFC 5280: 4.2.1.11",
 			Source:        lint.RFC5280,
+			Remediation:   "Include requireExplicitPolicy or inhibitPolicyMapping in the policyConstraints extension, or omit the extension.",
 			EffectiveDate: util.RFC2459Date,
 		},
 		Lint: NewPolicyConstraintsContents,
@@ -34,6 +34,7 @@ func init() {
 			Description:   "Conforming CAs MUST mark the policy constraints extension as critical",
 			Citation:      "RFC 5280: 4.2.1.11",
 			Source:        lint.RFC5280,
+			Remediation:   "Mark the policyConstraints extension as critical.",
 			EffectiveDate: util.RFC5280Date,
 		},
 		Lint: NewPolicyConstraintsCritical,
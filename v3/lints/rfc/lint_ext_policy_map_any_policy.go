@@ -37,6 +37,7 @@ func init() {
 			Description:   "Policies must not be mapped to or from the anyPolicy value",
 			Citation:      "RFC 5280: 4.2.1.5",
 			Source:        lint.RFC5280,
+			Remediation:   "Remove the mappings to or from anyPolicy from the policyMappings extension.",
 			EffectiveDate: util.RFC3280Date,
 		},
 		Lint: NewPolicyMapAnyPolicy,
@@ -35,6 +35,7 @@ func init() {
 			Description:   "Policy mappings should be marked as critical",
 			Citation:      "RFC 5280: 4.2.1.5",
 			Source:        lint.RFC5280,
+			Remediation:   "Mark the policyMappings extension as critical.",
 			EffectiveDate: util.RFC2459Date,
 		},
 		Lint: NewPolicyMapCritical,
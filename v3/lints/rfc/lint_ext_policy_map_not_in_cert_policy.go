@@ -37,6 +37,7 @@ func init() {
 			Description:   "Each issuerDomainPolicy named in the policy mappings extension should also be asserted in a certificate policies extension",
 			Citation:      "RFC 5280: 4.2.1.5",
 			Source:        lint.RFC5280,
+			Remediation:   "Assert each issuerDomainPolicy of the policyMappings extension in the certificatePolicies extension.",
 			EffectiveDate: util.RFC3280Date,
 		},
 		Lint: NewPolicyMapMatchesCertPolicy,
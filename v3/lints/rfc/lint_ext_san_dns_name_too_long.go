@@ -29,6 +29,8 @@ func init() {
 			Description:   "DNSName must be less than or equal to 253 bytes",
 			Citation:      "RFC 5280",
 			Source:        lint.RFC5280,
+			Tags:          []string{"dns"},
+			Remediation:   "Shorten the dNSName to no more than 253 characters.",
 			EffectiveDate: util.RFC5280Date,
 		},
 		Lint: NewSANDNSTooLong,
@@ -44,10 +46,12 @@ func (l *SANDNSTooLong) CheckApplies(c *x509.Certificate) bool {
 }
 
 func (l *SANDNSTooLong) Execute(c *x509.Certificate) *lint.LintResult {
-	for _, dns := range c.DNSNames {
+	var findings []lint.Finding
+	paths := util.SANDNSNamePaths(c)
+	for i, dns := range c.DNSNames {
 		if len(dns) > 253 {
-			return &lint.LintResult{Status: lint.Error}
+			findings = append(findings, lint.Finding{Path: paths[i], Value: dns, Expected: "at most 253 characters", Status: lint.Error})
 		}
 	}
-	return &lint.LintResult{Status: lint.Pass}
+	return lint.NewResultFromFindings(findings)
 }
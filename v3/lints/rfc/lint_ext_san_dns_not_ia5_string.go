@@ -45,6 +45,8 @@ func init() {
 			Description:   "dNSNames MUST be IA5 strings",
 			Citation:      "RFC 5280: 4.2.1.6",
 			Source:        lint.RFC5280,
+			Tags:          []string{"dns", "encoding"},
+			Remediation:   "Encode the dNSNames of the subjectAltName as IA5Strings, using the A-label form for internationalized names.",
 			EffectiveDate: util.RFC2459Date,
 		},
 		Lint: NewSANDNSNotIA5String,
@@ -42,6 +42,7 @@ func init() {
 			Description:   "General name fields MUST NOT be empty in subjectAlternateNames",
 			Citation:      "RFC 5280: 4.2.1.6",
 			Source:        lint.RFC5280,
+			Remediation:   "Remove the empty names from the subjectAltName extension.",
 			EffectiveDate: util.RFC2459Date,
 		},
 		Lint: NewSANEmptyName,
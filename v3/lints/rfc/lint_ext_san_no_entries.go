@@ -41,6 +41,7 @@ func init() {
 			Description:   "If present, the SAN extension MUST contain at least one entry",
 			Citation:      "RFC 5280: 4.2.1.6",
 			Source:        lint.RFC5280,
+			Remediation:   "Include at least one name in the subjectAltName extension, or omit the extension.",
 			EffectiveDate: util.RFC2459Date,
 		},
 		Lint: NewSANNoEntry,
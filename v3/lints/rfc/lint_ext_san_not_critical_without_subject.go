@@ -42,6 +42,7 @@ func init() {
 			Description:   "If there is an empty subject field, then the SAN extension MUST be critical",
 			Citation:      "RFC 5280: 4.2.1.6",
 			Source:        lint.RFC5280,
+			Remediation:   "Mark the subjectAltName extension as critical when the subject is empty.",
 			EffectiveDate: util.RFC2459Date,
 		},
 		Lint: NewExtSANNotCritNoSubject,
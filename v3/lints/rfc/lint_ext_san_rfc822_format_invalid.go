@@ -43,6 +43,8 @@ func init() {
 			Description:   "Email MUST NOT be surrounded with `<>`, and there must be no trailing comments in `()`",
 			Citation:      "RFC 5280: 4.2.1.6",
 			Source:        lint.RFC5280,
+			Tags:          []string{"encoding"},
+			Remediation:   "Write the rfc822Names of the subjectAltName as a bare mailbox, e.g. \"user@example.com\", without angle brackets or comments.",
 			EffectiveDate: util.RFC2459Date,
 		},
 		Lint: NewInvalidEmail,
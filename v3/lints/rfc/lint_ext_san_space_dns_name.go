@@ -45,6 +45,8 @@ func init() {
 			Description:   "The dNSName ` ` MUST NOT be used",
 			Citation:      "RFC 5280: 4.2.1.6",
 			Source:        lint.RFC5280,
+			Tags:          []string{"dns", "encoding"},
+			Remediation:   "Remove the dNSName consisting of a single space from the subjectAltName extension.",
 			EffectiveDate: util.RFC2459Date,
 		},
 		Lint: NewSANIsSpaceDNS,
@@ -60,10 +62,12 @@ func (l *SANIsSpaceDNS) CheckApplies(c *x509.Certificate) bool {
 }
 
 func (l *SANIsSpaceDNS) Execute(c *x509.Certificate) *lint.LintResult {
-	for _, dns := range c.DNSNames {
+	var findings []lint.Finding
+	paths := util.SANDNSNamePaths(c)
+	for i, dns := range c.DNSNames {
 		if dns == " " {
-			return &lint.LintResult{Status: lint.Error}
+			findings = append(findings, lint.Finding{Path: paths[i], Value: dns, Expected: "a name other than a single space", Status: lint.Error})
 		}
 	}
-	return &lint.LintResult{Status: lint.Pass}
+	return lint.NewResultFromFindings(findings)
 }
@@ -36,6 +36,8 @@ func init() {
 			Description:   "URIs in SAN extension must have a scheme and scheme specific part",
 			Citation:      "RFC5280: 4.2.1.6",
 			Source:        lint.RFC5280,
+			Tags:          []string{"encoding"},
+			Remediation:   "Give each URI of the subjectAltName a scheme and a scheme specific part, e.g. \"https://example.com/\".",
 			EffectiveDate: util.RFC5280Date,
 		},
 		Lint: NewExtSANURIFormatInvalid,
@@ -36,6 +36,8 @@ func init() {
 			Description:   "When subjectAlternateName contains a URI, the name MUST be an IA5 string",
 			Citation:      "RFC5280: 4.2.1.6",
 			Source:        lint.RFC5280,
+			Tags:          []string{"encoding"},
+			Remediation:   "Encode the URIs of the subjectAltName as IA5Strings, percent-encoding non-ASCII characters.",
 			EffectiveDate: util.RFC5280Date,
 		},
 		Lint: NewExtSANURINotIA5,
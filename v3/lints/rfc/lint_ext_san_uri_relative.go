@@ -43,6 +43,7 @@ func init() {
 			Description:   "When the subjectAlternateName extension is present and a URI is used, the name MUST NOT be a relative URI",
 			Citation:      "RFC 5280: 4.2.1.6",
 			Source:        lint.RFC5280,
+			Remediation:   "Replace relative URIs in the subjectAltName with absolute URIs.",
 			EffectiveDate: util.RFC5280Date,
 		},
 		Lint: NewExtSANURIRelative,
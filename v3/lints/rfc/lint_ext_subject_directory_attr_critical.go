@@ -37,6 +37,7 @@ func init() {
 			Description:   "Conforming CAs MUST mark the Subject Directory Attributes extension as not critical",
 			Citation:      "RFC 5280: 4.2.1.8",
 			Source:        lint.RFC5280,
+			Remediation:   "Mark the subjectDirectoryAttributes extension as non-critical.",
 			EffectiveDate: util.RFC2459Date,
 		},
 		Lint: NewSubDirAttrCrit,
@@ -34,6 +34,7 @@ func init() {
 			Description:   "The subject key identifier extension MUST be non-critical",
 			Citation:      "RFC 5280: 4.2.1.2",
 			Source:        lint.RFC5280,
+			Remediation:   "Mark the subjectKeyIdentifier extension as non-critical.",
 			EffectiveDate: util.RFC2459Date,
 		},
 		Lint: NewSubjectKeyIdCritical,
@@ -49,6 +49,7 @@ func init() {
 			Description:   "CAs MUST include a Subject Key Identifier in all CA certificates",
 			Citation:      "RFC 5280: 4.2 & 4.2.1.2",
 			Source:        lint.RFC5280,
+			Remediation:   "Include a subjectKeyIdentifier extension, e.g. the SHA-1 hash of the subjectPublicKey, in CA certificates.",
 			EffectiveDate: util.RFC2459Date,
 		},
 		Lint: NewSubjectKeyIdMissingCA,
@@ -49,6 +49,7 @@ func init() {
 			Description:   "Sub certificates SHOULD include Subject Key Identifier in end entity certs",
 			Citation:      "RFC 5280: 4.2 & 4.2.1.2",
 			Source:        lint.RFC5280,
+			Remediation:   "Include a subjectKeyIdentifier extension in subscriber certificates.",
 			EffectiveDate: util.RFC2459Date,
 		},
 		Lint: NewSubjectKeyIdMissingSubscriber,
@@ -44,6 +44,8 @@ func init() {
 			Description:   "Generalized time values MUST include seconds",
 			Citation:      "RFC 5280: 4.1.2.5.2",
 			Source:        lint.RFC5280,
+			Tags:          []string{"encoding", "time"},
+			Remediation:   "Encode GeneralizedTime values as YYYYMMDDHHMMSSZ, including the seconds even when they are zero.",
 			EffectiveDate: util.RFC2459Date,
 		},
 		Lint: NewGeneralizedNoSeconds,
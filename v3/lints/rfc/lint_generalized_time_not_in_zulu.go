@@ -43,6 +43,8 @@ func init() {
 			Description:   "Generalized time values MUST be expressed in Greenwich Mean Time (Zulu)",
 			Citation:      "RFC 5280: 4.1.2.5.2",
 			Source:        lint.RFC5280,
+			Tags:          []string{"encoding", "time"},
+			Remediation:   "Encode GeneralizedTime values in UTC, ending in \"Z\", rather than with a local time or offset.",
 			EffectiveDate: util.RFC2459Date,
 		},
 		Lint: NewGeneralizedNotZulu,
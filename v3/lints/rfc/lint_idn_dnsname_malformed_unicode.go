@@ -32,6 +32,8 @@ func init() {
 			Citation:      "RFC 3490",
 			EffectiveDate: util.RFC3490Date,
 			Source:        lint.RFC5280,
+			Tags:          []string{"dns", "encoding"},
+			Remediation:   "Correct the A-labels of the dNSName so that they decode to valid Unicode, converting names with an IDNA library.",
 		},
 		Lint: NewIDNMalformedUnicode,
 	})
@@ -32,6 +32,8 @@ func init() {
 			Description:   "Internationalized DNSNames must be normalized by Unicode normalization form C",
 			Citation:      "RFC 8399",
 			Source:        lint.RFC5891,
+			Tags:          []string{"dns", "encoding"},
+			Remediation:   "Normalize internationalized names with Unicode Normalization Form C before converting them to A-labels.",
 			EffectiveDate: util.RFC8399Date,
 		},
 		Lint: NewIDNNotNFC,
@@ -31,6 +31,8 @@ func init() {
 			Description:   "RFC 5280 Section 4.2.1.3 describes the value of a KeyUsage to be a DER encoded BitString, which itself defines that all trailing 0 bits be counted as being \"unused\".",
 			Citation:      "Where ITU-T Rec. X.680 | ISO/IEC 8824-1, 21.7, applies, the bitstring shall have all trailing 0 bits removed before it is encoded.",
 			Source:        lint.RFC5280,
+			Tags:          []string{"encoding"},
+			Remediation:   "Encode the keyUsage BIT STRING in DER, removing trailing zero bits and declaring them as unused bits.",
 			EffectiveDate: util.ZeroDate,
 		},
 		Lint: func() lint.LintInterface { return &incorrectKuEncoding{} },
@@ -72,11 +74,25 @@ func (l *incorrectKuEncoding) Execute(c *x509.Certificate) *lint.LintResult {
 	for i, b := range ku {
 		binary[i] = fmt.Sprintf("%08b", b)
 	}
+	// The index locates the BIT STRING within the extnValue of the extension.
+	index, _ := util.NewCertificateDERIndex(c.Raw)
+	var location *util.DERLocation
+	if ext := index.Extension(util.KeyUsageOID); ext != nil {
+		location = index.Locate(ext.ASN1Path + ".extnValue[0]")
+	}
 	return &lint.LintResult{
 		Status: lint.Error,
 		Details: fmt.Sprintf(
 			"KeyUsage contains an inefficient encoding wherein the number of 'unused bits' is declared to be "+
 				"%d, but it should be %d. Raw Bytes: %v, Raw Binary: [%s]",
 			declaredUnused, actualUnused, ku, strings.Join(binary, " "),
-		)}
+		),
+		Findings: []lint.Finding{{
+			Path:     "extensions.keyUsage",
+			Value:    fmt.Sprintf("%d unused bits", declaredUnused),
+			Expected: fmt.Sprintf("%d unused bits", actualUnused),
+			Status:   lint.Error,
+			Location: location,
+		}},
+	}
 }
@@ -51,6 +51,16 @@ func TestKuIncorrectEncoding(t *testing.T) {
 			if !strings.Contains(got.Details, details) {
 				t.Errorf("expected the returned details to contain '%s' but got %s", details, got.Details)
 			}
+			if want != lint.Error {
+				return
+			}
+			if len(got.Findings) != 1 || got.Findings[0].Location == nil {
+				t.Fatalf("expected a located finding, got %+v", got.Findings)
+			}
+			raw := test.ReadTestCert(file).Raw
+			if loc := got.Findings[0].Location; raw[loc.Start] != 0x03 || loc.End > len(raw) {
+				t.Errorf("expected the finding to locate the BIT STRING of the KeyUsage, got %+v", loc)
+			}
 		})
 	}
 }
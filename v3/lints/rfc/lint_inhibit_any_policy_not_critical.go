@@ -44,6 +44,7 @@ func init() {
 			Description:   "CAs MUST mark the inhibitAnyPolicy extension as critical",
 			Citation:      "RFC 5280: 4.2.1.14",
 			Source:        lint.RFC5280,
+			Remediation:   "Mark the inhibitAnyPolicy extension as critical.",
 			EffectiveDate: util.RFC3280Date,
 		},
 		Lint: NewInhibitAnyPolicyNotCritical,
@@ -30,6 +30,8 @@ func init() {
 			Description:   "X520 Distinguished Name Country MUST BE encoded as PrintableString",
 			Citation:      "RFC 5280: Appendix A",
 			Source:        lint.RFC5280,
+			Tags:          []string{"encoding"},
+			Remediation:   "Encode the countryName of the issuer as a PrintableString, matching the subject of the issuing CA certificate.",
 			EffectiveDate: util.ZeroDate,
 		},
 		Lint: NewIssuerDNCountryNotPrintableString,
@@ -37,6 +37,7 @@ func init() {
 			Description:   "Certificate issuer field MUST NOT be empty and must have a non-empty distinguished name",
 			Citation:      "RFC 5280: 4.1.2.4",
 			Source:        lint.RFC5280,
+			Remediation:   "Set the issuer to the non-empty subject of the issuing CA certificate.",
 			EffectiveDate: util.RFC2459Date,
 		},
 		Lint: NewIssuerFieldEmpty,
@@ -32,6 +32,7 @@ func init() {
 			Description:   "The certificate MUST only be used for a purpose consistent with both key usage extension and extended key usage extension.",
 			Citation:      "RFC 5280, Section 4.2.1.12.",
 			Source:        lint.RFC5280,
+			Remediation:   "Make the keyUsage and extKeyUsage of the certificate agree, asserting the key usages required by each extended key usage.",
 			EffectiveDate: util.RFC5280Date,
 		},
 		Lint: NewKUAndEKUInconsistent,
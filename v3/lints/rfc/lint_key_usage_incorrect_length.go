@@ -35,6 +35,8 @@ func init() {
 			Description:   "The key usage is a bit string with exactly nine possible flags",
 			Citation:      "RFC 5280: 4.2.1.3",
 			Source:        lint.RFC5280,
+			Tags:          []string{"encoding"},
+			Remediation:   "Encode the keyUsage BIT STRING with no more than the nine defined bits.",
 			EffectiveDate: util.RFC5280Date,
 		},
 		Lint: NewKeyUsageIncorrectLength,
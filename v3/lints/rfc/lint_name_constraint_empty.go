@@ -42,6 +42,7 @@ func init() {
 			Description:   "Conforming CAs MUST NOT issue certificates where name constraints is an empty sequence. That is, either the permittedSubtree or excludedSubtree fields must be present",
 			Citation:      "RFC 5280: 4.2.1.10",
 			Source:        lint.RFC5280,
+			Remediation:   "Include permittedSubtrees or excludedSubtrees in the nameConstraints extension, or omit the extension.",
 			EffectiveDate: util.RFC5280Date,
 		},
 		Lint: NewNameConstraintEmpty,
@@ -40,6 +40,7 @@ func init() {
 			Description:   "Within the name constraints name form, the maximum field is not used and therefore MUST be absent",
 			Citation:      "RFC 5280: 4.2.1.10",
 			Source:        lint.RFC5280,
+			Remediation:   "Remove the maximum field from each GeneralSubtree of the nameConstraints extension.",
 			EffectiveDate: util.RFC2459Date,
 		},
 		Lint: NewNameConstraintMax,
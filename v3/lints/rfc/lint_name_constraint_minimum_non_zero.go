@@ -40,6 +40,7 @@ func init() {
 			Description:   "Within the name constraints name forms, the minimum field is not used and therefore MUST be zero",
 			Citation:      "RFC 5280: 4.2.1.10",
 			Source:        lint.RFC5280,
+			Remediation:   "Remove the minimum field, which then defaults to zero, from each GeneralSubtree of the nameConstraints extension.",
 			EffectiveDate: util.RFC2459Date,
 		},
 		Lint: NewNameConstMin,
@@ -43,6 +43,8 @@ func init() {
 			Description:   "For URIs, the constraint MUST be specified as a fully qualified domain name [...] When the constraint begins with a period, it MAY be expanded with one or more labels.",
 			Citation:      "RFC 5280: 4.2.1.10",
 			Source:        lint.RFC5280,
+			Tags:          []string{"dns"},
+			Remediation:   "Specify URI constraints as a fully qualified domain name, optionally beginning with a period, without a scheme, port or path.",
 			EffectiveDate: util.RFC5280Date,
 		},
 		Lint: NewNameConstraintNotFQDN,
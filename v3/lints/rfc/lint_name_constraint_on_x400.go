@@ -42,6 +42,7 @@ func init() {
 			Description:   "The name constraints extension SHOULD NOT impose constraints on the x400Address name form",
 			Citation:      "RFC 5280: 4.2.1.10",
 			Source:        lint.RFC5280,
+			Remediation:   "Remove the constraints on x400Address from the nameConstraints extension.",
 			EffectiveDate: util.RFC5280Date,
 		},
 		Lint: NewNameConstraintOnX400,
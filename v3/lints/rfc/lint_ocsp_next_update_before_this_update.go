@@ -0,0 +1,63 @@
+package rfc
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"github.com/zmap/zcrypto/x509/revocation/ocsp"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+)
+
+type ocspNextUpdateBeforeThisUpdate struct{}
+
+/************************************************
+RFC 6960: 2.4
+thisUpdate      The most recent time at which the status being
+                indicated is known by the responder to have been
+                correct.
+
+nextUpdate      The time at or before which newer information will be
+                available about the status of the certificate.
+************************************************/
+
+func init() {
+	lint.RegisterOCSPResponseLint(&lint.OCSPResponseLint{
+		LintMetadata: lint.LintMetadata{
+			Name:          "e_ocsp_next_update_before_this_update",
+			Description:   "The nextUpdate of an OCSP response must not be earlier than its thisUpdate",
+			Citation:      "RFC 6960: 2.4",
+			Source:        lint.RFC6960,
+			Tags:          []string{"time"},
+			Remediation:   "Set the nextUpdate of the OCSP response to a time no earlier than its thisUpdate.",
+			EffectiveDate: util.ZeroDate,
+		},
+		Lint: NewOCSPNextUpdateBeforeThisUpdate,
+	})
+}
+
+func NewOCSPNextUpdateBeforeThisUpdate() lint.OCSPResponseLintInterface {
+	return &ocspNextUpdateBeforeThisUpdate{}
+}
+
+func (l *ocspNextUpdateBeforeThisUpdate) CheckApplies(r *ocsp.Response) bool {
+	return !r.NextUpdate.IsZero()
+}
+
+func (l *ocspNextUpdateBeforeThisUpdate) Execute(r *ocsp.Response) *lint.LintResult {
+	if r.NextUpdate.Before(r.ThisUpdate) {
+		return &lint.LintResult{Status: lint.Error, Details: "OCSP response nextUpdate is earlier than thisUpdate"}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
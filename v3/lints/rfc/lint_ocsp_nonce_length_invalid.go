@@ -0,0 +1,73 @@
+package rfc
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"fmt"
+
+	"github.com/zmap/zcrypto/encoding/asn1"
+	"github.com/zmap/zcrypto/x509/revocation/ocsp"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+)
+
+type ocspNonceLengthInvalid struct{}
+
+/************************************************
+RFC 8954: 2.1
+   Nonce ::= OCTET STRING(SIZE(1..32))
+
+An OCSP responder that supports the Nonce extension MUST accept Nonce
+lengths of at least 16 octets and up to and including 32 octets.
+************************************************/
+
+func init() {
+	lint.RegisterOCSPResponseLint(&lint.OCSPResponseLint{
+		LintMetadata: lint.LintMetadata{
+			Name:          "e_ocsp_nonce_length_invalid",
+			Description:   "The OCSP Nonce extension must contain between 1 and 32 octets",
+			Citation:      "RFC 8954: 2.1",
+			Source:        lint.RFC8954,
+			Remediation:   "Use a nonce of between 1 and 32 octets in the OCSP Nonce extension.",
+			EffectiveDate: util.ZeroDate,
+		},
+		Lint: NewOCSPNonceLengthInvalid,
+	})
+}
+
+func NewOCSPNonceLengthInvalid() lint.OCSPResponseLintInterface {
+	return &ocspNonceLengthInvalid{}
+}
+
+func (l *ocspNonceLengthInvalid) CheckApplies(r *ocsp.Response) bool {
+	ext, err := util.GetOCSPResponseExtension(r, util.OcspNonceOID)
+	return err == nil && ext != nil
+}
+
+func (l *ocspNonceLengthInvalid) Execute(r *ocsp.Response) *lint.LintResult {
+	// CheckApplies has already ensured that the extension parses.
+	ext, _ := util.GetOCSPResponseExtension(r, util.OcspNonceOID)
+	var nonce []byte
+	if rest, err := asn1.Unmarshal(ext.Value, &nonce); err != nil || len(rest) > 0 {
+		return &lint.LintResult{Status: lint.Error, Details: "OCSP Nonce extension is not a valid OCTET STRING"}
+	}
+	if len(nonce) < 1 || len(nonce) > 32 {
+		return &lint.LintResult{
+			Status:  lint.Error,
+			Details: fmt.Sprintf("OCSP Nonce is %d octets long, but must be between 1 and 32 octets", len(nonce)),
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
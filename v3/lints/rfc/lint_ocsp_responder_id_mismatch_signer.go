@@ -0,0 +1,87 @@
+package rfc
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"bytes"
+	"crypto/sha1"
+
+	"github.com/zmap/zcrypto/encoding/asn1"
+	"github.com/zmap/zcrypto/x509/pkix"
+	"github.com/zmap/zcrypto/x509/revocation/ocsp"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+)
+
+type ocspResponderIDMismatchSigner struct{}
+
+/************************************************
+RFC 6960: 4.2.2.3
+The ResponderID information corresponds to the responder's public key
+certificate.
+
+   ResponderID ::= CHOICE {
+      byName   [1] Name,
+      byKey    [2] KeyHash }
+
+   KeyHash ::= OCTET STRING -- SHA-1 hash of responder's public key
+   (excluding the tag and length fields)
+************************************************/
+
+func init() {
+	lint.RegisterOCSPResponseLint(&lint.OCSPResponseLint{
+		LintMetadata: lint.LintMetadata{
+			Name:          "e_ocsp_responder_id_mismatch_signer",
+			Description:   "The ResponderID of an OCSP response must identify the certificate of the responder that signed it",
+			Citation:      "RFC 6960: 4.2.2.3",
+			Source:        lint.RFC6960,
+			Remediation:   "Set the ResponderID of the OCSP response to the name or key hash of the certificate whose key signs the response.",
+			EffectiveDate: util.ZeroDate,
+		},
+		Lint: NewOCSPResponderIDMismatchSigner,
+	})
+}
+
+func NewOCSPResponderIDMismatchSigner() lint.OCSPResponseLintInterface {
+	return &ocspResponderIDMismatchSigner{}
+}
+
+// CheckApplies only considers responses that embed the certificate of the
+// responder, since the signer is otherwise unknown.
+func (l *ocspResponderIDMismatchSigner) CheckApplies(r *ocsp.Response) bool {
+	return r.Certificate != nil
+}
+
+func (l *ocspResponderIDMismatchSigner) Execute(r *ocsp.Response) *lint.LintResult {
+	if len(r.RawResponderName) > 0 {
+		if !bytes.Equal(r.RawResponderName, r.Certificate.RawSubject) {
+			return &lint.LintResult{Status: lint.Error, Details: "OCSP ResponderID byName does not match the subject of the responder certificate"}
+		}
+		return &lint.LintResult{Status: lint.Pass}
+	}
+
+	var spki struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}
+	if _, err := asn1.Unmarshal(r.Certificate.RawSubjectPublicKeyInfo, &spki); err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: "failed to parse the public key of the responder certificate"}
+	}
+	keyHash := sha1.Sum(spki.PublicKey.RightAlign())
+	if !bytes.Equal(r.ResponderKeyHash, keyHash[:]) {
+		return &lint.LintResult{Status: lint.Error, Details: "OCSP ResponderID byKey does not match the SHA-1 hash of the responder certificate's public key"}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
@@ -37,6 +37,7 @@ func init() {
 			Description:   "CAs MUST NOT include the pathLenConstraint field unless the CA boolean is asserted and the keyCertSign bit is set",
 			Citation:      "RFC 5280: 4.2.1.9",
 			Source:        lint.RFC5280,
+			Remediation:   "Remove the pathLenConstraint from certificates that do not assert the cA boolean and the keyCertSign key usage.",
 			EffectiveDate: util.RFC3280Date,
 		},
 		Lint: NewPathLenIncluded,
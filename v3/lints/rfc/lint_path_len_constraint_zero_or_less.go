@@ -52,6 +52,7 @@ func init() {
 			Description:   "Where it appears, the pathLenConstraint field MUST be greater than or equal to zero",
 			Citation:      "RFC 5280: 4.2.1.9",
 			Source:        lint.RFC5280,
+			Remediation:   "Set the pathLenConstraint to zero or greater, or omit it.",
 			EffectiveDate: util.RFC2459Date,
 		},
 		Lint: NewPathLenNonPositive,
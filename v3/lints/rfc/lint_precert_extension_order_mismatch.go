@@ -0,0 +1,77 @@
+package rfc
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"bytes"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+)
+
+type precertExtensionOrderMismatch struct{}
+
+/************************************************
+RFC 6962: 3.1
+The Precertificate is constructed from the certificate to be issued by adding
+a special critical poison extension ... to the end-entity TBSCertificate.
+
+Since the precertificate is the final TBSCertificate with the poison added,
+the extensions common to both must appear in the same order. Logs and
+monitors reconstruct the precertificate from the final certificate and will
+not match it otherwise.
+************************************************/
+
+func init() {
+	lint.RegisterPrecertificatePairLint(&lint.PrecertificatePairLint{
+		LintMetadata: lint.LintMetadata{
+			Name:          "e_precert_extension_order_mismatch",
+			Description:   "The extensions of a certificate MUST appear in the same order as in its precertificate",
+			Citation:      "RFC 6962: 3.1",
+			Source:        lint.RFC6962,
+			Remediation:   "Issue the certificate with its extensions in the same order as in its precertificate.",
+			EffectiveDate: util.ZeroDate,
+		},
+		Lint: NewPrecertExtensionOrderMismatch,
+	})
+}
+
+func NewPrecertExtensionOrderMismatch() lint.PrecertificatePairLintInterface {
+	return &precertExtensionOrderMismatch{}
+}
+
+// CheckApplies only considers pairs whose extensions are otherwise identical,
+// since any other difference is reported by e_precert_extensions_mismatch.
+func (l *precertExtensionOrderMismatch) CheckApplies(precert *x509.Certificate, cert *x509.Certificate, issuer *x509.Certificate) bool {
+	p, c, err := comparablePrecertExtensions(precert, cert, issuer)
+	return err == nil && precertExtensionsDiffer(p, c) == ""
+}
+
+func (l *precertExtensionOrderMismatch) Execute(precert *x509.Certificate, cert *x509.Certificate, issuer *x509.Certificate) *lint.LintResult {
+	p, c, err := comparablePrecertExtensions(precert, cert, issuer)
+	if err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: err.Error()}
+	}
+	for i := range p {
+		if !bytes.Equal(p[i].Raw, c[i].Raw) {
+			return &lint.LintResult{
+				Status:  lint.Error,
+				Details: "extension " + p[i].OID.String() + " is at a different position in the certificate than in the precertificate",
+			}
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
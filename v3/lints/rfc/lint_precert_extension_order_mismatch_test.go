@@ -0,0 +1,75 @@
+package rfc
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/test"
+)
+
+func TestPrecertExtensionOrderMismatch(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name    string
+		precert string
+		cert    string
+		issuer  string
+		want    lint.LintStatus
+	}{
+		{
+			name:    "same order",
+			precert: "precertValid.pem",
+			cert:    "precertFinalValid.pem",
+			want:    lint.Pass,
+		},
+		{
+			name:    "reordered extensions",
+			precert: "precertValid.pem",
+			cert:    "precertFinalExtensionReordered.pem",
+			want:    lint.Error,
+		},
+		{
+			name:    "additional extension",
+			precert: "precertValid.pem",
+			cert:    "precertFinalExtraExtension.pem",
+			want:    lint.NA,
+		},
+		{
+			name:    "issued by a precertificate signing certificate",
+			precert: "precertIssuedByPrecertSigner.pem",
+			cert:    "precertFinalIssuedByPrecertSignerCA.pem",
+			issuer:  "precertSigningCertificate.pem",
+			want:    lint.Pass,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			var result *lint.LintResult
+			if tc.issuer == "" {
+				result = test.TestPrecertificatePairLint(t, "e_precert_extension_order_mismatch", tc.precert, tc.cert)
+			} else {
+				result = test.TestPrecertificatePairLintWithIssuer(t, "e_precert_extension_order_mismatch", tc.precert, tc.cert, tc.issuer)
+			}
+			if result.Status != tc.want {
+				t.Errorf("expected %s, got %s: %s", tc.want, result.Status, result.Details)
+			}
+		})
+	}
+}
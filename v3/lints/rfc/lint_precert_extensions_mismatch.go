@@ -0,0 +1,127 @@
+package rfc
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+)
+
+type precertExtensionsMismatch struct{}
+
+/************************************************
+RFC 6962: 3.1
+The Precertificate is constructed from the certificate to be issued by adding
+a special critical poison extension ... to the end-entity TBSCertificate.
+
+RFC 6962: 3.3
+The SCT list extension is added to the final certificate.
+
+Apart from the poison extension and the SCT list extension, the final
+certificate must carry the same extensions as the precertificate, each with an
+identical encoding. A precertificate issued by a Precertificate Signing
+Certificate identifies that certificate in its authority key identifier, so
+the authority key identifier is not compared when the issuer of the
+precertificate is known to be one.
+************************************************/
+
+func init() {
+	lint.RegisterPrecertificatePairLint(&lint.PrecertificatePairLint{
+		LintMetadata: lint.LintMetadata{
+			Name:          "e_precert_extensions_mismatch",
+			Description:   "Apart from the poison and SCT list extensions, a certificate MUST contain the same extensions, identically encoded, as its precertificate",
+			Citation:      "RFC 6962: 3.1",
+			Source:        lint.RFC6962,
+			Remediation:   "Issue the certificate with the same extensions as its precertificate, identically encoded, other than the poison and SCT list extensions.",
+			EffectiveDate: util.ZeroDate,
+		},
+		Lint: NewPrecertExtensionsMismatch,
+	})
+}
+
+func NewPrecertExtensionsMismatch() lint.PrecertificatePairLintInterface {
+	return &precertExtensionsMismatch{}
+}
+
+func (l *precertExtensionsMismatch) CheckApplies(precert *x509.Certificate, cert *x509.Certificate, issuer *x509.Certificate) bool {
+	return true
+}
+
+func (l *precertExtensionsMismatch) Execute(precert *x509.Certificate, cert *x509.Certificate, issuer *x509.Certificate) *lint.LintResult {
+	p, c, err := comparablePrecertExtensions(precert, cert, issuer)
+	if err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: err.Error()}
+	}
+	if details := precertExtensionsDiffer(p, c); details != "" {
+		return &lint.LintResult{Status: lint.Error, Details: details}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+// precertExtensionsDiffer describes the first extension of either p or c
+// that has no identically encoded counterpart in the other, regardless of
+// order, or returns an empty string if there is none.
+func precertExtensionsDiffer(p []util.RawExtension, c []util.RawExtension) string {
+	unmatched := make([]bool, len(c))
+	for i := range unmatched {
+		unmatched[i] = true
+	}
+	for _, pe := range p {
+		found := false
+		for i, ce := range c {
+			if unmatched[i] && bytes.Equal(pe.Raw, ce.Raw) {
+				unmatched[i] = false
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Sprintf("extension %s of the precertificate is missing from the certificate or encoded differently", pe.OID)
+		}
+	}
+	for i, ce := range c {
+		if unmatched[i] {
+			return fmt.Sprintf("extension %s of the certificate is not present in the precertificate", ce.OID)
+		}
+	}
+	return ""
+}
+
+// comparablePrecertExtensions returns the extensions of the precertificate
+// without the poison extension and the extensions of the certificate without
+// the SCT list extension. If the precertificate was issued by a
+// Precertificate Signing Certificate then the authority key identifier is
+// removed from both, since it identifies a different key in each.
+func comparablePrecertExtensions(precert *x509.Certificate, cert *x509.Certificate, issuer *x509.Certificate) ([]util.RawExtension, []util.RawExtension, error) {
+	p, err := util.ParseTBSCertificateFields(precert.RawTBSCertificate)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse precertificate: %w", err)
+	}
+	c, err := util.ParseTBSCertificateFields(cert.RawTBSCertificate)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse certificate: %w", err)
+	}
+	pe := util.RemoveRawExtension(p.Extensions, util.CtPoisonOID)
+	ce := util.RemoveRawExtension(c.Extensions, util.TimestampOID)
+	if issuer != nil && util.IsPrecertSigningCert(issuer) {
+		pe = util.RemoveRawExtension(pe, util.AuthkeyOID)
+		ce = util.RemoveRawExtension(ce, util.AuthkeyOID)
+	}
+	return pe, ce, nil
+}
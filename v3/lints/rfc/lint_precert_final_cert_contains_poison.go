@@ -0,0 +1,61 @@
+package rfc
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+)
+
+type precertFinalCertContainsPoison struct{}
+
+/************************************************
+RFC 6962: 3.1
+The Precertificate is constructed from the certificate to be issued by adding
+a special critical poison extension ... to the end-entity TBSCertificate.
+
+The poison extension only marks the precertificate, so the certificate issued
+from it must not carry the extension.
+************************************************/
+
+func init() {
+	lint.RegisterPrecertificatePairLint(&lint.PrecertificatePairLint{
+		LintMetadata: lint.LintMetadata{
+			Name:          "e_precert_final_cert_contains_poison",
+			Description:   "The final certificate of a precertificate and certificate pair MUST NOT contain the CT poison extension",
+			Citation:      "RFC 6962: 3.1",
+			Source:        lint.RFC6962,
+			Remediation:   "Remove the CT poison extension from the final certificate.",
+			EffectiveDate: util.ZeroDate,
+		},
+		Lint: NewPrecertFinalCertContainsPoison,
+	})
+}
+
+func NewPrecertFinalCertContainsPoison() lint.PrecertificatePairLintInterface {
+	return &precertFinalCertContainsPoison{}
+}
+
+func (l *precertFinalCertContainsPoison) CheckApplies(precert *x509.Certificate, cert *x509.Certificate, issuer *x509.Certificate) bool {
+	return true
+}
+
+func (l *precertFinalCertContainsPoison) Execute(precert *x509.Certificate, cert *x509.Certificate, issuer *x509.Certificate) *lint.LintResult {
+	if util.IsExtInCert(cert, util.CtPoisonOID) {
+		return &lint.LintResult{Status: lint.Error, Details: "final certificate contains the CT poison extension"}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
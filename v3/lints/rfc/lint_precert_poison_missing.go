@@ -0,0 +1,64 @@
+package rfc
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+)
+
+type precertPoisonMissing struct{}
+
+/************************************************
+RFC 6962: 3.1
+The Precertificate is constructed from the certificate to be issued by adding
+a special critical poison extension (OID 1.3.6.1.4.1.11129.2.4.3, whose
+extnValue OCTET STRING contains ASN.1 NULL data (0x05 0x00)) to the end-entity
+TBSCertificate.
+************************************************/
+
+func init() {
+	lint.RegisterPrecertificatePairLint(&lint.PrecertificatePairLint{
+		LintMetadata: lint.LintMetadata{
+			Name:          "e_precert_poison_missing",
+			Description:   "The precertificate of a precertificate and certificate pair MUST contain the critical CT poison extension",
+			Citation:      "RFC 6962: 3.1",
+			Source:        lint.RFC6962,
+			Remediation:   "Include the critical CT poison extension in the precertificate.",
+			EffectiveDate: util.ZeroDate,
+		},
+		Lint: NewPrecertPoisonMissing,
+	})
+}
+
+func NewPrecertPoisonMissing() lint.PrecertificatePairLintInterface {
+	return &precertPoisonMissing{}
+}
+
+func (l *precertPoisonMissing) CheckApplies(precert *x509.Certificate, cert *x509.Certificate, issuer *x509.Certificate) bool {
+	return true
+}
+
+func (l *precertPoisonMissing) Execute(precert *x509.Certificate, cert *x509.Certificate, issuer *x509.Certificate) *lint.LintResult {
+	ext := util.GetExtFromCert(precert, util.CtPoisonOID)
+	if ext == nil {
+		return &lint.LintResult{Status: lint.Error, Details: "precertificate does not contain the CT poison extension"}
+	}
+	if !ext.Critical {
+		return &lint.LintResult{Status: lint.Error, Details: "CT poison extension of the precertificate is not critical"}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
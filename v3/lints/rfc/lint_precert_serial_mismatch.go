@@ -0,0 +1,67 @@
+package rfc
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"fmt"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+)
+
+type precertSerialMismatch struct{}
+
+/************************************************
+RFC 6962: 3.1
+The Precertificate is constructed from the certificate to be issued by adding
+a special critical poison extension ... to the end-entity TBSCertificate.
+
+Apart from the poison extension and the SCT list, the TBSCertificate of the
+final certificate is the same as that of the precertificate, including the
+serial number.
+************************************************/
+
+func init() {
+	lint.RegisterPrecertificatePairLint(&lint.PrecertificatePairLint{
+		LintMetadata: lint.LintMetadata{
+			Name:          "e_precert_serial_mismatch",
+			Description:   "The serial number of a certificate MUST be identical to the serial number of its precertificate",
+			Citation:      "RFC 6962: 3.1",
+			Source:        lint.RFC6962,
+			Remediation:   "Issue the certificate with the same serial number as its precertificate.",
+			EffectiveDate: util.ZeroDate,
+		},
+		Lint: NewPrecertSerialMismatch,
+	})
+}
+
+func NewPrecertSerialMismatch() lint.PrecertificatePairLintInterface {
+	return &precertSerialMismatch{}
+}
+
+func (l *precertSerialMismatch) CheckApplies(precert *x509.Certificate, cert *x509.Certificate, issuer *x509.Certificate) bool {
+	return true
+}
+
+func (l *precertSerialMismatch) Execute(precert *x509.Certificate, cert *x509.Certificate, issuer *x509.Certificate) *lint.LintResult {
+	if precert.SerialNumber.Cmp(cert.SerialNumber) != 0 {
+		return &lint.LintResult{
+			Status:  lint.Error,
+			Details: fmt.Sprintf("serial number %x of the certificate does not match serial number %x of the precertificate", cert.SerialNumber, precert.SerialNumber),
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
@@ -0,0 +1,109 @@
+package rfc
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+)
+
+type precertTBSFieldMismatch struct{}
+
+/************************************************
+RFC 6962: 3.1
+The Precertificate is constructed from the certificate to be issued by adding
+a special critical poison extension ... to the end-entity TBSCertificate.
+
+Every field of the TBSCertificate other than the extensions must therefore be
+encoded identically in the precertificate and the final certificate.
+
+A precertificate issued by a Precertificate Signing Certificate instead names
+that certificate as its issuer, and the final certificate names the CA that
+certified it. When the issuer of the precertificate is known to be one, the
+issuer of the final certificate is compared with the issuer of the
+Precertificate Signing Certificate.
+************************************************/
+
+func init() {
+	lint.RegisterPrecertificatePairLint(&lint.PrecertificatePairLint{
+		LintMetadata: lint.LintMetadata{
+			Name:          "e_precert_tbs_field_mismatch",
+			Description:   "The TBSCertificate fields of a certificate MUST be encoded identically to those of its precertificate",
+			Citation:      "RFC 6962: 3.1",
+			Source:        lint.RFC6962,
+			Remediation:   "Issue the certificate with the TBSCertificate fields of its precertificate, identically encoded.",
+			EffectiveDate: util.ZeroDate,
+		},
+		Lint: NewPrecertTBSFieldMismatch,
+	})
+}
+
+func NewPrecertTBSFieldMismatch() lint.PrecertificatePairLintInterface {
+	return &precertTBSFieldMismatch{}
+}
+
+func (l *precertTBSFieldMismatch) CheckApplies(precert *x509.Certificate, cert *x509.Certificate, issuer *x509.Certificate) bool {
+	return true
+}
+
+func (l *precertTBSFieldMismatch) Execute(precert *x509.Certificate, cert *x509.Certificate, issuer *x509.Certificate) *lint.LintResult {
+	p, err := util.ParseTBSCertificateFields(precert.RawTBSCertificate)
+	if err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: fmt.Sprintf("failed to parse precertificate: %v", err)}
+	}
+	c, err := util.ParseTBSCertificateFields(cert.RawTBSCertificate)
+	if err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: fmt.Sprintf("failed to parse certificate: %v", err)}
+	}
+
+	precertIssuer := p.Issuer
+	if issuer != nil && util.IsPrecertSigningCert(issuer) {
+		precertIssuer = issuer.RawIssuer
+	}
+
+	fields := []struct {
+		name    string
+		precert []byte
+		cert    []byte
+	}{
+		{"version", p.Version, c.Version},
+		{"serialNumber", p.SerialNumber, c.SerialNumber},
+		{"signature", p.Signature, c.Signature},
+		{"issuer", precertIssuer, c.Issuer},
+		{"validity", p.Validity, c.Validity},
+		{"subject", p.Subject, c.Subject},
+		{"subjectPublicKeyInfo", p.SubjectPublicKeyInfo, c.SubjectPublicKeyInfo},
+		{"issuerUniqueID", p.IssuerUniqueID, c.IssuerUniqueID},
+		{"subjectUniqueID", p.SubjectUniqueID, c.SubjectUniqueID},
+	}
+	var mismatched []string
+	for _, f := range fields {
+		if !bytes.Equal(f.precert, f.cert) {
+			mismatched = append(mismatched, f.name)
+		}
+	}
+	if len(mismatched) > 0 {
+		return &lint.LintResult{
+			Status:  lint.Error,
+			Details: "TBSCertificate fields differ from the precertificate: " + strings.Join(mismatched, ", "),
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
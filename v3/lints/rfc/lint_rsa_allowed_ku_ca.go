@@ -47,6 +47,8 @@ func init() {
 			Description:   "Key usage values digitalSignature, nonRepudiation, keyEncipherment, dataEncipherment, keyCertSign, and cRLSign may only be present in a CA certificate with an RSA key",
 			Citation:      "RFC 3279: 2.3.1",
 			Source:        lint.RFC3279,
+			Tags:          []string{"key"},
+			Remediation:   "Assert only digitalSignature, nonRepudiation, keyEncipherment, dataEncipherment, keyCertSign and cRLSign in the keyUsage of CA certificates for RSA keys.",
 			EffectiveDate: util.RFC3279Date,
 		},
 		Lint: NewRsaAllowedKUCa,
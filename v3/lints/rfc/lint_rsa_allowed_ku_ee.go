@@ -45,6 +45,8 @@ func init() {
 			Description:   "Key usage values digitalSignature, nonRepudiation, keyEncipherment, and dataEncipherment may only be present in an end entity certificate with an RSA key",
 			Citation:      "RFC 3279: 2.3.1",
 			Source:        lint.RFC3279,
+			Tags:          []string{"key"},
+			Remediation:   "Assert only digitalSignature, nonRepudiation, keyEncipherment and dataEncipherment in the keyUsage of end entity certificates for RSA keys.",
 			EffectiveDate: util.RFC3279Date,
 		},
 		Lint: NewRsaAllowedKUEe,
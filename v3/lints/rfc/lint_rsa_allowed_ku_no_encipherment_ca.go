@@ -47,6 +47,8 @@ func init() {
 			Description:   "If Key usage value keyCertSign or cRLSign is present in a CA certificate both keyEncipherment and dataEncipherment SHOULD NOT be present",
 			Citation:      "RFC 3279: 2.3.1",
 			Source:        lint.RFC3279,
+			Tags:          []string{"key"},
+			Remediation:   "Remove keyEncipherment and dataEncipherment from the keyUsage of CA certificates that assert keyCertSign or cRLSign.",
 			EffectiveDate: util.RFC3279Date,
 		},
 		Lint: NewRsaAllowedKUCaNoEncipherment,
@@ -49,6 +49,7 @@ func init() {
 			Description:   "Certificates must not have a DER encoded serial number longer than 20 octets",
 			Citation:      "RFC 5280: 4.1.2.2",
 			Source:        lint.RFC5280,
+			Remediation:   "Generate serial numbers whose DER encoding is no longer than 20 octets, e.g. from at most 159 random bits.",
 			EffectiveDate: util.RFC3280Date,
 		},
 		Lint: NewSerialNumberTooLong,
@@ -46,6 +46,7 @@ func init() {
 			Description:   "Certificates must have a positive serial number",
 			Citation:      "RFC 5280: 4.1.2.2",
 			Source:        lint.RFC5280,
+			Remediation:   "Use a positive serial number, ensuring the most significant bit of its first octet is clear or preceded by a zero octet.",
 			EffectiveDate: util.RFC3280Date,
 		},
 		Lint: NewSerialNumberNotPositive,
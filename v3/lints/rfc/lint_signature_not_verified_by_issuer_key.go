@@ -0,0 +1,68 @@
+package rfc
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"fmt"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+)
+
+type signatureNotVerifiedByIssuerKey struct{}
+
+/***********************************************************************
+RFC 5280: 6.1.3
+   (a)  Verify the basic certificate information.  The certificate
+        MUST satisfy each of the following:
+
+      (1)  The signature on the certificate can be verified using
+           working_public_key_algorithm, the working_public_key, and
+           the working_public_key_parameters.
+***********************************************************************/
+
+func init() {
+	lint.RegisterChainLint(&lint.ChainLint{
+		LintMetadata: lint.LintMetadata{
+			Name:          "e_signature_not_verified_by_issuer_key",
+			Description:   "The signature on the certificate MUST be verifiable using the public key of the issuer",
+			Citation:      "RFC 5280: 6.1.3",
+			Source:        lint.RFC5280,
+			Remediation:   "Sign the certificate with the private key of the issuing CA certificate, and check that the signature verifies before issuance.",
+			EffectiveDate: util.RFC2459Date,
+		},
+		Lint: NewSignatureNotVerifiedByIssuerKey,
+	})
+}
+
+func NewSignatureNotVerifiedByIssuerKey() lint.ChainLintInterface {
+	return &signatureNotVerifiedByIssuerKey{}
+}
+
+func (l *signatureNotVerifiedByIssuerKey) CheckApplies(c *x509.Certificate, issuer *x509.Certificate) bool {
+	return true
+}
+
+func (l *signatureNotVerifiedByIssuerKey) Execute(c *x509.Certificate, issuer *x509.Certificate) *lint.LintResult {
+	err := issuer.CheckSignature(c.SignatureAlgorithm, c.RawTBSCertificate, c.Signature)
+	if err != nil {
+		return &lint.LintResult{
+			Status:  lint.Error,
+			Details: fmt.Sprintf("signature could not be verified using the issuer's public key: %s", err),
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
@@ -36,6 +36,8 @@ func init() {
 			Description:   "RSA: Encoded public key algorithm identifier MUST have NULL parameters",
 			Citation:      "RFC 4055, Section 1.2",
 			Source:        lint.RFC5280, // RFC4055 is referenced in lint.RFC5280, Section 1
+			Tags:          []string{"key", "encoding"},
+			Remediation:   "Encode the parameters of the rsaEncryption algorithm identifier in the subjectPublicKeyInfo as NULL.",
 			EffectiveDate: util.RFC5280Date,
 		},
 		Lint: NewRsaSPKIEncryptionParamNotNULL,
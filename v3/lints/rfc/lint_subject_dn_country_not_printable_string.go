@@ -30,6 +30,8 @@ func init() {
 			Description:   "X520 Distinguished Name Country MUST be encoded as PrintableString",
 			Citation:      "RFC 5280: Appendix A",
 			Source:        lint.RFC5280,
+			Tags:          []string{"encoding"},
+			Remediation:   "Encode the countryName of the subject as a PrintableString.",
 			EffectiveDate: util.ZeroDate,
 		},
 		Lint: NewSubjectDNCountryNotPrintableString,
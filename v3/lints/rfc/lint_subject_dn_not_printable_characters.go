@@ -32,6 +32,8 @@ func init() {
 			Description:   "X520 Subject fields MUST only contain printable control characters",
 			Citation:      "RFC 5280: Appendix A",
 			Source:        lint.RFC5280,
+			Tags:          []string{"encoding"},
+			Remediation:   "Remove the control characters from the attributes of the subject.",
 			EffectiveDate: util.ZeroDate,
 		},
 		Lint: NewSubjectDNNotPrintableCharacters,
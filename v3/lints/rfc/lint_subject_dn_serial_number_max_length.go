@@ -31,6 +31,7 @@ func init() {
 			Description:   "The 'Serial Number' field of the subject MUST be less than 65 characters",
 			Citation:      "RFC 5280: Appendix A",
 			Source:        lint.RFC5280,
+			Remediation:   "Shorten the serialNumber of the subject to no more than 64 characters.",
 			EffectiveDate: util.ZeroDate,
 		},
 		Lint: NewSubjectDNSerialNumberMaxLength,
@@ -30,6 +30,8 @@ func init() {
 			Description:   "X520 Distinguished Name SerialNumber MUST be encoded as PrintableString",
 			Citation:      "RFC 5280: Appendix A",
 			Source:        lint.RFC5280,
+			Tags:          []string{"encoding"},
+			Remediation:   "Encode the serialNumber of the subject as a PrintableString.",
 			EffectiveDate: util.ZeroDate,
 		},
 		Lint: NewSubjectDNSerialNumberNotPrintableString,
@@ -45,6 +45,7 @@ func init() {
 			Description:   "The 'Email' field of the subject MUST be less than 256 characters",
 			Citation:      "RFC 5280: A.1",
 			Source:        lint.RFC5280,
+			Remediation:   "Shorten the emailAddress of the subject to no more than 255 characters.",
 			EffectiveDate: util.RFC2459Date,
 		},
 		Lint: NewSubjectEmailMaxLength,
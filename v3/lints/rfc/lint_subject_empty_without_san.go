@@ -42,6 +42,7 @@ func init() {
 			Description:   "CAs MUST support subject alternative name if the subject field is an empty sequence",
 			Citation:      "RFC 5280: 4.2 & 4.2.1.6",
 			Source:        lint.RFC5280,
+			Remediation:   "Include a subjectAltName extension when the subject is empty.",
 			EffectiveDate: util.RFC2459Date,
 		},
 		Lint: NewEmptyWithoutSAN,
@@ -56,6 +56,7 @@ func init() {
 			Description:   "The 'GivenName' field of the subject MUST be less than 32769 characters",
 			Citation:      "RFC 5280: A.1",
 			Source:        lint.RFC5280,
+			Remediation:   "Shorten the givenName of the subject to no more than 32768 characters.",
 			EffectiveDate: util.RFC2459Date,
 		},
 		Lint: NewSubjectGivenNameMaxLength,
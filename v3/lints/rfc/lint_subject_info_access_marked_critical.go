@@ -33,6 +33,7 @@ func init() {
 			Description:   "Conforming CAs MUST mark the Subject Info Access extension as non-critical",
 			Citation:      "RFC 5280: 4.2.2.2",
 			Source:        lint.RFC5280,
+			Remediation:   "Mark the subjectInfoAccess extension as non-critical.",
 			EffectiveDate: util.RFC3280Date,
 		},
 		Lint: NewSiaCrit,
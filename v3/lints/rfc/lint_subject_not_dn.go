@@ -41,6 +41,8 @@ func init() {
 			Description:   "When not empty, the subject field MUST be a distinguished name",
 			Citation:      "RFC 5280: 4.1.2.6",
 			Source:        lint.RFC5280,
+			Tags:          []string{"encoding"},
+			Remediation:   "Encode the subject as a sequence of RelativeDistinguishedNames.",
 			EffectiveDate: util.RFC2459Date,
 		},
 		Lint: NewSubjectDN,
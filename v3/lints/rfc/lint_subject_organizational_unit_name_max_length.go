@@ -38,6 +38,7 @@ func init() {
 			Description:   "The 'Organizational Unit Name' field of the subject MUST be less than 65 characters",
 			Citation:      "RFC 5280: A.1",
 			Source:        lint.RFC5280,
+			Remediation:   "Shorten the organizationalUnitName of the subject to no more than 64 characters.",
 			EffectiveDate: util.RFC2459Date,
 		},
 		Lint: NewSubjectOrganizationalUnitNameMaxLength,
@@ -39,6 +39,7 @@ func init() {
 			Description:   "The 'PostalCode' field of the subject MUST be less than 17 characters",
 			Citation:      "RFC 5280: A.1",
 			Source:        lint.RFC5280,
+			Remediation:   "Shorten the postalCode of the subject to no more than 16 characters.",
 			EffectiveDate: util.RFC2459Date,
 		},
 		Lint: NewSubjectPostalCodeMaxLength,
@@ -18,6 +18,7 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/zmap/zcrypto/encoding/asn1"
 	"github.com/zmap/zcrypto/x509"
@@ -32,6 +33,8 @@ func init() {
 			Description:   "PrintableString type's alphabet only includes a-z, A-Z, 0-9, and 11 special characters",
 			Citation:      "RFC 5280: Appendix B. ASN.1 Notes",
 			Source:        lint.RFC5280,
+			Tags:          []string{"encoding"},
+			Remediation:   "Encode the attribute as a UTF8String, or remove the characters outside the PrintableString alphabet.",
 			EffectiveDate: util.RFC2459Date,
 		},
 		Lint: NewSubjectPrintableStringBadAlpha,
@@ -89,22 +92,38 @@ func (l *subjectPrintableStringBadAlpha) Execute(c *x509.Certificate) *lint.Lint
 		}
 	}
 
-	for _, attrTypeAndValueSet := range rdnSequence {
-		for _, attrTypeAndValue := range attrTypeAndValueSet {
+	var findings []lint.Finding
+	var details []string
+	for i, attrTypeAndValueSet := range rdnSequence {
+		for j, attrTypeAndValue := range attrTypeAndValueSet {
 			// If the attribute type is a PrintableString the bytes of the attribute
 			// value must match the printable string alphabet.
 			if attrTypeAndValue.Value.Tag == asn1.TagPrintableString {
 				if err := validatePrintableString(attrTypeAndValue.Value.Bytes); err != nil {
-					return &lint.LintResult{
-						Status: lint.Error,
-						Details: fmt.Sprintf("RawSubject attr oid %s %s",
-							attrTypeAndValue.Type, err.Error()),
-					}
+					findings = append(findings, lint.Finding{
+						Path:     fmt.Sprintf("subject[%d][%d].value", i, j),
+						Value:    string(attrTypeAndValue.Value.Bytes),
+						Expected: "only a-z, A-Z, 0-9, space and ' = ( ) + , - . / : ?",
+						Status:   lint.Error,
+					})
+					details = append(details, fmt.Sprintf("RawSubject attr oid %s %s",
+						attrTypeAndValue.Type, err.Error()))
 				}
 			}
 		}
 	}
 
+	if len(findings) > 0 {
+		index, _ := util.NewCertificateDERIndex(c.Raw)
+		for i := range findings {
+			findings[i].Location = index.Locate("tbsCertificate." + findings[i].Path)
+		}
+		return &lint.LintResult{
+			Status:   lint.Error,
+			Details:  strings.Join(details, "; "),
+			Findings: findings,
+		}
+	}
 	return &lint.LintResult{
 		Status: lint.Pass,
 	}
@@ -38,6 +38,7 @@ func init() {
 			Description:   "The 'State Name' field of the subject MUST be less than 129 characters",
 			Citation:      "RFC 5280: A.1",
 			Source:        lint.RFC5280,
+			Remediation:   "Shorten the stateOrProvinceName of the subject to no more than 128 characters.",
 			EffectiveDate: util.RFC2459Date,
 		},
 		Lint: NewSubjectStateNameMaxLength,
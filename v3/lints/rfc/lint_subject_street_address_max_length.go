@@ -37,6 +37,7 @@ func init() {
 			Description:   "The 'StreetAddress' field of the subject MUST be less than 129 characters",
 			Citation:      "ITU-T X.520 (02/2001) UpperBounds",
 			Source:        lint.RFC5280,
+			Remediation:   "Shorten the streetAddress of the subject to no more than 128 characters.",
 			EffectiveDate: util.RFC2459Date,
 		},
 		Lint: NewSubjectStreetAddressMaxLength,
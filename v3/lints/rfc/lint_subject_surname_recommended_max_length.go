@@ -37,6 +37,7 @@ func init() {
 				"targeted this length, for compatibility purposes it may be prudent to limit surnames to this length.",
 			Citation:      "ITU-T Rec. X.411 (11/1988), Annex B Reference Definition of MTS Parameter Upper Bounds",
 			Source:        lint.RFC5280,
+			Remediation:   "Shorten the surname of the subject to no more than 64 characters.",
 			EffectiveDate: util.RFC2459Date,
 		},
 		Lint: NewSubjectSurnameRecommendedMaxLength,
@@ -30,6 +30,8 @@ func init() {
 			Description:   "RFC 5280 Section 4.2.1.3 describes the value of a KeyUsage to be a DER encoded BitString, which itself must not have unnecessary trailing 00 bytes.",
 			Citation:      "1.2.2 Where Rec. ITU-T X.680 | ISO/IEC 8824-1, 22.7, applies, the bitstring shall have all trailing 0 bits removed before it is encoded.",
 			Source:        lint.RFC5280,
+			Tags:          []string{"encoding"},
+			Remediation:   "Encode the keyUsage BIT STRING in DER, removing trailing zero octets and declaring the trailing zero bits as unused bits.",
 			EffectiveDate: util.ZeroDate,
 		},
 		Lint: func() lint.LintInterface { return &superfluousKuEncoding{} },
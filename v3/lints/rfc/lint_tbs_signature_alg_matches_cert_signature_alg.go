@@ -40,6 +40,7 @@ func init() {
 			Description:   "Certificate signature field must match TBSCertificate signature field",
 			Citation:      "RFC 5280, Section 4.1.1.2",
 			Source:        lint.RFC5280,
+			Remediation:   "Set the signatureAlgorithm of the certificate to exactly the signature field of the TBSCertificate, encoding both identically.",
 			EffectiveDate: util.RFC5280Date,
 		},
 		Lint: NewMismatchingSigAlg,
@@ -38,6 +38,8 @@ func init() {
 			Description:   "RSA: Encoded signature algorithm identifier MUST have NULL parameters",
 			Citation:      "RFC 4055, Section 5",
 			Source:        lint.RFC5280, // RFC4055 is referenced in RFC5280, Section 1
+			Tags:          []string{"encoding"},
+			Remediation:   "Encode the parameters of RSA PKCS #1 v1.5 signature algorithm identifiers as NULL.",
 			EffectiveDate: util.RFC5280Date,
 		},
 		Lint: NewRsaTBSSignatureEncryptionParamNotNULL,
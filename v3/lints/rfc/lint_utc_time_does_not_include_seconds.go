@@ -15,6 +15,7 @@ package rfc
  */
 
 import (
+	"github.com/zmap/zcrypto/encoding/asn1"
 	"github.com/zmap/zcrypto/x509"
 	"github.com/zmap/zlint/v3/lint"
 	"github.com/zmap/zlint/v3/util"
@@ -47,6 +48,8 @@ func init() {
 			Description:   "UTCTime values MUST include seconds",
 			Citation:      "RFC 5280: 4.1.2.5.1",
 			Source:        lint.RFC5280,
+			Tags:          []string{"encoding", "time"},
+			Remediation:   "Encode UTCTime values as YYMMDDHHMMSSZ, including the seconds even when they are zero.",
 			EffectiveDate: util.RFC2459Date,
 		},
 		Lint: NewUtcNoSecond,
@@ -68,17 +71,29 @@ func (l *utcNoSecond) CheckApplies(c *x509.Certificate) bool {
 func (l *utcNoSecond) Execute(c *x509.Certificate) *lint.LintResult {
 	date1, date2 := util.GetTimes(c)
 	beforeTag, afterTag := util.FindTimeType(date1, date2)
-	date1Utc := beforeTag == 23
-	date2Utc := afterTag == 23
-	if date1Utc {
-		if len(date1.Bytes) != 13 && len(date1.Bytes) != 17 {
-			return &lint.LintResult{Status: lint.Error}
+	var findings []lint.Finding
+	for _, date := range []struct {
+		name  string
+		tag   int
+		value asn1.RawValue
+	}{
+		{"notBefore", beforeTag, date1},
+		{"notAfter", afterTag, date2},
+	} {
+		if date.tag == 23 && len(date.value.Bytes) != 13 && len(date.value.Bytes) != 17 {
+			findings = append(findings, lint.Finding{
+				Path:     "validity." + date.name,
+				Value:    string(date.value.Bytes),
+				Expected: "a UTCTime of the form YYMMDDHHMMSSZ",
+				Status:   lint.Error,
+			})
 		}
 	}
-	if date2Utc {
-		if len(date2.Bytes) != 13 && len(date2.Bytes) != 17 {
-			return &lint.LintResult{Status: lint.Error}
+	if len(findings) > 0 {
+		index, _ := util.NewCertificateDERIndex(c.Raw)
+		for i := range findings {
+			findings[i].Location = index.Locate("tbsCertificate." + findings[i].Path)
 		}
 	}
-	return &lint.LintResult{Status: lint.Pass}
+	return lint.NewResultFromFindings(findings)
 }
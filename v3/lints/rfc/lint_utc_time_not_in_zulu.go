@@ -50,6 +50,8 @@ func init() {
 			Description:   "UTCTime values MUST be expressed in Greenwich Mean Time (Zulu)",
 			Citation:      "RFC 5280: 4.1.2.5.1",
 			Source:        lint.RFC5280,
+			Tags:          []string{"encoding", "time"},
+			Remediation:   "Encode UTCTime values in UTC, ending in \"Z\", rather than with an offset.",
 			EffectiveDate: util.RFC2459Date,
 		},
 		Lint: NewUtcTimeGMT,
@@ -40,6 +40,8 @@ func init() {
 			Description:   "Certificates valid through the year 2049 MUST be encoded in UTC time",
 			Citation:      "RFC 5280: 4.1.2.5",
 			Source:        lint.RFC5280,
+			Tags:          []string{"encoding", "time"},
+			Remediation:   "Encode validity times before 2050 as UTCTime rather than GeneralizedTime.",
 			EffectiveDate: util.RFC2459Date,
 		},
 		Lint: NewGeneralizedPre2050,
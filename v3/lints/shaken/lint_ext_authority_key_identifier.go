@@ -47,6 +47,8 @@ func init() {
 			Description:   description,
 			Citation:      ATIS1000080v003_STI_Citation,
 			Source:        lint.ATIS1000080,
+			Tags:          []string{"sti"},
+			Remediation:   "Include an authorityKeyIdentifier extension holding the subject key identifier of the issuing STI-CA certificate.",
 			EffectiveDate: util.ATIS1000080_v003_Leaf_Date,
 		},
 		Lint: NewAuthorityKeyIdentifierLeaf,
@@ -58,6 +60,8 @@ func init() {
 			Description:   description,
 			Citation:      ATIS1000080v003_STI_Citation,
 			Source:        lint.ATIS1000080,
+			Tags:          []string{"sti"},
+			Remediation:   "Include an authorityKeyIdentifier extension holding the subject key identifier of the issuing STI-CA certificate.",
 			EffectiveDate: util.ATIS1000080_v003_Date,
 		},
 		Lint: NewAuthorityKeyIdentifierCA,
@@ -47,6 +47,8 @@ func init() {
 			Description:   description,
 			Citation:      ATIS1000080v003_STI_Citation,
 			Source:        lint.ATIS1000080,
+			Tags:          []string{"sti"},
+			Remediation:   "Set the keyIdentifier of the authorityKeyIdentifier of the root certificate to its own subject key identifier, or omit the extension.",
 			EffectiveDate: util.ATIS1000080_v003_Date,
 		},
 		Lint: NewAuthorityKeyIdentifierRoot,
@@ -36,6 +36,8 @@ func init() {
 			Description:   description,
 			Citation:      ATIS1000080v003_STI_Citation,
 			Source:        lint.ATIS1000080,
+			Tags:          []string{"sti"},
+			Remediation:   "Include a certificatePolicies extension holding only the OID of the SHAKEN Certificate Policy established by the STI-PA.",
 			EffectiveDate: util.ATIS1000080_v003_Leaf_Date,
 		},
 		Lint: NewCertificatePoliciesLeaf,
@@ -47,6 +49,8 @@ func init() {
 			Description:   description,
 			Citation:      ATIS1000080v003_STI_Citation,
 			Source:        lint.ATIS1000080,
+			Tags:          []string{"sti"},
+			Remediation:   "Include a certificatePolicies extension holding only the OID of the SHAKEN Certificate Policy established by the STI-PA.",
 			EffectiveDate: util.ATIS1000080_v003_Date,
 		},
 		Lint: NewCertificatePoliciesCA,
@@ -31,6 +31,8 @@ func init() {
 			Description:   description,
 			Citation:      United_States_SHAKEN_CPv1_3_Citation_1_3,
 			Source:        lint.UnitedStatesSHAKENCP,
+			Tags:          []string{"sti"},
+			Remediation:   "Set the certificatePolicies extension to only the OID of the SHAKEN Certificate Policy established by the STI-PA.",
 			EffectiveDate: util.UnitedStatesSHAKENCPv1_3_Leaf_Date,
 		},
 		Lint: NewCertificatePoliciesIdLeaf,
@@ -41,6 +43,8 @@ func init() {
 			Description:   description,
 			Citation:      United_States_SHAKEN_CPv1_3_Citation_1_3,
 			Source:        lint.UnitedStatesSHAKENCP,
+			Tags:          []string{"sti"},
+			Remediation:   "Set the certificatePolicies extension to only the OID of the SHAKEN Certificate Policy established by the STI-PA.",
 			EffectiveDate: util.UnitedStatesSHAKENCPv1_3_Date,
 		},
 		Lint: NewCertificatePoliciesIdCA,
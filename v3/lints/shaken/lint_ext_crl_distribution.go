@@ -43,6 +43,8 @@ func init() {
 			Description:   description,
 			Citation:      ATIS1000080v003_STI_Citation,
 			Source:        lint.ATIS1000080,
+			Tags:          []string{"sti"},
+			Remediation:   "Include a cRLDistributionPoints extension with a single DistributionPoint locating the SHAKEN CRL.",
 			EffectiveDate: util.ATIS1000080_v003_Leaf_Date,
 		},
 		Lint: NewCrlDistributionLeaf,
@@ -54,6 +56,8 @@ func init() {
 			Description:   description,
 			Citation:      ATIS1000080v003_STI_Citation,
 			Source:        lint.ATIS1000080,
+			Tags:          []string{"sti"},
+			Remediation:   "Include a cRLDistributionPoints extension with a single DistributionPoint locating the SHAKEN CRL.",
 			EffectiveDate: util.ATIS1000080_v003_Date,
 		},
 		Lint: NewCrlDistributionCA,
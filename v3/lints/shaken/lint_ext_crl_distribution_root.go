@@ -37,6 +37,8 @@ func init() {
 			Description:   description,
 			Citation:      ATIS1000080v005_STI_Citation,
 			Source:        lint.ATIS1000080,
+			Tags:          []string{"sti"},
+			Remediation:   "Remove the cRLDistributionPoints extension from STI root certificates.",
 			EffectiveDate: util.ATIS1000080_v005_Date,
 		},
 		Lint: NewCrlDistributionRoot,
@@ -42,6 +42,8 @@ func init() {
 			Description:   description,
 			Citation:      ATIS1000080v004_STI_Citation,
 			Source:        lint.ATIS1000080,
+			Tags:          []string{"sti"},
+			Remediation:   "Give the single DistributionPoint of the cRLDistributionPoints extension a distributionPoint with the HTTP URL of the SHAKEN CRL hosted by the STI-PA and a cRLIssuer naming the issuer of that CRL.",
 			EffectiveDate: util.ATIS1000080_v004_Leaf_Date,
 		},
 		Lint: NewCrlDistributionStructLeaf,
@@ -53,6 +55,8 @@ func init() {
 			Description:   description,
 			Citation:      ATIS1000080v004_STI_Citation,
 			Source:        lint.ATIS1000080,
+			Tags:          []string{"sti"},
+			Remediation:   "Give the single DistributionPoint of the cRLDistributionPoints extension a distributionPoint with the HTTP URL of the SHAKEN CRL hosted by the STI-PA and a cRLIssuer naming the issuer of that CRL.",
 			EffectiveDate: util.ATIS1000080_v004_Date,
 		},
 		Lint: NewCrlDistributionStructCA,
@@ -37,6 +37,8 @@ func init() {
 			Description:   "STI certificates shall contain a Key Usage extension marked as critical.",
 			Citation:      ATIS1000080v003_STI_Citation,
 			Source:        lint.ATIS1000080,
+			Tags:          []string{"sti"},
+			Remediation:   "Include a keyUsage extension marked critical in STI certificates.",
 			EffectiveDate: util.ATIS1000080_v003_Date,
 		},
 		Lint: NewKeyUsage,
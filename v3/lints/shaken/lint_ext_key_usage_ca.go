@@ -37,6 +37,8 @@ func init() {
 			Description:   "The Key Usage extension for STI root and intermediate certificates shall contain a single key usage value of keyCertSign (5) and may contain the key usage values digitalSignature (0) and/or cRLSign (6).",
 			Citation:      ATIS1000080v003_STI_Citation,
 			Source:        lint.ATIS1000080,
+			Tags:          []string{"sti"},
+			Remediation:   "Set the keyUsage of STI root and intermediate certificates to keyCertSign, optionally with digitalSignature and cRLSign.",
 			EffectiveDate: util.ATIS1000080_v003_Date,
 		},
 		Lint: NewKeyUsageCa,
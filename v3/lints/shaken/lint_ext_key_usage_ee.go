@@ -37,6 +37,8 @@ func init() {
 			Description:   "The Key Usage extension for STI end-entity certificates shall contain a single key usage value of digitalSignature (0).",
 			Citation:      ATIS1000080v003_STI_Citation,
 			Source:        lint.ATIS1000080,
+			Tags:          []string{"sti"},
+			Remediation:   "Set the keyUsage of STI end-entity certificates to digitalSignature alone.",
 			EffectiveDate: util.ATIS1000080_v003_Leaf_Date,
 		},
 		Lint: NewKeyUsageEe,
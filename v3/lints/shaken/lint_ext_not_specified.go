@@ -37,6 +37,8 @@ func init() {
 			Description:   description,
 			Citation:      ATIS1000080v005_STI_Citation,
 			Source:        lint.ATIS1000080,
+			Tags:          []string{"sti"},
+			Remediation:   "Remove the extensions that are not specified by ATIS-1000080 from the STI certificate profile.",
 			EffectiveDate: util.ATIS1000080_v005_Leaf_Date,
 		},
 		Lint: NewExtNotSpecifiedLeaf,
@@ -48,6 +50,8 @@ func init() {
 			Description:   description,
 			Citation:      ATIS1000080v005_STI_Citation,
 			Source:        lint.ATIS1000080,
+			Tags:          []string{"sti"},
+			Remediation:   "Remove the extensions that are not specified by ATIS-1000080 from the STI CA certificate profile.",
 			EffectiveDate: util.ATIS1000080_v005_Date,
 		},
 		Lint: NewExtNotSpecifiedCA,
@@ -38,6 +38,8 @@ func init() {
 			Description:   subjectKeyIdentifier_details,
 			Citation:      ATIS1000080v003_STI_Citation,
 			Source:        lint.ATIS1000080,
+			Tags:          []string{"sti"},
+			Remediation:   "Include a subjectKeyIdentifier extension identifying the public key of the certificate.",
 			EffectiveDate: util.ATIS1000080_v003_Leaf_Date,
 		},
 		Lint: NewSubjectKeyIdentifierLeaf,
@@ -49,6 +51,8 @@ func init() {
 			Description:   subjectKeyIdentifier_details,
 			Citation:      ATIS1000080v003_STI_Citation,
 			Source:        lint.ATIS1000080,
+			Tags:          []string{"sti"},
+			Remediation:   "Include a subjectKeyIdentifier extension identifying the public key of the certificate.",
 			EffectiveDate: util.ATIS1000080_v003_Date,
 		},
 		Lint: NewSubjectKeyIdentifierCA,
@@ -41,6 +41,8 @@ func init() {
 			Description:   description,
 			Citation:      ATIS1000080v004_STI_Citation,
 			Source:        lint.ATIS1000080,
+			Tags:          []string{"sti"},
+			Remediation:   "Set the subjectKeyIdentifier to the 160-bit SHA-1 hash of the subjectPublicKey.",
 			EffectiveDate: util.ATIS1000080_v004_Leaf_Date,
 		},
 		Lint: NewSubjectKeyIdentifierSizeLeaf,
@@ -52,6 +54,8 @@ func init() {
 			Description:   description,
 			Citation:      ATIS1000080v004_STI_Citation,
 			Source:        lint.ATIS1000080,
+			Tags:          []string{"sti"},
+			Remediation:   "Set the subjectKeyIdentifier to the 160-bit SHA-1 hash of the subjectPublicKey.",
 			EffectiveDate: util.ATIS1000080_v004_Date,
 		},
 		Lint: NewSubjectKeyIdentifierSizeCA,
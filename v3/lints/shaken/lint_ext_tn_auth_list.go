@@ -31,6 +31,8 @@ func init() {
 			Description:   "STI End-Entity certificates shall contain a TNAuthList extension as specified in RFC 8226. The TNAuthList shall contain a single SPC value",
 			Citation:      ATIS1000080v003_STI_Citation,
 			Source:        lint.ATIS1000080,
+			Tags:          []string{"sti"},
+			Remediation:   "Include a TNAuthList extension holding the single SPC of the service provider in STI end-entity certificates.",
 			EffectiveDate: util.ATIS1000080_v003_Leaf_Date,
 		},
 		Lint: NewTnAuthList,
@@ -63,6 +65,15 @@ func (*tnAuthList) Execute(c *x509.Certificate) *lint.LintResult {
 		}
 	}
 
+	// Lints which depend upon this one are skipped if the extension can not
+	// be parsed at all.
+	if _, err := ParseTNAuthorizationList(ext.Value); err != nil {
+		return &lint.LintResult{
+			Status:  lint.Fatal,
+			Details: err.Error(),
+		}
+	}
+
 	_, err := GetTNEntrySPC(c)
 	if err != nil {
 		return &lint.LintResult{
@@ -31,6 +31,8 @@ func init() {
 			Description:   "STI intermediate and root certificates shall not contain a TNAuthList extension",
 			Citation:      ATIS1000080v003_STI_Citation,
 			Source:        lint.ATIS1000080,
+			Tags:          []string{"sti"},
+			Remediation:   "Remove the TNAuthList extension from STI root and intermediate certificates.",
 			EffectiveDate: util.ATIS1000080_v003_Date,
 		},
 		Lint: NewTnAuthListCa,
@@ -34,7 +34,10 @@ func init() {
 			Description:   "The SPC value in the TNAuthList extension shall contain only numbers and uppercase letters",
 			Citation:      ATIS1000080v005_STI_Citation,
 			Source:        lint.ATIS1000080,
+			Tags:          []string{"sti"},
+			Remediation:   "Write the SPC of the TNAuthList extension using only digits and uppercase letters.",
 			EffectiveDate: util.ATIS1000080_v005_Leaf_Date,
+			Prerequisites: []string{"e_atis_tn_auth_list"},
 		},
 		Lint: NewTnAuthListSpcFormat,
 	})
@@ -84,6 +84,29 @@ func Test_ExtTnAuthList(t *testing.T) {
 				Details: "the TNAuthList extension is marked as critical",
 			},
 		},
+		{
+			name: "e_atis_tn_auth_list malformed",
+			args: args{
+				lintName: "e_atis_tn_auth_list",
+				cert: &x509.Certificate{
+					NotBefore:  util.ATIS1000080_v003_Leaf_Date,
+					IsCA:       false,
+					SelfSigned: false,
+					ExtensionsMap: map[string]pkix.Extension{
+						util.TNAuthListOID.String(): {
+							Id:       util.TNAuthListOID,
+							Critical: false,
+							Value:    []byte{0x30, 0x01},
+						},
+					},
+				},
+				config: lint.NewEmptyConfig(),
+			},
+			want: &lint.LintResult{
+				Status:  lint.Fatal,
+				Details: "bad TNAuthorizationList ASN.1 raw, asn1: syntax error: data truncated",
+			},
+		},
 		{
 			name: "e_atis_tn_auth_list intermediate",
 			args: args{
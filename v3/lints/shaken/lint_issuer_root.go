@@ -33,6 +33,8 @@ func init() {
 			Description:   description,
 			Citation:      ATIS1000080v003_STI_Citation,
 			Source:        lint.ATIS1000080,
+			Tags:          []string{"sti"},
+			Remediation:   "Set the issuer of the root certificate to exactly its subject.",
 			EffectiveDate: util.ATIS1000080_v003_Date,
 		},
 		Lint: NewIssuerRoot,
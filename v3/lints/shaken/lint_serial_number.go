@@ -37,6 +37,8 @@ func init() {
 			Description:   description,
 			Citation:      ATIS1000080v003_STI_Citation,
 			Source:        lint.ATIS1000080,
+			Tags:          []string{"sti"},
+			Remediation:   "Include a positive serial number in STI certificates.",
 			EffectiveDate: util.ATIS1000080_v003_Leaf_Date,
 		},
 		Lint: NewSerialNumberLeaf,
@@ -47,6 +49,8 @@ func init() {
 			Description:   description,
 			Citation:      ATIS1000080v003_STI_Citation,
 			Source:        lint.ATIS1000080,
+			Tags:          []string{"sti"},
+			Remediation:   "Include a positive serial number in STI CA certificates.",
 			EffectiveDate: util.ATIS1000080_v003_Date,
 		},
 		Lint: NewSerialNumberCA,
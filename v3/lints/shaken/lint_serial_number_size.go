@@ -37,6 +37,8 @@ func init() {
 			Description:   description,
 			Citation:      ATIS1000080v005_STI_Citation,
 			Source:        lint.ATIS1000080,
+			Tags:          []string{"sti"},
+			Remediation:   "Generate serial numbers with at least 64 bits, e.g. from a cryptographically secure random number generator.",
 			EffectiveDate: util.ATIS1000080_v005_Leaf_Date,
 		},
 		Lint: NewSerialNumberSizeLeaf,
@@ -48,6 +50,8 @@ func init() {
 			Description:   description,
 			Citation:      "ATIS-1000080.v005",
 			Source:        lint.ATIS1000080,
+			Tags:          []string{"sti"},
+			Remediation:   "Generate serial numbers with at least 64 bits, e.g. from a cryptographically secure random number generator.",
 			EffectiveDate: util.ATIS1000080_v005_Date,
 		},
 		Lint: NewSerialNumberSizeCA,
@@ -40,6 +40,8 @@ func init() {
 			Description:   signatureAlgorithm_details,
 			Citation:      ATIS1000080v003_STI_Citation,
 			Source:        lint.ATIS1000080,
+			Tags:          []string{"sti"},
+			Remediation:   "Sign STI certificates with ecdsa-with-SHA256.",
 			EffectiveDate: util.ATIS1000080_v003_Leaf_Date,
 		},
 		Lint: NewSignatureAlgorithmLeaf,
@@ -51,6 +53,8 @@ func init() {
 			Description:   signatureAlgorithm_details,
 			Citation:      ATIS1000080v003_STI_Citation,
 			Source:        lint.ATIS1000080,
+			Tags:          []string{"sti"},
+			Remediation:   "Sign STI CA certificates with ecdsa-with-SHA256.",
 			EffectiveDate: util.ATIS1000080_v003_Date,
 		},
 		Lint: NewSignatureAlgorithmCA,
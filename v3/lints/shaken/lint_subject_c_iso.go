@@ -65,6 +65,8 @@ func init() {
 			Description:   description,
 			Citation:      ATIS1000080v005_STI_Citation,
 			Source:        lint.ATIS1000080,
+			Tags:          []string{"sti"},
+			Remediation:   "Set the countryName of the subject to an ISO 3166-1 alpha-2 country code, e.g. \"US\".",
 			EffectiveDate: util.ATIS1000080_v005_Leaf_Date,
 		},
 		Lint: NewSubjectCountryIsoLeaf,
@@ -76,6 +78,8 @@ func init() {
 			Description:   description,
 			Citation:      ATIS1000080v005_STI_Citation,
 			Source:        lint.ATIS1000080,
+			Tags:          []string{"sti"},
+			Remediation:   "Set the countryName of the subject to an ISO 3166-1 alpha-2 country code, e.g. \"US\".",
 			EffectiveDate: util.ATIS1000080_v005_Date,
 		},
 		Lint: NewSubjectCountryIsoCA,
@@ -22,6 +22,8 @@ func init() {
 			Description:   "Subject MUST contain a Country (C=) of \"US\".",
 			Citation:      United_States_SHAKEN_CPv1_4_Citation_3_1,
 			Source:        lint.UnitedStatesSHAKENCP,
+			Tags:          []string{"sti"},
+			Remediation:   "Set the countryName of the subject to \"US\".",
 			EffectiveDate: util.UnitedStatesSHAKENCPv1_4_Leaf_Date,
 		},
 		Lint: NewSubjectCUsLeaf,
@@ -32,6 +34,8 @@ func init() {
 			Description:   "Subject MUST contain a Country (C=) of \"US\".",
 			Citation:      "ATIS-1000080",
 			Source:        lint.UnitedStatesSHAKENCP,
+			Tags:          []string{"sti"},
+			Remediation:   "Set the countryName of the subject to \"US\".",
 			EffectiveDate: util.UnitedStatesSHAKENCPv1_4_Date,
 		},
 		Lint: NewSubjectCUsCA,
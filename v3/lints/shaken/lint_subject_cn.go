@@ -65,6 +65,8 @@ func init() {
 			Description:   description,
 			Citation:      ATIS1000080v003_STI_Citation,
 			Source:        lint.ATIS1000080,
+			Tags:          []string{"sti"},
+			Remediation:   "Include the text \"SHAKEN\" in the commonName of the subject.",
 			EffectiveDate: util.ATIS1000080_v003_Leaf_Date,
 		},
 		Lint: NewSubjectCNLeaf,
@@ -76,6 +78,8 @@ func init() {
 			Description:   description,
 			Citation:      ATIS1000080v003_STI_Citation,
 			Source:        lint.ATIS1000080,
+			Tags:          []string{"sti"},
+			Remediation:   "Include the text \"SHAKEN\" in the commonName of the subject.",
 			EffectiveDate: util.ATIS1000080_v003_Date,
 		},
 		Lint: NewSubjectCNCA,
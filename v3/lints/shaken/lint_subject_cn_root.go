@@ -63,6 +63,8 @@ func init() {
 			Description:   description,
 			Citation:      ATIS1000080v005_STI_Citation,
 			Source:        lint.ATIS1000080,
+			Tags:          []string{"sti"},
+			Remediation:   "Include the text \"ROOT\" in the commonName of the subject of STI root certificates.",
 			EffectiveDate: util.ATIS1000080_v005_Date,
 		},
 		Lint: NewSubjectCnRoot,
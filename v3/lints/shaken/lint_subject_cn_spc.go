@@ -63,7 +63,10 @@ func init() {
 			Description:   description,
 			Citation:      ATIS1000080v004_STI_Citation,
 			Source:        lint.ATIS1000080,
+			Tags:          []string{"sti"},
+			Remediation:   "Set the commonName of end-entity certificates to \"SHAKEN\", a single space and the SPC value of the TNAuthList extension, e.g. \"SHAKEN 1234\".",
 			EffectiveDate: util.ATIS1000080_v004_Leaf_Date,
+			Prerequisites: []string{"e_atis_tn_auth_list"},
 		},
 		Lint: NewSubjectCnSpc,
 	})
@@ -63,6 +63,8 @@ func init() {
 			Description:   description,
 			Citation:      ATIS1000080v003_STI_Citation,
 			Source:        lint.ATIS1000080,
+			Tags:          []string{"sti"},
+			Remediation:   "Include a countryName and a commonName in the subject of STI certificates.",
 			EffectiveDate: util.ATIS1000080_v003_Leaf_Date,
 		},
 		Lint: NewSubjectDNLeaf,
@@ -74,6 +76,8 @@ func init() {
 			Description:   description,
 			Citation:      ATIS1000080v003_STI_Citation,
 			Source:        lint.ATIS1000080,
+			Tags:          []string{"sti"},
+			Remediation:   "Include a countryName and a commonName in the subject of STI CA certificates.",
 			EffectiveDate: util.ATIS1000080_v003_Date,
 		},
 		Lint: NewSubjectDNCA,
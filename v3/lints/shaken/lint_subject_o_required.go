@@ -62,6 +62,8 @@ func init() {
 			Description:   description,
 			Citation:      ATIS1000080v004_STI_Citation,
 			Source:        lint.ATIS1000080,
+			Tags:          []string{"sti"},
+			Remediation:   "Include the organizationName of the service provider in the subject.",
 			EffectiveDate: util.ATIS1000080_v004_Leaf_Date,
 		},
 		Lint: NewSubjectOrgRequiredLeaf,
@@ -73,6 +75,8 @@ func init() {
 			Description:   description,
 			Citation:      ATIS1000080v004_STI_Citation,
 			Source:        lint.ATIS1000080,
+			Tags:          []string{"sti"},
+			Remediation:   "Include the organizationName of the STI-CA in the subject.",
 			EffectiveDate: util.ATIS1000080_v004_Date,
 		},
 		Lint: NewSubjectOrgRequiredCA,
@@ -46,6 +46,8 @@ func init() {
 			Description:   subjectPublicKey_details,
 			Citation:      ATIS1000080v003_STI_Citation,
 			Source:        lint.ATIS1000080,
+			Tags:          []string{"key", "sti"},
+			Remediation:   "Certify only P-256 keys, using the id-ecPublicKey algorithm, in STI certificates.",
 			EffectiveDate: util.ATIS1000080_v003_Leaf_Date,
 		},
 		Lint: NewSubjectPublicKeyLeaf,
@@ -56,6 +58,8 @@ func init() {
 			Description:   subjectPublicKey_details,
 			Citation:      ATIS1000080v003_STI_Citation,
 			Source:        lint.ATIS1000080,
+			Tags:          []string{"key", "sti"},
+			Remediation:   "Certify only P-256 keys, using the id-ecPublicKey algorithm, in STI CA certificates.",
 			EffectiveDate: util.ATIS1000080_v003_Date,
 		},
 		Lint: NewSubjectPublicKeyCA,
@@ -24,6 +24,8 @@ func init() {
 			Description:   description,
 			Citation:      United_States_SHAKEN_CPv1_4_Citation_3_1,
 			Source:        lint.UnitedStatesSHAKENCP,
+			Tags:          []string{"sti"},
+			Remediation:   "No change is required; a serialNumber attribute may be included in the subject.",
 			EffectiveDate: util.UnitedStatesSHAKENCPv1_4_Leaf_Date,
 		},
 		Lint: NewSubjectSnMayLeaf,
@@ -34,6 +36,8 @@ func init() {
 			Description:   description,
 			Citation:      United_States_SHAKEN_CPv1_4_Citation_3_1,
 			Source:        lint.UnitedStatesSHAKENCP,
+			Tags:          []string{"sti"},
+			Remediation:   "No change is required; a serialNumber attribute may be included in the subject.",
 			EffectiveDate: util.UnitedStatesSHAKENCPv1_4_Date,
 		},
 		Lint: NewSubjectSnMayCA,
@@ -25,6 +25,8 @@ func init() {
 			Description:   description,
 			Citation:      United_States_SHAKEN_CPv1_3_Citation_3_1,
 			Source:        lint.UnitedStatesSHAKENCP,
+			Tags:          []string{"sti"},
+			Remediation:   "Include a serialNumber attribute in the subject.",
 			EffectiveDate: util.UnitedStatesSHAKENCPv1_3_Leaf_Date,
 		},
 		Lint: NewSubjectSnShallLeaf,
@@ -35,6 +37,8 @@ func init() {
 			Description:   description,
 			Citation:      United_States_SHAKEN_CPv1_3_Citation_3_1,
 			Source:        lint.UnitedStatesSHAKENCP,
+			Tags:          []string{"sti"},
+			Remediation:   "Include a serialNumber attribute in the subject.",
 			EffectiveDate: util.UnitedStatesSHAKENCPv1_3_Date,
 		},
 		Lint: NewSubjectSnShallCA,
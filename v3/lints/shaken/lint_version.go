@@ -32,6 +32,8 @@ func init() {
 			Description:   version_details,
 			Citation:      ATIS1000080v003_STI_Citation,
 			Source:        lint.ATIS1000080,
+			Tags:          []string{"sti"},
+			Remediation:   "Issue STI certificates as version 3 certificates.",
 			EffectiveDate: util.ATIS1000080_v003_Leaf_Date,
 		},
 		Lint: NewVersionLeaf,
@@ -42,6 +44,8 @@ func init() {
 			Description:   version_details,
 			Citation:      ATIS1000080v003_STI_Citation,
 			Source:        lint.ATIS1000080,
+			Tags:          []string{"sti"},
+			Remediation:   "Issue STI CA certificates as version 3 certificates.",
 			EffectiveDate: util.ATIS1000080_v003_Date,
 		},
 		Lint: NewVersionCA,
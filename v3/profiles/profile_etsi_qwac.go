@@ -0,0 +1,30 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package profiles
+
+import "github.com/zmap/zlint/v3/lint"
+
+func init() {
+	register(definition{
+		profile: lint.Profile{
+			Name:        "etsi_qwac",
+			Description: "Qualified website authentication certificates (QWACs)",
+			Citation:    "ETSI EN 319 412-4 and ETSI EN 319 412-5",
+			Source:      lint.EtsiEsi,
+		},
+		sources: append(lint.SourceList{lint.EtsiEsi}, webPKISources...),
+		exclude: [][]string{caLints, rootCALints},
+	})
+}
@@ -0,0 +1,36 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package profiles
+
+import "github.com/zmap/zlint/v3/lint"
+
+func init() {
+	register(definition{
+		profile: lint.Profile{
+			Name:        "smime_multipurpose",
+			Description: "Multipurpose S/MIME subscriber certificates",
+			Citation:    "CA/Browser Forum S/MIME Baseline Requirements: 7.1",
+			Source:      lint.CABFSMIMEBaselineRequirements,
+		},
+		sources: smimeSources,
+		lints: append([]string{
+			"e_adobe_extensions_legacy_multipurpose_criticality",
+			"e_rsa_key_usage_legacy_multipurpose",
+			"w_smime_strict_aia_contains_internal_names",
+			"e_smime_legacy_multipurpose_eku_check",
+		}, smimeLints...),
+		exclude: [][]string{caLints, rootCALints},
+	})
+}
@@ -0,0 +1,54 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package profiles
+
+import "github.com/zmap/zlint/v3/lint"
+
+func init() {
+	register(definition{
+		profile: lint.Profile{
+			Name:        "sti_end_entity",
+			Description: "STIR/SHAKEN end-entity certificates",
+			Citation:    "ATIS-1000080 and the SHAKEN Certificate Policy",
+			Source:      lint.ATIS1000080,
+		},
+		lints: concat(stiLints, []string{
+			"e_atis_ext_authority_key_identifier",
+			"e_atis_ext_certificate_policies",
+			"e_atis_ext_crl_distribution",
+			"e_atis_ext_crl_distribution_struct",
+			"e_atis_ext_key_usage_ee",
+			"e_atis_ext_not_specified",
+			"e_atis_subject_key_identifier",
+			"e_atis_subject_key_identifier_size",
+			"e_atis_tn_auth_list",
+			"e_atis_tn_auth_list_spc_format",
+			"e_atis_serial_number",
+			"e_atis_serial_number_size",
+			"e_atis_signature_algorithm",
+			"e_atis_subject_c_iso",
+			"e_atis_subject_c_us",
+			"e_atis_subject_cn",
+			"e_atis_subject_cn_spc",
+			"e_atis_subject_dn",
+			"e_atis_subject_o_required",
+			"e_atis_subject_public_key",
+			"e_atis_version",
+			"e_shaken_certificate_policies_id",
+			"e_us_cp_subject_sn_may",
+			"e_us_cp_subject_sn_shall",
+		}),
+	})
+}
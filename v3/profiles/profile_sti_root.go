@@ -0,0 +1,35 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package profiles
+
+import "github.com/zmap/zlint/v3/lint"
+
+func init() {
+	register(definition{
+		profile: lint.Profile{
+			Name:        "sti_root",
+			Description: "STIR/SHAKEN root certificates",
+			Citation:    "ATIS-1000080 and the SHAKEN Certificate Policy",
+			Source:      lint.ATIS1000080,
+		},
+		lints: concat(stiLints, stiCALints, []string{
+			"e_atis_ext_authority_key_identifier_root",
+			"e_atis_ext_certificate_policies_root",
+			"e_atis_ext_crl_distribution_root",
+			"e_atis_subject_cn_root",
+			"e_issuer_root",
+		}),
+	})
+}
@@ -0,0 +1,30 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package profiles
+
+import "github.com/zmap/zlint/v3/lint"
+
+func init() {
+	register(definition{
+		profile: lint.Profile{
+			Name:        "tls_subscriber",
+			Description: "Publicly trusted TLS subscriber certificates",
+			Citation:    "CA/Browser Forum Baseline Requirements and EV Guidelines, RFC 5280 and root store policies",
+			Source:      lint.CABFBaselineRequirements,
+		},
+		sources: webPKISources,
+		exclude: [][]string{caLints, rootCALints},
+	})
+}
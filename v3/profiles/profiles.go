@@ -0,0 +1,273 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+// Package profiles registers the built-in lint profiles. Importing it (e.g.
+// with a blank import) makes the profiles available via lint.GetProfile and
+// lint.AllProfiles.
+package profiles
+
+import (
+	"sort"
+
+	"github.com/zmap/zlint/v3/lint"
+
+	_ "github.com/zmap/zlint/v3/lints/apple"
+	_ "github.com/zmap/zlint/v3/lints/cabf_br"
+	_ "github.com/zmap/zlint/v3/lints/cabf_ev"
+	_ "github.com/zmap/zlint/v3/lints/cabf_smime_br"
+	_ "github.com/zmap/zlint/v3/lints/community"
+	_ "github.com/zmap/zlint/v3/lints/etsi"
+	_ "github.com/zmap/zlint/v3/lints/mozilla"
+	_ "github.com/zmap/zlint/v3/lints/rfc"
+	_ "github.com/zmap/zlint/v3/lints/shaken"
+)
+
+// definition describes a built-in profile. Its lints are every certificate,
+// chain and precertificate pair lint from its sources, plus its lints, minus
+// its exclusions.
+//
+// Lints check whether they apply to a given certificate themselves, so an
+// irrelevant lint within a profile merely returns NA. Exclusions are therefore
+// limited to lints which plainly concern a different kind of certificate.
+type definition struct {
+	profile lint.Profile
+	sources lint.SourceList
+	lints   []string
+	exclude [][]string
+}
+
+// resolve returns the profile described by d, drawing lints from registry.
+func (d definition) resolve(registry lint.Registry) lint.Profile {
+	names := make(map[string]bool)
+	for _, source := range d.sources {
+		for _, l := range registry.CertificateLints().BySource(source) {
+			names[l.Name] = true
+		}
+		for _, l := range registry.ChainLints().BySource(source) {
+			names[l.Name] = true
+		}
+		for _, l := range registry.PrecertificatePairLints().BySource(source) {
+			names[l.Name] = true
+		}
+	}
+	for _, name := range d.lints {
+		names[name] = true
+	}
+	for _, exclusions := range d.exclude {
+		for _, name := range exclusions {
+			delete(names, name)
+		}
+	}
+	profile := d.profile
+	profile.LintNames = make([]string, 0, len(names))
+	for name := range names {
+		profile.LintNames = append(profile.LintNames, name)
+	}
+	sort.Strings(profile.LintNames)
+	return profile
+}
+
+// webPKISources are the sources of the lints for publicly trusted TLS
+// certificates.
+var webPKISources = lint.SourceList{
+	lint.RFC3279,
+	lint.RFC5280,
+	lint.RFC5480,
+	lint.RFC5891,
+	lint.RFC6962,
+	lint.RFC8813,
+	lint.CABFBaselineRequirements,
+	lint.CABFEVGuidelines,
+	lint.MozillaRootStorePolicy,
+	lint.AppleRootStorePolicy,
+	lint.Community,
+}
+
+// smimeSources are the sources of the general lints for S/MIME certificates,
+// in addition to those of the S/MIME Baseline Requirements which are chosen
+// by generation.
+var smimeSources = lint.SourceList{
+	lint.RFC3279,
+	lint.RFC5280,
+	lint.RFC5480,
+	lint.RFC8813,
+	lint.MozillaRootStorePolicy,
+}
+
+// caLints are the lints which only concern CA certificates.
+var caLints = []string{
+	"e_ca_common_name_missing",
+	"e_ca_country_name_invalid",
+	"e_ca_country_name_missing",
+	"e_ca_crl_sign_not_set",
+	"n_ca_digital_signature_not_set",
+	"e_ca_is_ca",
+	"e_ca_key_cert_sign_not_set",
+	"e_ca_key_usage_missing",
+	"e_ca_key_usage_not_critical",
+	"e_ca_organization_name_missing",
+	"e_ca_subject_field_empty",
+	"e_ext_subject_key_identifier_missing_ca",
+	"e_rsa_allowed_ku_ca",
+	"e_rsa_allowed_ku_no_encipherment_ca",
+	"e_sub_ca_aia_missing",
+	"w_sub_ca_aia_missing",
+	"w_sub_ca_aia_does_not_contain_issuing_ca_url",
+	"e_sub_ca_aia_marked_critical",
+	"w_sub_ca_certificate_policies_marked_critical",
+	"e_sub_ca_certificate_policies_missing",
+	"e_sub_ca_crl_distribution_points_does_not_contain_url",
+	"e_sub_ca_crl_distribution_points_marked_critical",
+	"e_sub_ca_crl_distribution_points_missing",
+	"w_sub_ca_eku_critical",
+	"n_sub_ca_eku_missing",
+	"n_sub_ca_eku_not_technically_constrained",
+	"w_sub_ca_name_constraints_not_critical",
+	"e_old_sub_ca_rsa_mod_less_than_1024_bits",
+}
+
+// rootCALints are the lints which only concern root CA certificates.
+var rootCALints = []string{
+	"e_old_root_ca_rsa_mod_less_than_2048_bits",
+	"w_root_ca_basic_constraints_path_len_constraint_field_present",
+	"w_root_ca_contains_cert_policy",
+	"e_root_ca_extended_key_usage_present",
+	"e_root_ca_key_usage_must_be_critical",
+	"e_root_ca_key_usage_present",
+}
+
+// tlsSubscriberLints are the lints which only concern TLS subscriber
+// certificates.
+var tlsSubscriberLints = []string{
+	"e_cab_dv_conflicts_with_locality",
+	"e_cab_dv_conflicts_with_org",
+	"e_cab_dv_conflicts_with_postal",
+	"e_cab_dv_conflicts_with_province",
+	"e_cab_dv_conflicts_with_street",
+	"e_cab_iv_requires_personal_name",
+	"e_cab_ov_requires_org",
+	"e_cert_policy_iv_requires_country",
+	"e_cert_policy_iv_requires_province_or_locality",
+	"e_cert_policy_ov_requires_country",
+	"e_cert_policy_ov_requires_province_or_locality",
+	"e_old_sub_cert_rsa_mod_less_than_1024_bits",
+	"w_sub_cert_aia_contains_internal_names",
+	"w_sub_cert_aia_does_not_contain_issuing_ca_url",
+	"e_sub_cert_aia_does_not_contain_ocsp_url",
+	"e_sub_cert_aia_marked_critical",
+	"e_sub_cert_aia_missing",
+	"e_sub_cert_basic_constraints_not_critical",
+	"e_sub_cert_cert_policy_empty",
+	"w_sub_cert_certificate_policies_marked_critical",
+	"e_sub_cert_certificate_policies_missing",
+	"e_sub_cert_country_name_must_appear",
+	"e_sub_cert_crl_distribution_points_does_not_contain_url",
+	"e_sub_cert_crl_distribution_points_marked_critical",
+	"w_sub_cert_eku_extra_values",
+	"e_sub_cert_eku_missing",
+	"e_sub_cert_eku_server_auth_client_auth_missing",
+	"e_sub_cert_given_name_surname_contains_correct_policy",
+	"e_sub_cert_not_is_ca",
+	"e_sub_cert_key_usage_cert_sign_bit_set",
+	"e_sub_cert_key_usage_crl_sign_bit_set",
+	"e_sub_cert_locality_name_must_appear",
+	"e_sub_cert_locality_name_must_not_appear",
+	"e_sub_cert_postal_code_must_not_appear",
+	"e_sub_cert_province_must_appear",
+	"e_sub_cert_province_must_not_appear",
+	"w_sub_cert_sha1_expiration_too_long",
+	"e_sub_cert_street_address_should_not_exist",
+	"e_sub_cert_valid_time_longer_than_39_months",
+	"e_sub_cert_valid_time_longer_than_825_days",
+	"w_ext_subject_key_identifier_missing_sub_cert",
+	"n_ecdsa_ee_invalid_ku",
+	"e_rsa_allowed_ku_ee",
+	"e_ev_business_category_missing",
+	"e_ev_country_name_missing",
+	"e_ev_not_wildcard",
+	"e_ev_organization_id_missing",
+	"e_ev_organization_name_missing",
+	"e_ev_san_ip_address_present",
+	"e_ev_serial_number_missing",
+	"e_ev_valid_time_too_long",
+	"e_onion_subject_validity_time_too_large",
+	"e_san_dns_name_onion_not_ev_cert",
+	"w_ct_sct_policy_count_unsatisfied",
+	"e_tls_server_cert_valid_time_longer_than_398_days",
+	"w_tls_server_cert_valid_time_longer_than_397_days",
+	"e_precert_extension_order_mismatch",
+	"e_precert_extensions_mismatch",
+	"e_precert_final_cert_contains_poison",
+	"e_precert_poison_missing",
+	"e_precert_serial_mismatch",
+	"e_precert_tbs_field_mismatch",
+}
+
+// smimeLints are the lints of the S/MIME Baseline Requirements which concern
+// every generation of S/MIME subscriber certificate.
+var smimeLints = []string{
+	"e_ecpublickey_key_usages",
+	"e_ec_other_key_usages",
+	"e_edwardspublickey_key_usages",
+	"w_key_usage_criticality",
+	"e_key_usage_presence",
+	"e_rsa_other_key_usages",
+	"e_san_shall_be_present",
+	"w_san_should_not_be_critical",
+	"e_single_email_if_present",
+	"e_subscribers_shall_have_crl_distribution_points",
+	"e_mailbox_validated_enforce_subject_field_restrictions",
+}
+
+// stiLints are the STI lints which concern every kind of STI certificate.
+var stiLints = []string{
+	"e_atis_ext_basic_constraints",
+	"e_atis_ext_key_usage",
+}
+
+// stiCALints are the STI lints which concern both root and intermediate STI
+// certificates.
+var stiCALints = []string{
+	"e_atis_ext_key_usage_ca",
+	"e_atis_ext_not_specified_ca",
+	"e_atis_subject_key_identifier_ca",
+	"e_atis_subject_key_identifier_size_ca",
+	"e_atis_tn_auth_list_ca",
+	"e_atis_serial_number_ca",
+	"e_atis_serial_number_size_ca",
+	"e_atis_signature_algorithm_ca",
+	"e_atis_subject_c_iso_ca",
+	"e_atis_subject_c_us_ca",
+	"e_atis_subject_cn_ca",
+	"e_atis_subject_dn_ca",
+	"e_atis_subject_o_required_ca",
+	"e_atis_subject_public_key_ca",
+	"e_atis_version_ca",
+	"e_us_cp_subject_sn_may_ca",
+	"e_us_cp_subject_sn_shall_ca",
+}
+
+func concat(lists ...[]string) []string {
+	var all []string
+	for _, list := range lists {
+		all = append(all, list...)
+	}
+	return all
+}
+
+// register registers the profile described by d, drawing lints from the
+// global registry.
+func register(d definition) {
+	lint.RegisterProfile(d.resolve(lint.GlobalRegistry()))
+}
@@ -16,6 +16,7 @@ package profiles
 
 import (
 	"io/ioutil"
+	"strings"
 	"testing"
 
 	"github.com/zmap/zlint/v3/lint"
@@ -27,14 +28,19 @@ import (
 	_ "github.com/zmap/zlint/v3/lints/etsi"
 	_ "github.com/zmap/zlint/v3/lints/mozilla"
 	_ "github.com/zmap/zlint/v3/lints/rfc"
+	_ "github.com/zmap/zlint/v3/lints/shaken"
 )
 
 // We would like to make sure that there is a generic test that makes sure
 // that all profiles actually refer to registered lints.
 func TestLintsInAllProfilesExist(t *testing.T) {
+	registered := make(map[string]bool)
+	for _, name := range lint.GlobalRegistry().Names() {
+		registered[name] = true
+	}
 	for _, profile := range lint.AllProfiles() {
 		for _, l := range profile.LintNames {
-			if lint.GlobalRegistry().ByName(l) == nil {
+			if !registered[l] {
 				t.Errorf("Profile '%s' declares lint '%s' which does not exist", profile.Name, l)
 			}
 		}
@@ -73,3 +79,174 @@ func TestNotMissingAnyLintSources(t *testing.T) {
 	}
 
 }
+
+func TestBuiltinProfiles(t *testing.T) {
+	testCases := []struct {
+		profile string
+		include []string
+		exclude []string
+	}{
+		{
+			profile: "tls_subscriber",
+			include: []string{"e_sub_cert_aia_missing", "e_ev_organization_name_missing", "e_signature_not_verified_by_issuer_key", "e_precert_poison_missing"},
+			exclude: []string{"e_ca_is_ca", "e_root_ca_key_usage_present", "e_smime_strict_eku_check", "e_crl_has_next_update"},
+		},
+		{
+			profile: "tls_subordinate_ca",
+			include: []string{"e_ca_is_ca", "e_sub_ca_aia_missing", "e_sub_cert_or_sub_ca_using_sha1"},
+			exclude: []string{"e_sub_cert_aia_missing", "e_root_ca_key_usage_present"},
+		},
+		{
+			profile: "smime_strict",
+			include: []string{"e_smime_strict_eku_check", "e_key_usage_presence", "e_ext_san_empty_name"},
+			exclude: []string{"e_smime_legacy_multipurpose_eku_check", "e_sub_cert_aia_missing"},
+		},
+		{
+			profile: "smime_legacy",
+			include: []string{"e_smime_legacy_multipurpose_eku_check", "w_smime_legacy_aia_contains_internal_names"},
+			exclude: []string{"e_smime_strict_eku_check", "w_smime_strict_aia_contains_internal_names"},
+		},
+		{
+			profile: "sti_end_entity",
+			include: []string{"e_atis_tn_auth_list", "e_atis_version"},
+			exclude: []string{"e_atis_version_ca", "e_issuer_root"},
+		},
+		{
+			profile: "sti_root",
+			include: []string{"e_atis_version_ca", "e_issuer_root"},
+			exclude: []string{"e_atis_version", "e_atis_ext_authority_key_identifier_ca"},
+		},
+		{
+			profile: "etsi_qwac",
+			include: []string{"e_qcstatem_qctype_valid", "e_sub_cert_aia_missing"},
+			exclude: []string{"e_ca_is_ca"},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.profile, func(t *testing.T) {
+			profile, ok := lint.GetProfile(tc.profile)
+			if !ok {
+				t.Fatalf("expected the profile %s to be registered", tc.profile)
+			}
+			names := make(map[string]bool)
+			for _, name := range profile.LintNames {
+				names[name] = true
+			}
+			for _, name := range tc.include {
+				if !names[name] {
+					t.Errorf("expected %s to include %s", tc.profile, name)
+				}
+			}
+			for _, name := range tc.exclude {
+				if names[name] {
+					t.Errorf("expected %s to exclude %s", tc.profile, name)
+				}
+			}
+		})
+	}
+}
+
+func TestNewProfiles(t *testing.T) {
+	testCases := []struct {
+		name    string
+		json    string
+		toml    string
+		want    map[string][]string
+		wantErr string
+	}{
+		{
+			name: "extends and excludes",
+			json: `{"profiles": [
+				{"name": "test_sti", "extends": ["sti_end_entity"], "exclude": ["e_atis_version"]},
+				{"name": "test_sti_strict", "extends": ["test_sti"], "lints": ["e_rsa_fermat_factorization"]}
+			]}`,
+			toml: `
+[[profiles]]
+name = "test_sti"
+extends = ["sti_end_entity"]
+exclude = ["e_atis_version"]
+
+[[profiles]]
+name = "test_sti_strict"
+extends = ["test_sti"]
+lints = ["e_rsa_fermat_factorization"]
+`,
+			want: map[string][]string{
+				"test_sti":        {"e_atis_tn_auth_list"},
+				"test_sti_strict": {"e_atis_tn_auth_list", "e_rsa_fermat_factorization"},
+			},
+		},
+		{
+			name:    "unknown lint",
+			json:    `{"profiles": [{"name": "test", "lints": ["e_does_not_exist"]}]}`,
+			toml:    "[[profiles]]\nname = \"test\"\nlints = [\"e_does_not_exist\"]\n",
+			wantErr: `profile "test" includes the unknown lint "e_does_not_exist"`,
+		},
+		{
+			name:    "unknown profile",
+			json:    `{"profiles": [{"name": "test", "extends": ["does_not_exist"]}]}`,
+			toml:    "[[profiles]]\nname = \"test\"\nextends = [\"does_not_exist\"]\n",
+			wantErr: `unknown profile "does_not_exist"`,
+		},
+		{
+			name: "cycle",
+			json: `{"profiles": [
+				{"name": "a", "extends": ["b"]},
+				{"name": "b", "extends": ["a"]}
+			]}`,
+			toml:    "[[profiles]]\nname = \"a\"\nextends = [\"b\"]\n[[profiles]]\nname = \"b\"\nextends = [\"a\"]\n",
+			wantErr: `profile "a" extends itself`,
+		},
+		{
+			name:    "conflicts with a built-in",
+			json:    `{"profiles": [{"name": "tls_subscriber", "lints": ["e_rsa_fermat_factorization"]}]}`,
+			toml:    "[[profiles]]\nname = \"tls_subscriber\"\nlints = [\"e_rsa_fermat_factorization\"]\n",
+			wantErr: "conflicts with a registered profile",
+		},
+		{
+			name:    "unknown field",
+			json:    `{"profiles": [{"name": "test", "lint": ["e_rsa_fermat_factorization"]}]}`,
+			toml:    "[[profiles]]\nname = \"test\"\nlint = [\"e_rsa_fermat_factorization\"]\n",
+			wantErr: "failed to parse profiles",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		for format, read := range map[string]func() ([]lint.Profile, error){
+			"json": func() ([]lint.Profile, error) { return lint.NewProfilesFromJSON(strings.NewReader(tc.json)) },
+			"toml": func() ([]lint.Profile, error) { return lint.NewProfilesFromTOML(strings.NewReader(tc.toml)) },
+		} {
+			read := read
+			t.Run(tc.name+"/"+format, func(t *testing.T) {
+				profiles, err := read()
+				if tc.wantErr != "" {
+					if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+						t.Fatalf("expected an error containing %q, got %v", tc.wantErr, err)
+					}
+					return
+				}
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if len(profiles) != len(tc.want) {
+					t.Fatalf("expected %d profiles, got %d", len(tc.want), len(profiles))
+				}
+				for _, profile := range profiles {
+					names := make(map[string]bool)
+					for _, name := range profile.LintNames {
+						names[name] = true
+					}
+					for _, name := range tc.want[profile.Name] {
+						if !names[name] {
+							t.Errorf("expected %s to include %s", profile.Name, name)
+						}
+					}
+					if names["e_atis_version"] {
+						t.Errorf("expected %s to exclude e_atis_version", profile.Name)
+					}
+				}
+			})
+		}
+	}
+}
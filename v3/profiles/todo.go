@@ -1,5 +0,0 @@
-package profiles
-
-// This file exists purely to avoid the following error until we have at least one profile
-//
-// no non-test Go files in /home/runner/work/zlint/zlint/v3/profiles
@@ -15,7 +15,14 @@
 package zlint
 
 import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
 	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zcrypto/x509/revocation/ocsp"
 	"github.com/zmap/zlint/v3/lint"
 )
 
@@ -29,33 +36,369 @@ type ResultSet struct {
 	WarningsPresent bool                        `json:"warnings_present"`
 	ErrorsPresent   bool                        `json:"errors_present"`
 	FatalsPresent   bool                        `json:"fatals_present"`
+	// Waived holds the original results of the lints whose findings were
+	// waived by the configuration, keyed by lint name. Suppressed findings
+	// are removed from Results, whereas downgraded findings remain within
+	// Results at their reduced severity.
+	Waived map[string]*WaivedResult `json:"waived,omitempty"`
+}
+
+// WaivedResult is a lint result which a waiver was applied to.
+type WaivedResult struct {
+	// Result is the result of the lint before the waiver was applied.
+	Result *lint.LintResult `json:"result"`
+	// Waiver is the waiver which was applied.
+	Waiver lint.Waiver `json:"waiver"`
 }
 
 // Execute lints on the given certificate with all of the lints in the provided
-// registry. The ResultSet is mutated to trace the lint results obtained from
-// linting the certificate.
-func (z *ResultSet) executeCertificate(o *x509.Certificate, registry lint.Registry) {
+// registry, as directed by opts. Any waivers within the configuration of the
+// registry are applied to each result as it is recorded. The ResultSet is
+// mutated to trace the lint results obtained from linting the certificate.
+func (z *ResultSet) executeCertificate(ctx context.Context, o *x509.Certificate, registry lint.Registry, opts LintOptions) {
 	z.Results = make(map[string]*lint.LintResult, len(registry.Names()))
-	// Run each lint from the registry.
-	for _, lint := range registry.CertificateLints().Lints() {
-		res := lint.Execute(o, registry.GetConfiguration())
-		res.LintMetadata = lint.LintMetadata
-		z.Results[lint.Name] = res
-		z.updateErrorStatePresent(res)
+	config := registry.GetConfiguration()
+	lints := registry.CertificateLints().Lints()
+	metas := make([]lint.LintMetadata, len(lints))
+	for i, l := range lints {
+		metas[i] = l.LintMetadata
 	}
+	z.executeInOrder(ctx, metas, opts, z.certificateWaivers(o, config.Waivers(), time.Now()), func(i int) *lint.LintResult {
+		return lints[i].ExecuteWithOptions(o, config, opts.execution())
+	})
+}
+
+// Execute lints on the given certificate with all of the certificate lints and
+// chain lints in the provided registry. The chain lints are given issuer, which
+// may be nil if the issuer is not known. The lints are executed as directed by
+// opts, and any waivers within the configuration of the registry are applied
+// to each result as it is recorded. The ResultSet is mutated to trace the lint
+// results obtained from linting the certificate.
+func (z *ResultSet) executeChain(ctx context.Context, o *x509.Certificate, issuer *x509.Certificate, registry lint.Registry, opts LintOptions) {
+	z.executeCertificate(ctx, o, registry, opts)
+	config := registry.GetConfiguration()
+	lints := registry.ChainLints().Lints()
+	metas := make([]lint.LintMetadata, len(lints))
+	for i, l := range lints {
+		metas[i] = l.LintMetadata
+	}
+	z.executeInOrder(ctx, metas, opts, z.certificateWaivers(o, config.Waivers(), time.Now()), func(i int) *lint.LintResult {
+		return lints[i].ExecuteWithOptions(o, issuer, config, opts.execution())
+	})
 }
 
 // Execute lints on the given CRL with all of the lints in the provided
-// registry. The ResultSet is mutated to trace the lint results obtained from
-// linting the CRL.
-func (z *ResultSet) executeRevocationList(o *x509.RevocationList, registry lint.Registry) {
+// registry, as directed by opts. The ResultSet is mutated to trace the lint
+// results obtained from linting the CRL.
+func (z *ResultSet) executeRevocationList(ctx context.Context, o *x509.RevocationList, registry lint.Registry, opts LintOptions) {
+	z.Results = make(map[string]*lint.LintResult, len(registry.Names()))
+	config := registry.GetConfiguration()
+	lints := registry.RevocationListLints().Lints()
+	metas := make([]lint.LintMetadata, len(lints))
+	for i, l := range lints {
+		metas[i] = l.LintMetadata
+	}
+	z.executeInOrder(ctx, metas, opts, nil, func(i int) *lint.LintResult {
+		return lints[i].ExecuteWithOptions(o, config, opts.execution())
+	})
+}
+
+// Execute lints on the given OCSP response with all of the lints in the
+// provided registry, as directed by opts. The ResultSet is mutated to trace
+// the lint results obtained from linting the OCSP response.
+func (z *ResultSet) executeOCSPResponse(ctx context.Context, o *ocsp.Response, registry lint.Registry, opts LintOptions) {
+	z.Results = make(map[string]*lint.LintResult, len(registry.Names()))
+	config := registry.GetConfiguration()
+	lints := registry.OCSPResponseLints().Lints()
+	metas := make([]lint.LintMetadata, len(lints))
+	for i, l := range lints {
+		metas[i] = l.LintMetadata
+	}
+	z.executeInOrder(ctx, metas, opts, nil, func(i int) *lint.LintResult {
+		return lints[i].ExecuteWithOptions(o, config, opts.execution())
+	})
+}
+
+// Execute lints on the given certificate request with all of the lints in the
+// provided registry, as directed by opts. The ResultSet is mutated to trace
+// the lint results obtained from linting the certificate request.
+func (z *ResultSet) executeCertificateRequest(ctx context.Context, o *x509.CertificateRequest, registry lint.Registry, opts LintOptions) {
 	z.Results = make(map[string]*lint.LintResult, len(registry.Names()))
-	// Run each lints from the registry.
-	for _, lint := range registry.RevocationListLints().Lints() {
-		res := lint.Execute(o, registry.GetConfiguration())
-		res.LintMetadata = lint.LintMetadata
-		z.Results[lint.Name] = res
-		z.updateErrorStatePresent(res)
+	config := registry.GetConfiguration()
+	lints := registry.CertificateRequestLints().Lints()
+	metas := make([]lint.LintMetadata, len(lints))
+	for i, l := range lints {
+		metas[i] = l.LintMetadata
+	}
+	z.executeInOrder(ctx, metas, opts, nil, func(i int) *lint.LintResult {
+		return lints[i].ExecuteWithOptions(o, config, opts.execution())
+	})
+}
+
+// Execute lints on the given precertificate, final certificate and issuer of
+// the precertificate with all of the precertificate pair lints in the provided
+// registry, as directed by opts. The ResultSet is mutated to trace the lint
+// results obtained from linting the pair.
+func (z *ResultSet) executePrecertificatePair(ctx context.Context, precert *x509.Certificate, cert *x509.Certificate, issuer *x509.Certificate, registry lint.Registry, opts LintOptions) {
+	z.Results = make(map[string]*lint.LintResult, len(registry.Names()))
+	config := registry.GetConfiguration()
+	lints := registry.PrecertificatePairLints().Lints()
+	metas := make([]lint.LintMetadata, len(lints))
+	for i, l := range lints {
+		metas[i] = l.LintMetadata
+	}
+	z.executeInOrder(ctx, metas, opts, nil, func(i int) *lint.LintResult {
+		return lints[i].ExecuteWithOptions(precert, cert, issuer, config, opts.execution())
+	})
+}
+
+// executeInOrder executes the lints described by metas as directed by opts,
+// recording each of their results. The i'th lint is executed by calling run(i).
+// If waive is not nil then each result is passed through it before being
+// recorded, and results for which it returns nil are not recorded at all.
+//
+// Lints are executed in successive levels, such that a lint is only executed
+// once all of its prerequisites have been recorded. A lint with a failed
+// prerequisite is recorded as Skipped without being executed. Since results are
+// waived before the next level is executed, a prerequisite whose failure was
+// waived does not cause its dependents to be skipped.
+func (z *ResultSet) executeInOrder(ctx context.Context, metas []lint.LintMetadata, opts LintOptions, waive func(name string, res *lint.LintResult) *lint.LintResult, run func(i int) *lint.LintResult) {
+	for _, level := range prerequisiteLevels(metas) {
+		level := level
+		results := executeLints(ctx, len(level), opts, func(j int) *lint.LintResult {
+			if opts.SkipSignatureLints && isSignatureLint(metas[level[j]].Name) {
+				return &lint.LintResult{
+					Status:  lint.Skipped,
+					Details: "skipped as the signature of the certificate is not genuine"}
+			}
+			if res := z.prerequisiteFailure(metas[level[j]]); res != nil {
+				return res
+			}
+			return run(level[j])
+		})
+		for j, i := range level {
+			res := results[j]
+			if waive != nil {
+				// The metadata is set first so that it is kept with
+				// the original result of a waived lint.
+				res.LintMetadata = metas[i]
+				if res = waive(metas[i].Name, res); res == nil {
+					continue
+				}
+			}
+			z.record(metas[i], res)
+		}
+	}
+}
+
+// isSignatureLint reports whether name is listed in TemplateSignatureLints.
+func isSignatureLint(name string) bool {
+	for _, signatureLint := range TemplateSignatureLints {
+		if name == signatureLint {
+			return true
+		}
+	}
+	return false
+}
+
+// prerequisiteLevels groups the indices of metas into levels, such that every
+// lint appears in a later level than each of its prerequisites that are also
+// present in metas. Within a level indices are in ascending order. If no lint
+// has any prerequisites then there is a single level containing every lint.
+func prerequisiteLevels(metas []lint.LintMetadata) [][]int {
+	index := make(map[string]int, len(metas))
+	for i, meta := range metas {
+		index[meta.Name] = i
+	}
+	// pending counts the prerequisites of each lint which have not yet been
+	// placed into a level.
+	pending := make([]int, len(metas))
+	dependents := make([][]int, len(metas))
+	for i, meta := range metas {
+		for _, name := range meta.Prerequisites {
+			if j, ok := index[name]; ok {
+				pending[i]++
+				dependents[j] = append(dependents[j], i)
+			}
+		}
+	}
+	var level []int
+	for i := range metas {
+		if pending[i] == 0 {
+			level = append(level, i)
+		}
+	}
+	var levels [][]int
+	placed := 0
+	for len(level) > 0 {
+		levels = append(levels, level)
+		placed += len(level)
+		var next []int
+		for _, i := range level {
+			for _, d := range dependents[i] {
+				pending[d]--
+				if pending[d] == 0 {
+					next = append(next, d)
+				}
+			}
+		}
+		sort.Ints(next)
+		level = next
+	}
+	if placed < len(metas) {
+		// The registry refuses to register lints whose prerequisites form a
+		// cycle, but should one slip through then its members are still
+		// executed (last) rather than silently dropped.
+		var cycle []int
+		for i := range metas {
+			if pending[i] > 0 {
+				cycle = append(cycle, i)
+			}
+		}
+		levels = append(levels, cycle)
+	}
+	return levels
+}
+
+// prerequisiteFailure returns a Skipped result if any of the prerequisites of
+// the lint described by meta has already been recorded as Fatal or Skipped,
+// and nil otherwise. Other failures do not cascade, since a prerequisite that
+// could complete its checks does not prevent its dependents from completing
+// theirs.
+func (z *ResultSet) prerequisiteFailure(meta lint.LintMetadata) *lint.LintResult {
+	for _, name := range meta.Prerequisites {
+		res, ok := z.Results[name]
+		if !ok {
+			continue
+		}
+		switch res.Status {
+		case lint.Fatal, lint.Skipped:
+			return &lint.LintResult{
+				Status:  lint.Skipped,
+				Details: fmt.Sprintf("skipped as the prerequisite lint %s returned %s", name, res.Status)}
+		}
+	}
+	return nil
+}
+
+// executeLints calls run for every index in [0, n) using at most opts.Workers
+// goroutines, and returns the results ordered by index. The order in which
+// results are returned is therefore independent of the order in which the
+// lints finished executing. If opts.Workers is less than or equal to one then
+// every lint is run serially.
+func executeLints(ctx context.Context, n int, opts LintOptions, run func(i int) *lint.LintResult) []*lint.LintResult {
+	results := make([]*lint.LintResult, n)
+	workers := opts.Workers
+	if workers > n {
+		workers = n
+	}
+	if workers <= 1 {
+		for i := range results {
+			i := i
+			results[i] = executeLint(ctx, opts.Timeout, func() *lint.LintResult { return run(i) })
+		}
+		return results
+	}
+	indices := make(chan int)
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				i := i
+				results[i] = executeLint(ctx, opts.Timeout, func() *lint.LintResult { return run(i) })
+			}
+		}()
+	}
+	for i := range results {
+		indices <- i
+	}
+	close(indices)
+	wg.Wait()
+	return results
+}
+
+// executeLint calls run, giving up once ctx is done or once timeout has
+// elapsed (if timeout is greater than zero). A lint that is given up on is
+// left to finish in the background and its result is replaced with a Fatal
+// result describing why it did not complete.
+func executeLint(ctx context.Context, timeout time.Duration, run func() *lint.LintResult) *lint.LintResult {
+	if err := ctx.Err(); err != nil {
+		return &lint.LintResult{
+			Status:  lint.Fatal,
+			Details: fmt.Sprintf("lint was not executed: %s", err)}
+	}
+	if ctx.Done() == nil && timeout <= 0 {
+		// Nothing can interrupt the lint, so there is no need to pay for
+		// running it on a separate goroutine.
+		return run()
+	}
+	var budget <-chan time.Time
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		budget = timer.C
+	}
+	// The channel is buffered so that an abandoned lint does not leak the
+	// goroutine running it once it eventually finishes.
+	done := make(chan *lint.LintResult, 1)
+	go func() {
+		done <- run()
+	}()
+	select {
+	case res := <-done:
+		return res
+	case <-budget:
+		return &lint.LintResult{
+			Status:  lint.Fatal,
+			Details: fmt.Sprintf("lint timed out: execution exceeded the per-lint time budget of %s", timeout)}
+	case <-ctx.Done():
+		return &lint.LintResult{
+			Status:  lint.Fatal,
+			Details: fmt.Sprintf("lint was abandoned before completing: %s", ctx.Err())}
+	}
+}
+
+// record adds the result of the lint described by meta to the ResultSet.
+func (z *ResultSet) record(meta lint.LintMetadata, res *lint.LintResult) {
+	res.LintMetadata = meta
+	z.Results[meta.Name] = res
+	z.updateErrorStatePresent(res)
+}
+
+// certificateWaivers returns a function which applies the first of waivers
+// that is in force at the time now and covers the findings of a lint for the
+// certificate c, for use by executeInOrder. The original results of findings
+// that were suppressed or downgraded are recorded in z.Waived. If there are no
+// waivers then nil is returned.
+func (z *ResultSet) certificateWaivers(c *x509.Certificate, waivers []lint.Waiver, now time.Time) func(name string, res *lint.LintResult) *lint.LintResult {
+	if len(waivers) == 0 {
+		return nil
+	}
+	return func(name string, res *lint.LintResult) *lint.LintResult {
+		switch res.Status {
+		case lint.Notice, lint.Warn, lint.Error, lint.Fatal:
+		default:
+			return res
+		}
+		for _, waiver := range waivers {
+			if !waiver.AppliesTo(name, c, now) {
+				continue
+			}
+			waived := waiver.Apply(res)
+			if waived == res {
+				// The result is already no more severe than the waiver
+				// allows, so nothing was waived.
+				return res
+			}
+			if z.Waived == nil {
+				z.Waived = make(map[string]*WaivedResult)
+			}
+			z.Waived[name] = &WaivedResult{Result: res, Waiver: waiver}
+			return waived
+		}
+		return res
 	}
 }
 
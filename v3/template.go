@@ -0,0 +1,130 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package zlint
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"time"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3/lint"
+	"golang.org/x/crypto/ed25519"
+)
+
+// TemplateSignatureLints are the lints whose results depend upon the genuine
+// signature of a certificate. They can not be evaluated against a certificate
+// template, and so are reported as skipped by LintCertificateTemplate, or
+// whenever LintOptions.SkipSignatureLints is set.
+var TemplateSignatureLints = []string{
+	"e_signature_not_verified_by_issuer_key",
+}
+
+// LintCertificateTemplate lints the certificate that issuer would produce
+// from template for the subject public key pub, before it is signed, using
+// the default options. See LintCertificateTemplateWithOptions.
+func LintCertificateTemplate(template, issuer *x509.Certificate, pub interface{}, registry lint.Registry) (*ResultSet, error) {
+	return LintCertificateTemplateWithOptions(context.Background(), template, issuer, pub, registry, LintOptions{})
+}
+
+// LintCertificateTemplateWithOptions lints the certificate that issuer would
+// produce from template for the subject public key pub, before it is signed,
+// executing the lints as directed by opts. If registry is nil then the global
+// registry of all lints is used.
+//
+// The certificate is built by x509.CreateCertificate, exactly as it would be
+// at issuance, but is signed by a dummy signer using the signature algorithm
+// of the issuer's key (or that of the template, if it is set). Both the
+// certificate and chain lints are run, with issuer as the issuer. As the
+// signature is not genuine, the lints listed in TemplateSignatureLints are
+// reported as skipped.
+//
+// An error is returned if the certificate can not be built from template, or
+// if the issuer's key is of a type that can not sign certificates.
+func LintCertificateTemplateWithOptions(ctx context.Context, template, issuer *x509.Certificate, pub interface{}, registry lint.Registry, opts LintOptions) (*ResultSet, error) {
+	if template == nil || issuer == nil {
+		return nil, errors.New("a template and its issuer are required")
+	}
+	if registry == nil {
+		registry = lint.GlobalRegistry()
+	}
+	signer, err := newTemplateSigner(issuer.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, issuer, pub, signer)
+	if err != nil {
+		return nil, fmt.Errorf("unable to build certificate from template: %w", err)
+	}
+	c, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse certificate built from template: %w", err)
+	}
+	opts.SkipSignatureLints = true
+	res := new(ResultSet)
+	res.executeChain(ctx, c, issuer, registry, opts)
+	res.Version = Version
+	res.Timestamp = time.Now().Unix()
+	return res, nil
+}
+
+// templateSigner is a crypto.Signer which produces dummy signatures of the
+// size and form that the private key matching pub would produce.
+type templateSigner struct {
+	pub crypto.PublicKey
+}
+
+// newTemplateSigner returns a templateSigner for the public key of an issuer,
+// as parsed by x509.ParseCertificate.
+func newTemplateSigner(pub interface{}) (*templateSigner, error) {
+	switch key := pub.(type) {
+	case *x509.AugmentedECDSA:
+		return &templateSigner{pub: key.Pub}, nil
+	case *rsa.PublicKey, *ecdsa.PublicKey, ed25519.PublicKey:
+		return &templateSigner{pub: key}, nil
+	default:
+		return nil, fmt.Errorf("unsupported issuer public key type %T", pub)
+	}
+}
+
+// Public implements crypto.Signer.
+func (s *templateSigner) Public() crypto.PublicKey {
+	return s.pub
+}
+
+// Sign implements crypto.Signer.
+func (s *templateSigner) Sign(_ io.Reader, _ []byte, _ crypto.SignerOpts) ([]byte, error) {
+	switch key := s.pub.(type) {
+	case *rsa.PublicKey:
+		return make([]byte, key.Size()), nil
+	case *ecdsa.PublicKey:
+		// The largest values of r and s, so that the signature is as long as
+		// any that the issuer could produce.
+		max := new(big.Int).Sub(key.Curve.Params().N, big.NewInt(1))
+		return asn1.Marshal(struct{ R, S *big.Int }{max, max})
+	case ed25519.PublicKey:
+		return make([]byte, ed25519.SignatureSize), nil
+	default:
+		return nil, fmt.Errorf("unsupported issuer public key type %T", s.pub)
+	}
+}
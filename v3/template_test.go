@@ -0,0 +1,149 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package zlint
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zcrypto/x509/pkix"
+	"github.com/zmap/zlint/v3/lint"
+	"golang.org/x/crypto/ed25519"
+)
+
+// newTestIssuer returns a self-signed CA certificate for the private key priv.
+func newTestIssuer(t *testing.T, priv crypto.Signer) *x509.Certificate {
+	t.Helper()
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Template Test Root", Organization: []string{"ZLint"}, Country: []string{"US"}},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().AddDate(10, 0, 0),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		SubjectKeyId:          []byte{1, 2, 3, 4},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, priv.Public(), priv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	issuer, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return issuer
+}
+
+func TestLintCertificateTemplate(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	subjectKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(0x7e57),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		DNSNames:     []string{"example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().AddDate(0, 3, 0),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+
+	testCases := []struct {
+		name          string
+		key           crypto.Signer
+		wantAlgorithm x509.SignatureAlgorithm
+	}{
+		{name: "RSA", key: rsaKey, wantAlgorithm: x509.SHA256WithRSA},
+		{name: "ECDSA", key: ecdsaKey, wantAlgorithm: x509.ECDSAWithSHA384},
+		{name: "Ed25519", key: ed25519Key, wantAlgorithm: x509.Ed25519Sig},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			issuer := newTestIssuer(t, tc.key)
+			got, err := LintCertificateTemplate(template, issuer, subjectKey.Public(), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Linting the template must match linting the certificate once it
+			// has genuinely been signed, bar the lints of the signature.
+			der, err := x509.CreateCertificate(rand.Reader, template, issuer, subjectKey.Public(), tc.key)
+			if err != nil {
+				t.Fatal(err)
+			}
+			signed, err := x509.ParseCertificate(der)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if signed.SignatureAlgorithm != tc.wantAlgorithm {
+				t.Fatalf("expected the issuer to sign with %s, got %s", tc.wantAlgorithm, signed.SignatureAlgorithm)
+			}
+			want := LintChainWithOptions(context.Background(), signed, nil, issuer, nil, LintOptions{})[0]
+
+			skipped := make(map[string]bool)
+			for _, name := range TemplateSignatureLints {
+				skipped[name] = true
+				if res := got.Results[name]; res == nil || res.Status != lint.Skipped {
+					t.Errorf("expected %s to be skipped, got %v", name, res)
+				}
+			}
+			if len(got.Results) != len(want.Results) {
+				t.Fatalf("expected %d results, got %d", len(want.Results), len(got.Results))
+			}
+			for name, res := range want.Results {
+				if skipped[name] {
+					continue
+				}
+				if got.Results[name].Status != res.Status {
+					t.Errorf("%s: expected %s (%s), got %s (%s)", name, res.Status, res.Details, got.Results[name].Status, got.Results[name].Details)
+				}
+			}
+			if got.ErrorsPresent != want.ErrorsPresent || got.WarningsPresent != want.WarningsPresent {
+				t.Errorf("expected the presence of errors and warnings to match the signed certificate")
+			}
+		})
+	}
+}
+
+func TestLintCertificateTemplateUnsupportedIssuer(t *testing.T) {
+	issuer := &x509.Certificate{PublicKey: "not a key"}
+	template := &x509.Certificate{SerialNumber: big.NewInt(1)}
+	if _, err := LintCertificateTemplate(template, issuer, nil, nil); err == nil {
+		t.Error("expected an error for an issuer key that can not sign")
+	}
+}
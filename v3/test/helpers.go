@@ -25,9 +25,16 @@ import (
 	"strings"
 
 	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zcrypto/x509/revocation/ocsp"
 	"github.com/zmap/zlint/v3/lint"
 )
 
+func init() {
+	// A lint that panics should fail its unit tests loudly, with its full
+	// stack, rather than being quietly reported as a Fatal result.
+	lint.SetStrictPanics(true)
+}
+
 // TestLint executes the given lintName against a certificate read from
 // a testcert data file with the given filename. Filenames should be relative to
 // `testdata/` and not absolute file paths.
@@ -68,6 +75,123 @@ func TestRevocationListLintWithConfig(tb testing.TB, lintName string, testCRLFil
 	return TestLintRevocationList(tb, lintName, ReadTestRevocationList(tb, testCRLFilename), config)
 }
 
+// TestOCSPResponseLint executes the given lintName against an OCSP response
+// read from a testdata file with the given filename. Filenames should be
+// relative to `testdata/` and not absolute file paths.
+//
+//nolint:revive
+func TestOCSPResponseLint(tb testing.TB, lintName string, testOCSPFilename string) *lint.LintResult {
+	tb.Helper()
+	return TestOCSPResponseLintWithConfig(tb, lintName, testOCSPFilename, "")
+}
+
+func TestOCSPResponseLintWithConfig(tb testing.TB, lintName string, testOCSPFilename string, configuration string) *lint.LintResult {
+	tb.Helper()
+	config, err := lint.NewConfigFromString(configuration)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	return TestLintOCSPResponse(tb, lintName, ReadTestOCSPResponse(tb, testOCSPFilename), config)
+}
+
+// TestCertificateRequestLint executes the given lintName against a
+// certificate request read from a testdata file with the given filename.
+// Filenames should be relative to `testdata/` and not absolute file paths.
+//
+//nolint:revive
+func TestCertificateRequestLint(tb testing.TB, lintName string, testCSRFilename string) *lint.LintResult {
+	tb.Helper()
+	return TestCertificateRequestLintWithConfig(tb, lintName, testCSRFilename, "")
+}
+
+func TestCertificateRequestLintWithConfig(tb testing.TB, lintName string, testCSRFilename string, configuration string) *lint.LintResult {
+	tb.Helper()
+	config, err := lint.NewConfigFromString(configuration)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	return TestLintCertificateRequest(tb, lintName, ReadTestCertificateRequest(tb, testCSRFilename), config)
+}
+
+// TestChainLint executes the given lintName against a certificate and the
+// certificate of its issuer, both read from testcert data files with the given
+// filenames. Filenames should be relative to `testdata/` and not absolute file
+// paths.
+//
+//nolint:revive
+func TestChainLint(tb testing.TB, lintName string, testCertFilename string, testIssuerFilename string) *lint.LintResult {
+	tb.Helper()
+	return TestLintChain(tb, lintName, ReadTestCert(testCertFilename), ReadTestCert(testIssuerFilename), lint.NewEmptyConfig())
+}
+
+// TestLintChain executes a chain lint with the given name against an already
+// parsed certificate and issuer certificate.
+//
+//nolint:revive
+func TestLintChain(tb testing.TB, lintName string, cert *x509.Certificate, issuer *x509.Certificate, ctx lint.Configuration) *lint.LintResult {
+	tb.Helper()
+	l := lint.GlobalRegistry().ChainLints().ByName(lintName)
+	if l == nil {
+		tb.Fatalf(
+			"Lint name %q does not exist in lint.Lints. "+
+				"Did you forget to RegisterLint?\n",
+			lintName)
+	}
+	res := l.Execute(cert, issuer, ctx)
+	// We never expect a lint to return a nil LintResult
+	if res == nil {
+		tb.Fatalf(
+			"Running lint %q on test certificate chain generated a nil LintResult.\n",
+			lintName)
+	}
+	return res
+}
+
+// TestPrecertificatePairLint executes the given lintName against a
+// precertificate and the final certificate issued from it, both read from
+// testcert data files with the given filenames. Filenames should be relative
+// to `testdata/` and not absolute file paths.
+//
+//nolint:revive
+func TestPrecertificatePairLint(tb testing.TB, lintName string, testPrecertFilename string, testCertFilename string) *lint.LintResult {
+	tb.Helper()
+	return TestLintPrecertificatePair(tb, lintName, ReadTestCert(testPrecertFilename), ReadTestCert(testCertFilename), nil, lint.NewEmptyConfig())
+}
+
+// TestPrecertificatePairLintWithIssuer is like TestPrecertificatePairLint,
+// but also provides the issuer of the precertificate, read from the testcert
+// data file with the given filename.
+//
+//nolint:revive
+func TestPrecertificatePairLintWithIssuer(tb testing.TB, lintName string, testPrecertFilename string, testCertFilename string, testIssuerFilename string) *lint.LintResult {
+	tb.Helper()
+	return TestLintPrecertificatePair(tb, lintName, ReadTestCert(testPrecertFilename), ReadTestCert(testCertFilename), ReadTestCert(testIssuerFilename), lint.NewEmptyConfig())
+}
+
+// TestLintPrecertificatePair executes a precertificate pair lint with the
+// given name against an already parsed precertificate, certificate and issuer
+// of the precertificate, which may be nil.
+//
+//nolint:revive
+func TestLintPrecertificatePair(tb testing.TB, lintName string, precert *x509.Certificate, cert *x509.Certificate, issuer *x509.Certificate, ctx lint.Configuration) *lint.LintResult {
+	tb.Helper()
+	l := lint.GlobalRegistry().PrecertificatePairLints().ByName(lintName)
+	if l == nil {
+		tb.Fatalf(
+			"Lint name %q does not exist in lint.Lints. "+
+				"Did you forget to RegisterLint?\n",
+			lintName)
+	}
+	res := l.Execute(precert, cert, issuer, ctx)
+	// We never expect a lint to return a nil LintResult
+	if res == nil {
+		tb.Fatalf(
+			"Running lint %q on test precertificate pair generated a nil LintResult.\n",
+			lintName)
+	}
+	return res
+}
+
 // TestLintCert executes a lint with the given name against an already parsed
 // certificate. This is useful when a unit test reads a certificate from disk
 // and then mutates it in some way before trying to lint it.
@@ -118,6 +242,52 @@ func TestLintRevocationList(tb testing.TB, lintName string, crl *x509.Revocation
 	return res
 }
 
+// TestLintOCSPResponse executes a lint with the given name against an already
+// parsed OCSP response.
+//
+//nolint:revive
+func TestLintOCSPResponse(tb testing.TB, lintName string, r *ocsp.Response, ctx lint.Configuration) *lint.LintResult {
+	tb.Helper()
+	l := lint.GlobalRegistry().OCSPResponseLints().ByName(lintName)
+	if l == nil {
+		tb.Fatalf(
+			"Lint name %q does not exist in lint.Lints. "+
+				"Did you forget to RegisterLint?\n",
+			lintName)
+	}
+	res := l.Execute(r, ctx)
+	// We never expect a lint to return a nil LintResult
+	if res == nil {
+		tb.Fatalf(
+			"Running lint %q on test OCSP response generated a nil LintResult.\n",
+			lintName)
+	}
+	return res
+}
+
+// TestLintCertificateRequest executes a lint with the given name against an
+// already parsed certificate request.
+//
+//nolint:revive
+func TestLintCertificateRequest(tb testing.TB, lintName string, r *x509.CertificateRequest, ctx lint.Configuration) *lint.LintResult {
+	tb.Helper()
+	l := lint.GlobalRegistry().CertificateRequestLints().ByName(lintName)
+	if l == nil {
+		tb.Fatalf(
+			"Lint name %q does not exist in lint.Lints. "+
+				"Did you forget to RegisterLint?\n",
+			lintName)
+	}
+	res := l.Execute(r, ctx)
+	// We never expect a lint to return a nil LintResult
+	if res == nil {
+		tb.Fatalf(
+			"Running lint %q on test certificate request generated a nil LintResult.\n",
+			lintName)
+	}
+	return res
+}
+
 // ReadTestCert loads a x509.Certificate from the given inPath which is assumed
 // to be relative to `testdata/`.
 //
@@ -192,3 +362,80 @@ func ReadTestRevocationList(tb testing.TB, inPath string) *x509.RevocationList {
 
 	return theCrl
 }
+
+// ReadTestOCSPResponse loads an ocsp.Response from the given inPath which is
+// assumed to be relative to `testdata/`. The file may hold either a PEM block
+// of type "OCSP RESPONSE" or the raw DER encoding.
+//
+// Important: ReadTestOCSPResponse is only appropriate for unit tests. It will
+// fail the test if the inPath file can not be loaded.
+func ReadTestOCSPResponse(tb testing.TB, inPath string) *ocsp.Response {
+	tb.Helper()
+	fullPath := fmt.Sprintf("../../testdata/%s", inPath)
+	data, err := os.ReadFile(fullPath)
+	if err != nil {
+		tb.Fatalf(
+			"Unable to read test OCSP response from %q - %q "+
+				"Does a unit test have an incorrect test file name?\n",
+			fullPath, err)
+	}
+
+	if strings.Contains(string(data), "-BEGIN OCSP RESPONSE-") {
+		block, _ := pem.Decode(data)
+		if block == nil { //nolint: staticcheck // tb.Fatalf exits
+			tb.Fatalf(
+				"Failed to PEM decode test OCSP response from %q - "+
+					"Does a unit test have a buggy test file?\n",
+				fullPath)
+		}
+		data = block.Bytes //nolint: staticcheck // tb.Fatalf exits
+	}
+
+	resp, err := ocsp.ParseResponse(data, nil)
+	if err != nil {
+		tb.Fatalf(
+			"Failed to parse test OCSP response from %q - %q "+
+				"Does a unit test have a buggy test file?\n",
+			fullPath, err)
+	}
+
+	return resp
+}
+
+// ReadTestCertificateRequest loads a x509.CertificateRequest from the given
+// inPath which is assumed to be relative to `testdata/`.
+//
+// Important: ReadTestCertificateRequest is only appropriate for unit tests. It
+// will fail the test if the inPath file can not be loaded.
+func ReadTestCertificateRequest(tb testing.TB, inPath string) *x509.CertificateRequest {
+	tb.Helper()
+	fullPath := fmt.Sprintf("../../testdata/%s", inPath)
+	data, err := os.ReadFile(fullPath)
+	if err != nil {
+		tb.Fatalf(
+			"Unable to read test certificate request from %q - %q "+
+				"Does a unit test have an incorrect test file name?\n",
+			fullPath, err)
+	}
+
+	if strings.Contains(string(data), "-BEGIN CERTIFICATE REQUEST-") {
+		block, _ := pem.Decode(data)
+		if block == nil { //nolint: staticcheck // tb.Fatalf exits
+			tb.Fatalf(
+				"Failed to PEM decode test certificate request from %q - "+
+					"Does a unit test have a buggy test file?\n",
+				fullPath)
+		}
+		data = block.Bytes //nolint: staticcheck // tb.Fatalf exits
+	}
+
+	csr, err := x509.ParseCertificateRequest(data)
+	if err != nil {
+		tb.Fatalf(
+			"Failed to parse test certificate request from %q - %q "+
+				"Does a unit test have a buggy test file?\n",
+			fullPath, err)
+	}
+
+	return csr
+}
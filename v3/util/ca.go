@@ -51,6 +51,18 @@ func IsDelegatedOCSPResponderCert(cert *x509.Certificate) bool {
 	return HasEKU(cert, x509.ExtKeyUsageOcspSigning)
 }
 
+// IsPrecertSigningCert returns true if the certificate is an RFC 6962
+// Precertificate Signing Certificate, i.e. it has the Certificate
+// Transparency EKU 1.3.6.1.4.1.11129.2.4.4.
+func IsPrecertSigningCert(cert *x509.Certificate) bool {
+	for _, eku := range cert.UnknownExtKeyUsage {
+		if eku.Equal(CtPrecertSigningOID) {
+			return true
+		}
+	}
+	return false
+}
+
 func IsServerAuthCert(cert *x509.Certificate) bool {
 	if len(cert.ExtKeyUsage) == 0 {
 		return true
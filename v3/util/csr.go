@@ -0,0 +1,61 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package util
+
+import (
+	"github.com/zmap/zcrypto/encoding/asn1"
+	"github.com/zmap/zcrypto/x509"
+)
+
+// CSRAttribute is a single attribute of a PKCS#10 certificate request as
+// defined in RFC 2986 section 4.1. The values are left unparsed since their
+// type depends on the attribute.
+type CSRAttribute struct {
+	Type   asn1.ObjectIdentifier
+	Values []asn1.RawValue `asn1:"set"`
+}
+
+type csrInfo struct {
+	Version    int
+	Subject    asn1.RawValue
+	PublicKey  asn1.RawValue
+	Attributes []CSRAttribute `asn1:"tag:0"`
+}
+
+// GetCSRAttributes returns the attributes of the given certificate request.
+// Unlike r.Attributes, attributes whose values are not sequences (such as the
+// challengePassword) are also returned.
+func GetCSRAttributes(r *x509.CertificateRequest) ([]CSRAttribute, error) {
+	var info csrInfo
+	if _, err := asn1.Unmarshal(r.RawTBSCertificateRequest, &info); err != nil {
+		return nil, err
+	}
+	return info.Attributes, nil
+}
+
+// GetCSRAttribute returns the attribute with the given OID, or nil if the
+// certificate request does not contain it.
+func GetCSRAttribute(r *x509.CertificateRequest, oid asn1.ObjectIdentifier) (*CSRAttribute, error) {
+	attrs, err := GetCSRAttributes(r)
+	if err != nil {
+		return nil, err
+	}
+	for i := range attrs {
+		if attrs[i].Type.Equal(oid) {
+			return &attrs[i], nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,309 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package util
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/zmap/zcrypto/cryptobyte"
+	cryptobyte_asn1 "github.com/zmap/zcrypto/cryptobyte/asn1"
+	"github.com/zmap/zcrypto/encoding/asn1"
+)
+
+// A DERLocation locates an element within a DER encoding, such as the Raw
+// bytes of a certificate, by its ASN.1 path and the offsets of its first byte
+// and of the byte following it.
+type DERLocation struct {
+	ASN1Path string `json:"asn1Path"`
+	Start    int    `json:"start"`
+	End      int    `json:"end"`
+}
+
+// A DERNode is a single element within a DER encoding.
+type DERNode struct {
+	DERLocation
+	Tag cryptobyte_asn1.Tag
+	// HeaderLength is the number of bytes of the identifier and length octets
+	// preceding the contents of the element.
+	HeaderLength int
+	// Children are the elements within the contents of a constructed
+	// element, or within an extnValue that holds a DER encoding.
+	Children []*DERNode
+}
+
+// Location returns the location of the node, for use within a lint.Finding.
+func (n *DERNode) Location() *DERLocation {
+	location := n.DERLocation
+	return &location
+}
+
+// Contents returns the offsets of the contents of the element, following its
+// header.
+func (n *DERNode) Contents() (start, end int) {
+	return n.Start + n.HeaderLength, n.End
+}
+
+// A DERIndex indexes each of the elements of a DER encoding by position and
+// ASN.1 path. The path of an element is that of its parent followed by its
+// index within the parent, e.g. "[0][2]", except where NewCertificateDERIndex
+// names the fields of a certificate, e.g. "tbsCertificate.subject[1][0].value".
+//
+// Building an index walks the whole encoding, so lints only build one once
+// they have a finding to locate. Should the encoding not be indexable, such as
+// when it is malformed, the findings are reported without a location.
+type DERIndex struct {
+	Root   *DERNode
+	raw    []byte
+	nodes  []*DERNode
+	byPath map[string]*DERNode
+}
+
+// NewDERIndex indexes the elements of the single DER encoded element der.
+func NewDERIndex(der []byte) (*DERIndex, error) {
+	input := cryptobyte.String(der)
+	root, err := parseDERNode(&input, 0, "")
+	if err != nil {
+		return nil, err
+	}
+	if !input.Empty() {
+		return nil, errors.New("trailing data after DER encoding")
+	}
+	idx := &DERIndex{Root: root, raw: der}
+	idx.reindex()
+	return idx, nil
+}
+
+// NewCertificateDERIndex indexes the elements of the DER encoded certificate
+// raw, such as Certificate.Raw, naming the fields of the certificate after RFC
+// 5280 section 4.1. The fields of the TBSCertificate are named within
+// "tbsCertificate", e.g. "tbsCertificate.validity.notBefore". Each extension
+// is named "tbsCertificate.extensions[i]", where i is its index among the
+// extensions, with the fields "extnID", "critical" and "extnValue", and the
+// DER encoding within each extnValue is indexed as its children. Attributes of
+// the issuer and subject are named by the index of their RDN and their index
+// within the RDN, with the fields "type" and "value", e.g.
+// "tbsCertificate.subject[1][0].value". An explicitly tagged field shares its
+// path with the element within it, and Lookup returns the outer of the two.
+func NewCertificateDERIndex(raw []byte) (*DERIndex, error) {
+	idx, err := NewDERIndex(raw)
+	if err != nil {
+		return nil, err
+	}
+	cert := idx.Root
+	if cert.Tag != cryptobyte_asn1.SEQUENCE || len(cert.Children) != 3 || cert.Children[0].Tag != cryptobyte_asn1.SEQUENCE {
+		return nil, errors.New("DER encoding is not a certificate")
+	}
+	cert.rename("certificate")
+	cert.Children[1].rename("signatureAlgorithm")
+	cert.Children[2].rename("signatureValue")
+	tbs := cert.Children[0]
+	tbs.rename("tbsCertificate")
+	fields := tbs.Children
+	if len(fields) > 0 && fields[0].Tag == cryptobyte_asn1.Tag(0).Constructed().ContextSpecific() {
+		fields[0].renameExplicit("tbsCertificate.version")
+		fields = fields[1:]
+	}
+	if len(fields) < 6 {
+		return nil, errors.New("TBSCertificate is missing required fields")
+	}
+	for i, name := range []string{"serialNumber", "signature", "issuer", "validity", "subject", "subjectPublicKeyInfo"} {
+		fields[i].rename("tbsCertificate." + name)
+	}
+	if validity := fields[3]; len(validity.Children) == 2 {
+		validity.Children[0].rename("tbsCertificate.validity.notBefore")
+		validity.Children[1].rename("tbsCertificate.validity.notAfter")
+	}
+	renameAttributes(fields[2])
+	renameAttributes(fields[4])
+	for _, field := range fields[6:] {
+		switch field.Tag {
+		case cryptobyte_asn1.Tag(1).ContextSpecific():
+			field.rename("tbsCertificate.issuerUniqueID")
+		case cryptobyte_asn1.Tag(2).ContextSpecific():
+			field.rename("tbsCertificate.subjectUniqueID")
+		case cryptobyte_asn1.Tag(3).Constructed().ContextSpecific():
+			field.renameExplicit("tbsCertificate.extensions")
+			if len(field.Children) == 1 {
+				for _, ext := range field.Children[0].Children {
+					idx.indexExtension(ext)
+				}
+			}
+		}
+	}
+	idx.reindex()
+	return idx, nil
+}
+
+// Lookup returns the element at the ASN.1 path, or nil if there is none. It
+// may be called on a nil index.
+func (idx *DERIndex) Lookup(path string) *DERNode {
+	if idx == nil {
+		return nil
+	}
+	return idx.byPath[path]
+}
+
+// Locate returns the location of the element at the ASN.1 path, or nil if
+// there is none. It may be called on a nil index, such that a lint may attach
+// a location to a finding only if the index could be built.
+func (idx *DERIndex) Locate(path string) *DERLocation {
+	if n := idx.Lookup(path); n != nil {
+		return n.Location()
+	}
+	return nil
+}
+
+// Nodes returns every element in the order of its first byte.
+func (idx *DERIndex) Nodes() []*DERNode {
+	return idx.nodes
+}
+
+// Bytes returns the complete encoding of the element n.
+func (idx *DERIndex) Bytes(n *DERNode) []byte {
+	return idx.raw[n.Start:n.End]
+}
+
+// Extension returns the first extension with the given OID of a certificate
+// indexed by NewCertificateDERIndex, or nil if there is none. It may be called
+// on a nil index.
+func (idx *DERIndex) Extension(oid asn1.ObjectIdentifier) *DERNode {
+	for i := 0; ; i++ {
+		ext := idx.Lookup(fmt.Sprintf("tbsCertificate.extensions[%d]", i))
+		if ext == nil {
+			return nil
+		}
+		extnID := idx.Lookup(ext.ASN1Path + ".extnID")
+		if extnID == nil {
+			continue
+		}
+		value := cryptobyte.String(idx.Bytes(extnID))
+		var id asn1.ObjectIdentifier
+		if value.ReadASN1ObjectIdentifier(&id) && id.Equal(oid) {
+			return ext
+		}
+	}
+}
+
+// indexExtension names the fields of the Extension ext and indexes the DER
+// encoding within its extnValue, if there is one.
+func (idx *DERIndex) indexExtension(ext *DERNode) {
+	fields := ext.Children
+	if len(fields) < 2 {
+		return
+	}
+	fields[0].rename(ext.ASN1Path + ".extnID")
+	if len(fields) == 3 {
+		fields[1].rename(ext.ASN1Path + ".critical")
+	}
+	value := fields[len(fields)-1]
+	value.rename(ext.ASN1Path + ".extnValue")
+	if value.Tag != cryptobyte_asn1.OCTET_STRING {
+		return
+	}
+	start, end := value.Contents()
+	if children, err := parseDERChildren(idx.raw[start:end], start, value.ASN1Path); err == nil {
+		value.Children = children
+	}
+}
+
+func (idx *DERIndex) reindex() {
+	idx.nodes = nil
+	idx.byPath = map[string]*DERNode{}
+	idx.add(idx.Root)
+}
+
+func (idx *DERIndex) add(n *DERNode) {
+	idx.nodes = append(idx.nodes, n)
+	if _, ok := idx.byPath[n.ASN1Path]; !ok {
+		idx.byPath[n.ASN1Path] = n
+	}
+	for _, child := range n.Children {
+		idx.add(child)
+	}
+}
+
+// rename sets the path of n, and of its descendants within it.
+func (n *DERNode) rename(path string) {
+	n.ASN1Path = path
+	for i, child := range n.Children {
+		child.rename(fmt.Sprintf("%s[%d]", path, i))
+	}
+}
+
+// renameExplicit sets the path of the explicitly tagged n, and of the single
+// element within it, to path.
+func (n *DERNode) renameExplicit(path string) {
+	n.rename(path)
+	if len(n.Children) == 1 {
+		n.Children[0].rename(path)
+	}
+}
+
+// renameAttributes names the type and value of each AttributeTypeAndValue
+// within the Name n.
+func renameAttributes(name *DERNode) {
+	for _, rdn := range name.Children {
+		for _, attr := range rdn.Children {
+			if len(attr.Children) == 2 {
+				attr.Children[0].rename(attr.ASN1Path + ".type")
+				attr.Children[1].rename(attr.ASN1Path + ".value")
+			}
+		}
+	}
+}
+
+// parseDERNode reads a single element from input, which begins at offset
+// within the complete encoding, and the elements within it.
+func parseDERNode(input *cryptobyte.String, offset int, path string) (*DERNode, error) {
+	var element cryptobyte.String
+	var tag cryptobyte_asn1.Tag
+	if !input.ReadAnyASN1Element(&element, &tag) {
+		return nil, fmt.Errorf("unable to parse DER element at offset %d", offset)
+	}
+	var contents cryptobyte.String
+	if header := element; !header.ReadAnyASN1(&contents, &tag) {
+		return nil, fmt.Errorf("unable to parse DER element at offset %d", offset)
+	}
+	n := &DERNode{
+		DERLocation:  DERLocation{ASN1Path: path, Start: offset, End: offset + len(element)},
+		Tag:          tag,
+		HeaderLength: len(element) - len(contents),
+	}
+	if tag == tag.Constructed() {
+		children, err := parseDERChildren(contents, n.Start+n.HeaderLength, path)
+		if err != nil {
+			return nil, err
+		}
+		n.Children = children
+	}
+	return n, nil
+}
+
+// parseDERChildren reads each of the elements within contents, which begins
+// at offset within the complete encoding.
+func parseDERChildren(contents []byte, offset int, path string) ([]*DERNode, error) {
+	input := cryptobyte.String(contents)
+	var children []*DERNode
+	for i := 0; !input.Empty(); i++ {
+		start := offset + len(contents) - len(input)
+		child, err := parseDERNode(&input, start, fmt.Sprintf("%s[%d]", path, i))
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, child)
+	}
+	return children, nil
+}
@@ -0,0 +1,94 @@
+package util
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	stdx509 "crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestNewCertificateDERIndex(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &stdx509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{Organization: []string{"Example"}, CommonName: "example.com"},
+		NotBefore:    time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
+		NotAfter:     time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+		KeyUsage:     stdx509.KeyUsageDigitalSignature,
+		DNSNames:     []string{"example.com"},
+	}
+	raw, err := stdx509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	idx, err := NewCertificateDERIndex(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root := idx.Root; root.Start != 0 || root.End != len(raw) || root.ASN1Path != "certificate" {
+		t.Errorf("expected the root to span the certificate, got %+v", root.DERLocation)
+	}
+	for path, want := range map[string][]byte{
+		"tbsCertificate.validity.notBefore":  []byte("230101000000Z"),
+		"tbsCertificate.validity.notAfter":   []byte("240101000000Z"),
+		"tbsCertificate.subject[0][0].value": []byte("Example"),
+		"tbsCertificate.subject[1][0].value": []byte("example.com"),
+	} {
+		n := idx.Lookup(path)
+		if n == nil {
+			t.Errorf("expected an element at %s", path)
+			continue
+		}
+		if start, end := n.Contents(); !bytes.Equal(raw[start:end], want) {
+			t.Errorf("expected %s to contain %q, got %q", path, want, raw[start:end])
+		}
+	}
+	ku := idx.Extension(KeyUsageOID)
+	if ku == nil {
+		t.Fatal("expected to find the keyUsage extension")
+	}
+	bitString := idx.Lookup(ku.ASN1Path + ".extnValue[0]")
+	if bitString == nil || raw[bitString.Start] != 0x03 {
+		t.Errorf("expected the BIT STRING within the keyUsage extnValue, got %+v", bitString)
+	}
+	if idx.Extension(BasicConstOID) != nil {
+		t.Error("expected no basicConstraints extension")
+	}
+	var last int
+	for _, n := range idx.Nodes() {
+		if n.Start < last {
+			t.Errorf("expected elements in order of position, got %s at %d after %d", n.ASN1Path, n.Start, last)
+		}
+		last = n.Start
+	}
+
+	if _, err := NewCertificateDERIndex(append(raw, 0)); err == nil {
+		t.Error("expected an error for trailing data")
+	}
+	var missing *DERIndex
+	if missing.Locate("tbsCertificate") != nil {
+		t.Error("expected no location from a nil index")
+	}
+}
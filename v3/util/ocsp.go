@@ -0,0 +1,60 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package util
+
+import (
+	"time"
+
+	"github.com/zmap/zcrypto/encoding/asn1"
+	"github.com/zmap/zcrypto/x509/pkix"
+	"github.com/zmap/zcrypto/x509/revocation/ocsp"
+)
+
+// ocspResponseData mirrors the ResponseData structure of RFC 6960 section
+// 4.2.1. The ocsp package only exposes the single response extensions, so the
+// raw TBSResponseData is reparsed to reach the response extensions.
+type ocspResponseData struct {
+	Version            int `asn1:"optional,default:0,explicit,tag:0"`
+	RawResponderID     asn1.RawValue
+	ProducedAt         time.Time `asn1:"generalized"`
+	Responses          []asn1.RawValue
+	ResponseExtensions []pkix.Extension `asn1:"explicit,tag:1,optional"`
+}
+
+// GetOCSPResponseExtensions returns the responseExtensions of the given OCSP
+// response, which are distinct from the singleExtensions found in
+// r.Extensions.
+func GetOCSPResponseExtensions(r *ocsp.Response) ([]pkix.Extension, error) {
+	var data ocspResponseData
+	if _, err := asn1.Unmarshal(r.TBSResponseData, &data); err != nil {
+		return nil, err
+	}
+	return data.ResponseExtensions, nil
+}
+
+// GetOCSPResponseExtension returns the response extension with the given OID,
+// or nil if the OCSP response does not contain it.
+func GetOCSPResponseExtension(r *ocsp.Response, oid asn1.ObjectIdentifier) (*pkix.Extension, error) {
+	exts, err := GetOCSPResponseExtensions(r)
+	if err != nil {
+		return nil, err
+	}
+	for i := range exts {
+		if exts[i].Id.Equal(oid) {
+			return &exts[i], nil
+		}
+	}
+	return nil, nil
+}
@@ -1,6 +1,12 @@
 package util
 
-import "github.com/zmap/zcrypto/x509"
+import (
+	"fmt"
+
+	"github.com/zmap/zcrypto/cryptobyte"
+	cryptobyte_asn1 "github.com/zmap/zcrypto/cryptobyte/asn1"
+	"github.com/zmap/zcrypto/x509"
+)
 
 func HasEmailSAN(c *x509.Certificate) bool {
 	for _, san := range c.EmailAddresses {
@@ -17,3 +23,37 @@ func HasEmailSAN(c *x509.Certificate) bool {
 
 	return false
 }
+
+// SANDNSNamePaths returns the path of each of the dNSNames of the
+// subjectAltName extension of c, in the order of c.DNSNames, for use within a
+// lint.Finding. A path locates the dNSName by its position among all of the
+// GeneralNames of the extension, e.g. "extensions.subjectAltName[3].dNSName".
+func SANDNSNamePaths(c *x509.Certificate) []string {
+	const path = "extensions.subjectAltName[%d].dNSName"
+	paths := make([]string, 0, len(c.DNSNames))
+	if ext := GetExtFromCert(c, SubjectAlternateNameOID); ext != nil {
+		value := cryptobyte.String(ext.Value)
+		var names cryptobyte.String
+		if value.ReadASN1(&names, cryptobyte_asn1.SEQUENCE) {
+			for i := 0; !names.Empty(); i++ {
+				var name cryptobyte.String
+				var tag cryptobyte_asn1.Tag
+				if !names.ReadAnyASN1(&name, &tag) {
+					break
+				}
+				if tag == cryptobyte_asn1.Tag(2).ContextSpecific() {
+					paths = append(paths, fmt.Sprintf(path, i))
+				}
+			}
+		}
+	}
+	// Should the extension not be parsed as zcrypto parsed it, fall back to
+	// locating each dNSName by its index within c.DNSNames.
+	if len(paths) != len(c.DNSNames) {
+		paths = paths[:0]
+		for i := range c.DNSNames {
+			paths = append(paths, fmt.Sprintf(path, i))
+		}
+	}
+	return paths
+}
@@ -0,0 +1,118 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package util
+
+import (
+	"errors"
+
+	"github.com/zmap/zcrypto/encoding/asn1"
+)
+
+// TBSCertificateFields holds the DER encoding of each field of a
+// TBSCertificate (RFC 5280 section 4.1) so that two certificates can be
+// compared byte-for-byte, including the encoding of each field. Optional
+// fields that are absent are nil.
+type TBSCertificateFields struct {
+	Version              []byte
+	SerialNumber         []byte
+	Signature            []byte
+	Issuer               []byte
+	Validity             []byte
+	Subject              []byte
+	SubjectPublicKeyInfo []byte
+	IssuerUniqueID       []byte
+	SubjectUniqueID      []byte
+	Extensions           []RawExtension
+}
+
+// RawExtension is a single extension of a TBSCertificate together with its
+// complete DER encoding.
+type RawExtension struct {
+	Raw      asn1.RawContent
+	OID      asn1.ObjectIdentifier
+	Critical bool `asn1:"optional"`
+	Value    []byte
+}
+
+// ParseTBSCertificateFields splits the DER encoded TBSCertificate raw into its
+// fields without interpreting them.
+func ParseTBSCertificateFields(raw []byte) (*TBSCertificateFields, error) {
+	var tbs asn1.RawValue
+	if rest, err := asn1.Unmarshal(raw, &tbs); err != nil {
+		return nil, err
+	} else if len(rest) > 0 {
+		return nil, errors.New("trailing data after TBSCertificate")
+	}
+
+	var elements []asn1.RawValue
+	for rest := tbs.Bytes; len(rest) > 0; {
+		var element asn1.RawValue
+		var err error
+		if rest, err = asn1.Unmarshal(rest, &element); err != nil {
+			return nil, err
+		}
+		elements = append(elements, element)
+	}
+
+	fields := &TBSCertificateFields{}
+	if len(elements) > 0 && elements[0].Class == asn1.ClassContextSpecific && elements[0].Tag == 0 {
+		fields.Version = elements[0].FullBytes
+		elements = elements[1:]
+	}
+	if len(elements) < 6 {
+		return nil, errors.New("TBSCertificate is missing required fields")
+	}
+	fields.SerialNumber = elements[0].FullBytes
+	fields.Signature = elements[1].FullBytes
+	fields.Issuer = elements[2].FullBytes
+	fields.Validity = elements[3].FullBytes
+	fields.Subject = elements[4].FullBytes
+	fields.SubjectPublicKeyInfo = elements[5].FullBytes
+
+	for _, element := range elements[6:] {
+		if element.Class != asn1.ClassContextSpecific {
+			return nil, errors.New("unexpected field in TBSCertificate")
+		}
+		switch element.Tag {
+		case 1:
+			fields.IssuerUniqueID = element.FullBytes
+		case 2:
+			fields.SubjectUniqueID = element.FullBytes
+		case 3:
+			var exts []RawExtension
+			if rest, err := asn1.Unmarshal(element.Bytes, &exts); err != nil {
+				return nil, err
+			} else if len(rest) > 0 {
+				return nil, errors.New("trailing data after extensions")
+			}
+			fields.Extensions = exts
+		default:
+			return nil, errors.New("unexpected field in TBSCertificate")
+		}
+	}
+	return fields, nil
+}
+
+// RemoveRawExtension returns the extensions in exts other than those with the
+// given OID, preserving their order.
+func RemoveRawExtension(exts []RawExtension, oid asn1.ObjectIdentifier) []RawExtension {
+	var out []RawExtension
+	for _, ext := range exts {
+		if !ext.OID.Equal(oid) {
+			out = append(out, ext)
+		}
+	}
+	return out
+}
@@ -17,9 +17,11 @@
 package zlint
 
 import (
+	"context"
 	"time"
 
 	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zcrypto/x509/revocation/ocsp"
 	"github.com/zmap/zlint/v3/lint"
 	_ "github.com/zmap/zlint/v3/lints/apple"
 	_ "github.com/zmap/zlint/v3/lints/cabf_br"
@@ -34,6 +36,42 @@ import (
 
 const Version int64 = 3
 
+// LintOptions controls how the lints in a registry are executed.
+type LintOptions struct {
+	// Workers is the maximum number of lints that may be executed concurrently
+	// against a single input. If Workers is less than or equal to one then
+	// every lint is executed serially on the calling goroutine.
+	//
+	// The contents of the resulting ResultSet do not depend on the number of
+	// workers. Lints are each given their own instance of the lint
+	// implementation, and the registry's Configuration is only ever read while
+	// linting, so it is safe to share between workers.
+	Workers int
+	// Timeout is the maximum amount of time that any single lint may spend
+	// executing. A lint that exceeds its budget is abandoned and recorded as a
+	// Fatal result describing the timeout. If Timeout is zero then lints have
+	// no individual time budget.
+	Timeout time.Duration
+	// AsOf, if not zero, is the date against which the effective window of
+	// each lint is evaluated, instead of the date of the object being linted.
+	// See lint.ExecutionOptions for details.
+	AsOf time.Time
+	// Preview causes lints which are not yet effective to be executed anyway,
+	// reporting any failures as lint.Upcoming rather than lint.NE. See
+	// lint.ExecutionOptions for details.
+	Preview bool
+	// SkipSignatureLints causes the lints listed in TemplateSignatureLints to
+	// be reported as lint.Skipped rather than executed. It is for certificates
+	// whose signature was not produced by their issuer, such as a
+	// precertificate rebuilt from a Certificate Transparency log entry.
+	SkipSignatureLints bool
+}
+
+// execution returns the lint.ExecutionOptions selected by o.
+func (o LintOptions) execution() lint.ExecutionOptions {
+	return lint.ExecutionOptions{AsOf: o.AsOf, Preview: o.Preview}
+}
+
 // LintCertificate runs all registered lints on c using default options,
 // producing a ResultSet.
 //
@@ -50,6 +88,24 @@ func LintCertificate(c *x509.Certificate) *ResultSet {
 // If registry is nil then the global registry of all lints is used and this
 // function is equivalent to calling LintCertificate(c).
 func LintCertificateEx(c *x509.Certificate, registry lint.Registry) *ResultSet {
+	return LintCertificateWithOptions(context.Background(), c, registry, LintOptions{})
+}
+
+// LintCertificateContext is equivalent to LintCertificateEx, except that
+// linting is abandoned once ctx is done. Lints that have not finished by then
+// are recorded as Fatal results describing why they did not complete.
+func LintCertificateContext(ctx context.Context, c *x509.Certificate, registry lint.Registry) *ResultSet {
+	return LintCertificateWithOptions(ctx, c, registry, LintOptions{})
+}
+
+// LintCertificateWithOptions runs lints from the provided registry on c
+// producing a ResultSet, executing the lints as directed by opts. Linting is
+// abandoned once ctx is done. Any waivers within the configuration of the
+// registry are applied to the results (see lint.Waiver).
+//
+// Using LintCertificateContext(ctx, c, registry) is equivalent to calling
+// LintCertificateWithOptions(ctx, c, registry, LintOptions{}).
+func LintCertificateWithOptions(ctx context.Context, c *x509.Certificate, registry lint.Registry, opts LintOptions) *ResultSet {
 	if c == nil {
 		return nil
 	}
@@ -57,12 +113,62 @@ func LintCertificateEx(c *x509.Certificate, registry lint.Registry) *ResultSet {
 		registry = lint.GlobalRegistry()
 	}
 	res := new(ResultSet)
-	res.executeCertificate(c, registry)
+	res.executeCertificate(ctx, c, registry, opts)
 	res.Version = Version
 	res.Timestamp = time.Now().Unix()
 	return res
 }
 
+// LintChain runs lints from the provided registry on every certificate in the
+// chain formed by leaf, intermediates and root, producing one ResultSet per
+// certificate. The intermediates are expected to be in order, starting with
+// the issuer of leaf. The root may be nil if it is not available.
+//
+// Each certificate is linted with all of the CertificateLints in the registry,
+// as well as with all of the ChainLints using the next certificate in the chain
+// as its issuer. The root certificate is treated as its own issuer. If no root
+// is provided then the ChainLints for the last certificate in the chain return
+// NA.
+//
+// The returned slice is ordered leaf first, followed by the intermediates and
+// finally the root (if provided). If registry is nil then the global registry
+// of all lints is used. Any waivers within the configuration of the registry
+// are applied to the results for each certificate (see lint.Waiver).
+func LintChain(leaf *x509.Certificate, intermediates []*x509.Certificate, root *x509.Certificate, registry lint.Registry) []*ResultSet {
+	return LintChainWithOptions(context.Background(), leaf, intermediates, root, registry, LintOptions{})
+}
+
+// LintChainWithOptions is equivalent to LintChain, except that the lints are
+// executed as directed by opts and linting is abandoned once ctx is done.
+func LintChainWithOptions(ctx context.Context, leaf *x509.Certificate, intermediates []*x509.Certificate, root *x509.Certificate, registry lint.Registry, opts LintOptions) []*ResultSet {
+	if leaf == nil {
+		return nil
+	}
+	if registry == nil {
+		registry = lint.GlobalRegistry()
+	}
+	chain := append([]*x509.Certificate{leaf}, intermediates...)
+	if root != nil {
+		chain = append(chain, root)
+	}
+	results := make([]*ResultSet, 0, len(chain))
+	for i, c := range chain {
+		var issuer *x509.Certificate
+		switch {
+		case i+1 < len(chain):
+			issuer = chain[i+1]
+		case c == root:
+			issuer = root
+		}
+		res := new(ResultSet)
+		res.executeChain(ctx, c, issuer, registry, opts)
+		res.Version = Version
+		res.Timestamp = time.Now().Unix()
+		results = append(results, res)
+	}
+	return results
+}
+
 // LintRevocationList runs all registered lints on r using default options,
 // producing a ResultSet.
 //
@@ -78,6 +184,20 @@ func LintRevocationList(r *x509.RevocationList) *ResultSet {
 // If registry is nil then the global registry of all lints is used and this
 // function is equivalent to calling LintRevocationList(r).
 func LintRevocationListEx(r *x509.RevocationList, registry lint.Registry) *ResultSet {
+	return LintRevocationListWithOptions(context.Background(), r, registry, LintOptions{})
+}
+
+// LintRevocationListContext is equivalent to LintRevocationListEx, except
+// that linting is abandoned once ctx is done. Lints that have not finished by
+// then are recorded as Fatal results describing why they did not complete.
+func LintRevocationListContext(ctx context.Context, r *x509.RevocationList, registry lint.Registry) *ResultSet {
+	return LintRevocationListWithOptions(ctx, r, registry, LintOptions{})
+}
+
+// LintRevocationListWithOptions runs lints from the provided registry on r
+// producing a ResultSet, executing the lints as directed by opts. Linting is
+// abandoned once ctx is done.
+func LintRevocationListWithOptions(ctx context.Context, r *x509.RevocationList, registry lint.Registry, opts LintOptions) *ResultSet {
 	if r == nil {
 		return nil
 	}
@@ -85,7 +205,121 @@ func LintRevocationListEx(r *x509.RevocationList, registry lint.Registry) *Resul
 		registry = lint.GlobalRegistry()
 	}
 	res := new(ResultSet)
-	res.executeRevocationList(r, registry)
+	res.executeRevocationList(ctx, r, registry, opts)
+	res.Version = Version
+	res.Timestamp = time.Now().Unix()
+	return res
+}
+
+// LintOCSPResponse runs all registered lints on r using default options,
+// producing a ResultSet.
+//
+// Using LintOCSPResponse(r) is equivalent to calling LintOCSPResponseEx(r, nil).
+func LintOCSPResponse(r *ocsp.Response) *ResultSet {
+	return LintOCSPResponseEx(r, nil)
+}
+
+// LintOCSPResponseEx runs lints from the provided registry on r producing
+// a ResultSet. Providing an explicit registry allows the caller to filter the
+// lints that will be run. (See lint.Registry.Filter())
+//
+// If registry is nil then the global registry of all lints is used and this
+// function is equivalent to calling LintOCSPResponse(r).
+func LintOCSPResponseEx(r *ocsp.Response, registry lint.Registry) *ResultSet {
+	return LintOCSPResponseWithOptions(context.Background(), r, registry, LintOptions{})
+}
+
+// LintOCSPResponseWithOptions runs lints from the provided registry on r
+// producing a ResultSet, executing the lints as directed by opts. Linting is
+// abandoned once ctx is done.
+func LintOCSPResponseWithOptions(ctx context.Context, r *ocsp.Response, registry lint.Registry, opts LintOptions) *ResultSet {
+	if r == nil {
+		return nil
+	}
+	if registry == nil {
+		registry = lint.GlobalRegistry()
+	}
+	res := new(ResultSet)
+	res.executeOCSPResponse(ctx, r, registry, opts)
+	res.Version = Version
+	res.Timestamp = time.Now().Unix()
+	return res
+}
+
+// LintCertificateRequest runs all registered lints on r using default
+// options, producing a ResultSet.
+//
+// Using LintCertificateRequest(r) is equivalent to calling
+// LintCertificateRequestEx(r, nil).
+func LintCertificateRequest(r *x509.CertificateRequest) *ResultSet {
+	return LintCertificateRequestEx(r, nil)
+}
+
+// LintCertificateRequestEx runs lints from the provided registry on r
+// producing a ResultSet. Providing an explicit registry allows the caller to
+// filter the lints that will be run. (See lint.Registry.Filter())
+//
+// If registry is nil then the global registry of all lints is used and this
+// function is equivalent to calling LintCertificateRequest(r).
+func LintCertificateRequestEx(r *x509.CertificateRequest, registry lint.Registry) *ResultSet {
+	return LintCertificateRequestWithOptions(context.Background(), r, registry, LintOptions{})
+}
+
+// LintCertificateRequestWithOptions runs lints from the provided registry on
+// r producing a ResultSet, executing the lints as directed by opts. Linting is
+// abandoned once ctx is done.
+func LintCertificateRequestWithOptions(ctx context.Context, r *x509.CertificateRequest, registry lint.Registry, opts LintOptions) *ResultSet {
+	if r == nil {
+		return nil
+	}
+	if registry == nil {
+		registry = lint.GlobalRegistry()
+	}
+	res := new(ResultSet)
+	res.executeCertificateRequest(ctx, r, registry, opts)
+	res.Version = Version
+	res.Timestamp = time.Now().Unix()
+	return res
+}
+
+// LintPrecertificatePair runs all registered precertificate pair lints on an
+// RFC 6962 precertificate, the final certificate issued from it and the issuer
+// of the precertificate using default options, producing a ResultSet. The
+// issuer may be nil if it is not known, in which case a precertificate issued
+// by a Precertificate Signing Certificate is reported as differing from its
+// final certificate.
+//
+// Using LintPrecertificatePair(precert, cert, issuer) is equivalent to calling
+// LintPrecertificatePairEx(precert, cert, issuer, nil).
+func LintPrecertificatePair(precert *x509.Certificate, cert *x509.Certificate, issuer *x509.Certificate) *ResultSet {
+	return LintPrecertificatePairEx(precert, cert, issuer, nil)
+}
+
+// LintPrecertificatePairEx runs the precertificate pair lints from the
+// provided registry on an RFC 6962 precertificate, the final certificate
+// issued from it and the issuer of the precertificate, producing a ResultSet.
+// Providing an explicit registry allows the caller to filter the lints that
+// will be run. (See lint.Registry.Filter())
+//
+// If registry is nil then the global registry of all lints is used and this
+// function is equivalent to calling LintPrecertificatePair(precert, cert, issuer).
+func LintPrecertificatePairEx(precert *x509.Certificate, cert *x509.Certificate, issuer *x509.Certificate, registry lint.Registry) *ResultSet {
+	return LintPrecertificatePairWithOptions(context.Background(), precert, cert, issuer, registry, LintOptions{})
+}
+
+// LintPrecertificatePairWithOptions runs the precertificate pair lints from
+// the provided registry on precert, cert and the issuer of precert producing a
+// ResultSet, executing the lints as directed by opts. Linting is abandoned
+// once ctx is done.
+func LintPrecertificatePairWithOptions(ctx context.Context, precert *x509.Certificate, cert *x509.Certificate, issuer *x509.Certificate, registry lint.Registry, opts LintOptions) *ResultSet {
+	if precert == nil || cert == nil {
+		return nil
+	}
+	if registry == nil {
+		registry = lint.GlobalRegistry()
+	}
+	res := new(ResultSet)
+	res.executePrecertificatePair(ctx, precert, cert, issuer, registry, opts)
 	res.Version = Version
 	res.Timestamp = time.Now().Unix()
 	return res
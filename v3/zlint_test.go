@@ -1,7 +1,13 @@
 package zlint
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/pem"
 	"fmt"
+	"os"
+	"path/filepath"
 	"reflect"
 	"strings"
 	"testing"
@@ -10,6 +16,8 @@ import (
 	"github.com/zmap/zlint/v3/util"
 
 	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zcrypto/x509/pkix"
+	"github.com/zmap/zcrypto/x509/revocation/ocsp"
 	"github.com/zmap/zlint/v3/lint"
 )
 
@@ -35,6 +43,21 @@ func TestLintNames(t *testing.T) {
 	}
 }
 
+func TestLintsHaveRemediation(t *testing.T) {
+	var buf bytes.Buffer
+	lint.GlobalRegistry().WriteJSON(&buf)
+	dec := json.NewDecoder(&buf)
+	for dec.More() {
+		var meta lint.LintMetadata
+		if err := dec.Decode(&meta); err != nil {
+			t.Fatal(err)
+		}
+		if meta.Remediation == "" {
+			t.Errorf("lint %q has no remediation", meta.Name)
+		}
+	}
+}
+
 type configurableTestLint struct {
 	A     string
 	B     int
@@ -149,3 +172,696 @@ anything = "at all"
 		t.Fatal("expected lint metadata to have a name, got empty")
 	}
 }
+
+func readTestCertificate(t *testing.T, name string) *x509.Certificate {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		t.Fatalf("unable to decode PEM from %s", name)
+	}
+	c, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestLintChain(t *testing.T) {
+	leaf := readTestCertificate(t, "chainLeafValid.pem")
+	root := readTestCertificate(t, "chainIssuingCA.pem")
+	registry, err := lint.GlobalRegistry().Filter(lint.FilterOptions{
+		IncludeNames: []string{"e_signature_not_verified_by_issuer_key", "e_ext_authority_key_identifier_mismatch_issuer_ski"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results := LintChain(leaf, nil, root, registry)
+	if len(results) != 2 {
+		t.Fatalf("expected 2 result sets, got %d", len(results))
+	}
+	for i, want := range []lint.LintStatus{lint.Pass, lint.Pass} {
+		got := results[i].Results["e_signature_not_verified_by_issuer_key"].Status
+		if got != want {
+			t.Errorf("certificate %d: expected %s, got %s", i, want, got)
+		}
+	}
+	// The root has no authority key identifier.
+	if got := results[1].Results["e_ext_authority_key_identifier_mismatch_issuer_ski"].Status; got != lint.NA {
+		t.Errorf("expected root AKI lint to be NA, got %s", got)
+	}
+
+	results = LintChain(leaf, nil, nil, registry)
+	if len(results) != 1 {
+		t.Fatalf("expected 1 result set, got %d", len(results))
+	}
+	if got := results[0].Results["e_signature_not_verified_by_issuer_key"].Status; got != lint.NA {
+		t.Errorf("expected NA without an issuer, got %s", got)
+	}
+}
+
+func TestLintOCSPResponse(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "ocspIntervalTooLong.pem"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		t.Fatal("unable to decode PEM from ocspIntervalTooLong.pem")
+	}
+	resp, err := ocsp.ParseResponse(block.Bytes, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res := LintOCSPResponse(resp)
+	if got := res.Results["e_ocsp_validity_interval_longer_than_ten_days"].Status; got != lint.Error {
+		t.Errorf("expected %s, got %s", lint.Error, got)
+	}
+	if !res.ErrorsPresent {
+		t.Error("expected ErrorsPresent to be set")
+	}
+	if _, ok := res.Results["e_crl_has_valid_reason_code"]; ok {
+		t.Error("expected no CRL lints to be run on an OCSP response")
+	}
+}
+
+func TestLintCertificateRequest(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "csrRSA1024.pem"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		t.Fatal("unable to decode PEM from csrRSA1024.pem")
+	}
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res := LintCertificateRequest(csr)
+	if got := res.Results["e_csr_rsa_mod_less_than_2048_bits"].Status; got != lint.Error {
+		t.Errorf("expected %s, got %s", lint.Error, got)
+	}
+	if got := res.Results["e_csr_signature_invalid"].Status; got != lint.Pass {
+		t.Errorf("expected %s, got %s", lint.Pass, got)
+	}
+	if _, ok := res.Results["e_rsa_mod_less_than_2048_bits"]; ok {
+		t.Error("expected no certificate lints to be run on a certificate request")
+	}
+}
+
+func TestLintPrecertificatePair(t *testing.T) {
+	precert := readTestCertificate(t, "precertValid.pem")
+	cert := readTestCertificate(t, "precertFinalSerialMismatch.pem")
+
+	res := LintPrecertificatePair(precert, cert, nil)
+	if got := res.Results["e_precert_serial_mismatch"].Status; got != lint.Error {
+		t.Errorf("expected %s, got %s", lint.Error, got)
+	}
+	if got := res.Results["e_precert_extensions_mismatch"].Status; got != lint.Pass {
+		t.Errorf("expected %s, got %s", lint.Pass, got)
+	}
+	if _, ok := res.Results["e_sub_cert_aia_does_not_contain_ocsp_url"]; ok {
+		t.Error("expected no certificate lints to be run on a precertificate pair")
+	}
+	if LintPrecertificatePair(precert, nil, nil) != nil {
+		t.Error("expected a nil ResultSet without a final certificate")
+	}
+}
+
+func TestLintCertificateWithOptions(t *testing.T) {
+	config, err := lint.NewConfigFromString(`
+[e_rsa_fermat_factorization]
+Rounds = 1000
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	registry, err := lint.GlobalRegistry().Filter(lint.FilterOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	registry.SetConfiguration(config)
+
+	testCases := []string{
+		"rsaFermatFactorizationSusceptible.pem",
+		"chainLeafValid.pem",
+		"precertValid.pem",
+		"RSASHA1Good.pem",
+		"IANBareWildcard.pem",
+	}
+	for _, name := range testCases {
+		name := name
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			c := readTestCertificate(t, name)
+			want := LintCertificateEx(c, registry)
+			for _, workers := range []int{0, 2, 8, 1000} {
+				got := LintCertificateWithOptions(context.Background(), c, registry, LintOptions{Workers: workers})
+				got.Timestamp = want.Timestamp
+				if !reflect.DeepEqual(got, want) {
+					t.Errorf("expected the results with %d workers to equal the serial results", workers)
+				}
+			}
+		})
+	}
+}
+
+func TestLintChainWithOptions(t *testing.T) {
+	leaf := readTestCertificate(t, "chainLeafBadSignature.pem")
+	root := readTestCertificate(t, "chainIssuingCA.pem")
+
+	want := LintChain(leaf, nil, root, nil)
+	got := LintChainWithOptions(context.Background(), leaf, nil, root, nil, LintOptions{Workers: 4})
+	if len(got) != len(want) {
+		t.Fatalf("expected %d result sets, got %d", len(want), len(got))
+	}
+	for i := range want {
+		got[i].Timestamp = want[i].Timestamp
+		if !reflect.DeepEqual(got[i], want[i]) {
+			t.Errorf("certificate %d: expected the parallel results to equal the serial results", i)
+		}
+	}
+	if got := got[0].Results["e_signature_not_verified_by_issuer_key"].Status; got != lint.Error {
+		t.Errorf("expected %s, got %s", lint.Error, got)
+	}
+}
+
+type slowTestLint struct {
+	// DelayMillis is how long Execute sleeps for before passing.
+	DelayMillis int
+}
+
+func (l *slowTestLint) Configure() interface{} {
+	return l
+}
+
+func (l *slowTestLint) CheckApplies(c *x509.Certificate) bool {
+	return true
+}
+
+func (l *slowTestLint) Execute(c *x509.Certificate) *lint.LintResult {
+	time.Sleep(time.Duration(l.DelayMillis) * time.Millisecond)
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func TestLintCertificateContext(t *testing.T) {
+	lint.RegisterCertificateLint(&lint.CertificateLint{
+		LintMetadata: lint.LintMetadata{
+			Name:          "library_usage_test_slow_lint",
+			Description:   "Sleeps for a configurable amount of time before passing",
+			Citation:      "ZLint",
+			Source:        lint.Community,
+			EffectiveDate: util.ZeroDate,
+		},
+		Lint: func() lint.CertificateLintInterface { return &slowTestLint{} },
+	})
+	registry, err := lint.GlobalRegistry().Filter(lint.FilterOptions{
+		IncludeNames: []string{"library_usage_test_slow_lint", "e_cert_contains_unique_identifier"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	config, err := lint.NewConfigFromString(`
+[library_usage_test_slow_lint]
+DelayMillis = 5000
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	registry.SetConfiguration(config)
+	c := readTestCertificate(t, "chainLeafValid.pem")
+
+	testCases := []struct {
+		name        string
+		ctx         func() (context.Context, context.CancelFunc)
+		opts        LintOptions
+		wantDetails string
+	}{
+		{
+			name: "per-lint time budget",
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithCancel(context.Background())
+			},
+			opts:        LintOptions{Timeout: 10 * time.Millisecond},
+			wantDetails: "lint timed out: execution exceeded the per-lint time budget of 10ms",
+		},
+		{
+			name: "context deadline",
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithTimeout(context.Background(), 10*time.Millisecond)
+			},
+			wantDetails: "lint was abandoned before completing: context deadline exceeded",
+		},
+		{
+			name: "context deadline with workers",
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithTimeout(context.Background(), 10*time.Millisecond)
+			},
+			opts:        LintOptions{Workers: 2},
+			wantDetails: "lint was abandoned before completing: context deadline exceeded",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctx, cancel := tc.ctx()
+			defer cancel()
+			start := time.Now()
+			res := LintCertificateWithOptions(ctx, c, registry, tc.opts)
+			if elapsed := time.Since(start); elapsed > time.Second {
+				t.Errorf("expected linting to be abandoned promptly, took %s", elapsed)
+			}
+			got := res.Results["library_usage_test_slow_lint"]
+			if got.Status != lint.Fatal || got.Details != tc.wantDetails {
+				t.Errorf("expected %s (%s), got %s (%s)", lint.Fatal, tc.wantDetails, got.Status, got.Details)
+			}
+			if !res.FatalsPresent {
+				t.Error("expected FatalsPresent to be set")
+			}
+			if got := res.Results["e_cert_contains_unique_identifier"].Status; got != lint.Pass {
+				t.Errorf("expected %s, got %s", lint.Pass, got)
+			}
+		})
+	}
+}
+
+func TestLintRevocationListContext(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "crlEmpty.pem"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		t.Fatal("unable to decode PEM from crlEmpty.pem")
+	}
+	crl, err := x509.ParseRevocationList(block.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res := LintRevocationListContext(context.Background(), crl, nil)
+	if res.FatalsPresent {
+		t.Error("expected no fatal results with a live context")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	res = LintRevocationListContext(ctx, crl, nil)
+	if len(res.Results) == 0 {
+		t.Fatal("expected results for every revocation list lint")
+	}
+	for name, got := range res.Results {
+		want := "lint was not executed: context canceled"
+		if got.Status != lint.Fatal || got.Details != want {
+			t.Errorf("%s: expected %s (%s), got %s (%s)", name, lint.Fatal, want, got.Status, got.Details)
+		}
+	}
+}
+
+func TestLintPrerequisitesAreRegistered(t *testing.T) {
+	registry := lint.GlobalRegistry()
+	check := func(meta lint.LintMetadata, registered func(name string) bool) {
+		for _, name := range meta.Prerequisites {
+			if !registered(name) {
+				t.Errorf("lint %s has prerequisite %s which is not a registered lint of the same kind", meta.Name, name)
+			}
+		}
+	}
+	isCertificateLint := func(name string) bool { return registry.CertificateLints().ByName(name) != nil }
+	for _, l := range registry.CertificateLints().Lints() {
+		check(l.LintMetadata, isCertificateLint)
+	}
+	for _, l := range registry.ChainLints().Lints() {
+		check(l.LintMetadata, func(name string) bool {
+			return registry.ChainLints().ByName(name) != nil || isCertificateLint(name)
+		})
+	}
+	for _, l := range registry.RevocationListLints().Lints() {
+		check(l.LintMetadata, func(name string) bool { return registry.RevocationListLints().ByName(name) != nil })
+	}
+	for _, l := range registry.OCSPResponseLints().Lints() {
+		check(l.LintMetadata, func(name string) bool { return registry.OCSPResponseLints().ByName(name) != nil })
+	}
+	for _, l := range registry.CertificateRequestLints().Lints() {
+		check(l.LintMetadata, func(name string) bool { return registry.CertificateRequestLints().ByName(name) != nil })
+	}
+	for _, l := range registry.PrecertificatePairLints().Lints() {
+		check(l.LintMetadata, func(name string) bool { return registry.PrecertificatePairLints().ByName(name) != nil })
+	}
+}
+
+func TestLintCertificateSkipsFailedPrerequisites(t *testing.T) {
+	registry, err := lint.GlobalRegistry().Filter(lint.FilterOptions{
+		IncludeNames: []string{"e_atis_tn_auth_list", "e_atis_tn_auth_list_spc_format"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The TNAuthList extension can not be parsed, so only the root cause
+	// should be reported.
+	malformed := &x509.Certificate{
+		NotBefore: util.ATIS1000080_v005_Leaf_Date,
+		ExtensionsMap: map[string]pkix.Extension{
+			util.TNAuthListOID.String(): {Id: util.TNAuthListOID, Value: []byte{0x30, 0x01}},
+		},
+	}
+	for _, workers := range []int{1, 2} {
+		res := LintCertificateWithOptions(context.Background(), malformed, registry, LintOptions{Workers: workers})
+		if got := res.Results["e_atis_tn_auth_list"].Status; got != lint.Fatal {
+			t.Errorf("expected %s, got %s", lint.Fatal, got)
+		}
+		got := res.Results["e_atis_tn_auth_list_spc_format"]
+		want := "skipped as the prerequisite lint e_atis_tn_auth_list returned fatal"
+		if got.Status != lint.Skipped || got.Details != want {
+			t.Errorf("expected %s (%s), got %s (%s)", lint.Skipped, want, got.Status, got.Details)
+		}
+	}
+
+	// A prerequisite that completes with an error does not hide the results
+	// of its dependents.
+	missing := &x509.Certificate{NotBefore: util.ATIS1000080_v005_Leaf_Date}
+	res := LintCertificateEx(missing, registry)
+	for _, name := range []string{"e_atis_tn_auth_list", "e_atis_tn_auth_list_spc_format"} {
+		if got := res.Results[name].Status; got != lint.Error {
+			t.Errorf("expected %s to be %s, got %s", name, lint.Error, got)
+		}
+	}
+
+	// Without the prerequisite in the registry the dependent lint runs as usual.
+	registry, err = lint.GlobalRegistry().Filter(lint.FilterOptions{
+		IncludeNames: []string{"e_atis_tn_auth_list_spc_format"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	res = LintCertificateEx(malformed, registry)
+	if got := res.Results["e_atis_tn_auth_list_spc_format"].Status; got != lint.Error {
+		t.Errorf("expected %s, got %s", lint.Error, got)
+	}
+}
+
+func TestLintCertificateRunsDependentsOfWaivedPrerequisites(t *testing.T) {
+	malformed := &x509.Certificate{
+		NotBefore: util.ATIS1000080_v005_Leaf_Date,
+		ExtensionsMap: map[string]pkix.Extension{
+			util.TNAuthListOID.String(): {Id: util.TNAuthListOID, Value: []byte{0x30, 0x01}},
+		},
+	}
+	for _, severity := range []string{"", "error"} {
+		registry, err := lint.GlobalRegistry().Filter(lint.FilterOptions{
+			IncludeNames: []string{"e_atis_tn_auth_list", "e_atis_tn_auth_list_spc_format"},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		config, err := lint.NewConfigFromString(fmt.Sprintf(`
+[[Waivers]]
+lint = "e_atis_tn_auth_list"
+severity = %q
+justification = "Approved test exception"
+expires = 2100-01-01T00:00:00Z
+`, severity))
+		if err != nil {
+			t.Fatal(err)
+		}
+		registry.SetConfiguration(config)
+		res := LintCertificateEx(malformed, registry)
+		waived, ok := res.Waived["e_atis_tn_auth_list"]
+		if !ok || waived.Result.Status != lint.Fatal || waived.Result.LintMetadata.Name != "e_atis_tn_auth_list" {
+			t.Errorf("severity %q: expected the fatal result of the prerequisite to be waived, got %v", severity, waived)
+		}
+		if got := res.Results["e_atis_tn_auth_list_spc_format"].Status; got != lint.Error {
+			t.Errorf("severity %q: expected the dependent lint to be executed and return %s, got %s", severity, lint.Error, got)
+		}
+	}
+}
+
+func TestPrerequisiteLevels(t *testing.T) {
+	meta := func(name string, prerequisites ...string) lint.LintMetadata {
+		return lint.LintMetadata{Name: name, Prerequisites: prerequisites}
+	}
+	testCases := []struct {
+		name  string
+		metas []lint.LintMetadata
+		want  [][]int
+	}{
+		{
+			name:  "no prerequisites",
+			metas: []lint.LintMetadata{meta("a"), meta("b"), meta("c")},
+			want:  [][]int{{0, 1, 2}},
+		},
+		{
+			name:  "prerequisite registered after its dependent",
+			metas: []lint.LintMetadata{meta("a", "c"), meta("b"), meta("c")},
+			want:  [][]int{{1, 2}, {0}},
+		},
+		{
+			name:  "transitive prerequisites",
+			metas: []lint.LintMetadata{meta("a", "b", "c"), meta("b", "c"), meta("c"), meta("d", "c")},
+			want:  [][]int{{2}, {1, 3}, {0}},
+		},
+		{
+			name:  "unknown prerequisite",
+			metas: []lint.LintMetadata{meta("a", "z"), meta("b")},
+			want:  [][]int{{0, 1}},
+		},
+		{
+			name:  "cycle",
+			metas: []lint.LintMetadata{meta("a", "b"), meta("b", "a"), meta("c")},
+			want:  [][]int{{2}, {0, 1}},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if got := prerequisiteLevels(tc.metas); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestLintCertificateAsOfAndPreview(t *testing.T) {
+	registry, err := lint.GlobalRegistry().Filter(lint.FilterOptions{
+		IncludeNames: []string{"e_atis_tn_auth_list"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The certificate predates the lint, but is missing its TNAuthList
+	// extension and so would fail were it issued today.
+	c := &x509.Certificate{NotBefore: util.ATIS1000080_v003_Leaf_Date.AddDate(-1, 0, 0)}
+	effective := util.ATIS1000080_v003_Leaf_Date.Format("2006-01-02")
+
+	testCases := []struct {
+		name        string
+		opts        LintOptions
+		wantStatus  lint.LintStatus
+		wantDetails string
+	}{
+		{
+			name:       "default",
+			wantStatus: lint.NE,
+		},
+		{
+			name:        "as of the effective date",
+			opts:        LintOptions{AsOf: util.ATIS1000080_v003_Leaf_Date},
+			wantStatus:  lint.Error,
+			wantDetails: "the TNAuthList extension is not present",
+		},
+		{
+			name:        "preview",
+			opts:        LintOptions{Preview: true},
+			wantStatus:  lint.Upcoming,
+			wantDetails: "error once effective on " + effective + ": the TNAuthList extension is not present",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			res := LintCertificateWithOptions(context.Background(), c, registry, tc.opts)
+			got := res.Results["e_atis_tn_auth_list"]
+			if got.Status != tc.wantStatus || got.Details != tc.wantDetails {
+				t.Errorf("expected %s (%s), got %s (%s)", tc.wantStatus, tc.wantDetails, got.Status, got.Details)
+			}
+			if res.ErrorsPresent != (tc.wantStatus == lint.Error) {
+				t.Errorf("expected ErrorsPresent to be %t", tc.wantStatus == lint.Error)
+			}
+		})
+	}
+}
+
+func TestLintOCSPResponseAndCertificateRequestAsOf(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "ocspIntervalTooLong.pem"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		t.Fatal("unable to decode PEM from ocspIntervalTooLong.pem")
+	}
+	resp, err := ocsp.ParseResponse(block.Bytes, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err = os.ReadFile(filepath.Join("testdata", "csrDNSNameNotFQDN.pem"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, _ = pem.Decode(data)
+	if block == nil {
+		t.Fatal("unable to decode PEM from csrDNSNameNotFQDN.pem")
+	}
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	before := func(date time.Time) LintOptions { return LintOptions{AsOf: date.AddDate(0, 0, -1)} }
+	previewBefore := func(date time.Time) LintOptions {
+		return LintOptions{AsOf: date.AddDate(0, 0, -1), Preview: true}
+	}
+	testCases := []struct {
+		name string
+		lint string
+		run  func(opts LintOptions) *ResultSet
+		date time.Time
+	}{
+		{
+			name: "OCSP response",
+			lint: "e_ocsp_validity_interval_longer_than_ten_days",
+			run: func(opts LintOptions) *ResultSet {
+				return LintOCSPResponseWithOptions(context.Background(), resp, nil, opts)
+			},
+			date: util.CABFBRs_1_7_1_Date,
+		},
+		{
+			name: "certificate request",
+			lint: "e_csr_san_dns_name_not_fqdn",
+			run: func(opts LintOptions) *ResultSet {
+				return LintCertificateRequestWithOptions(context.Background(), csr, nil, opts)
+			},
+			date: util.CABEffectiveDate,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.run(LintOptions{}).Results[tc.lint].Status; got != lint.Error {
+				t.Errorf("expected %s by default, got %s", lint.Error, got)
+			}
+			if got := tc.run(before(tc.date)).Results[tc.lint].Status; got != lint.NE {
+				t.Errorf("expected %s before the effective date, got %s", lint.NE, got)
+			}
+			if got := tc.run(previewBefore(tc.date)).Results[tc.lint].Status; got != lint.Upcoming {
+				t.Errorf("expected %s when previewed, got %s", lint.Upcoming, got)
+			}
+		})
+	}
+}
+
+func TestLintCertificateWithWaivers(t *testing.T) {
+	c := readTestCertificate(t, "rsaFermatFactorizationSusceptible.pem")
+	const name = "e_rsa_fermat_factorization"
+	const fingerprint = "38:17:C1:E5:01:E1:ED:88:60:F5:6B:25:33:76:8E:85:80:31:1D:9E:F1:CB:CB:18:40:3A:6B:29:7B:EF:A7:C6"
+	testCases := []struct {
+		name       string
+		waiver     string
+		wantStatus lint.LintStatus
+		wantWaived bool
+		wantErrors bool
+	}{
+		{
+			name:       "no waiver",
+			wantStatus: lint.Error,
+			wantErrors: true,
+		},
+		{
+			name:       "suppressed by fingerprint",
+			waiver:     `fingerprint = "` + fingerprint + `"`,
+			wantWaived: true,
+		},
+		{
+			name:       "downgraded by serial",
+			waiver:     "serial = \"03\"\nseverity = \"warn\"",
+			wantStatus: lint.Warn,
+			wantWaived: true,
+		},
+		{
+			name:       "no more severe than the waiver",
+			waiver:     "serial = \"03\"\nseverity = \"error\"",
+			wantStatus: lint.Error,
+			wantErrors: true,
+		},
+		{
+			name:       "other serial",
+			waiver:     `serial = "04"`,
+			wantStatus: lint.Error,
+			wantErrors: true,
+		},
+		{
+			name:       "expired",
+			waiver:     "fingerprint = \"" + fingerprint + "\"\nexpires = 2020-01-01T00:00:00Z",
+			wantStatus: lint.Error,
+			wantErrors: true,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			registry, err := lint.GlobalRegistry().Filter(lint.FilterOptions{
+				IncludeNames: []string{name},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tc.waiver != "" {
+				expires := "expires = 2100-01-01T00:00:00Z"
+				if strings.Contains(tc.waiver, "expires") {
+					expires = ""
+				}
+				config, err := lint.NewConfigFromString(fmt.Sprintf(`
+[[Waivers]]
+lint = %q
+justification = "Approved test exception"
+%s
+%s
+`, name, tc.waiver, expires))
+				if err != nil {
+					t.Fatal(err)
+				}
+				registry.SetConfiguration(config)
+			}
+			res := LintCertificateEx(c, registry)
+			got, ok := res.Results[name]
+			if tc.wantStatus == lint.Reserved {
+				if ok {
+					t.Errorf("expected the finding to be suppressed, got %s", got.Status)
+				}
+			} else if !ok || got.Status != tc.wantStatus {
+				t.Errorf("expected %s, got %v", tc.wantStatus, got)
+			}
+			waived, ok := res.Waived[name]
+			if ok != tc.wantWaived {
+				t.Fatalf("expected the finding to be waived: %t, got %t", tc.wantWaived, ok)
+			}
+			if ok && (waived.Result.Status != lint.Error || waived.Waiver.Justification == "") {
+				t.Errorf("expected the original error and its waiver to be recorded, got %s", waived.Result.Status)
+			}
+			if res.ErrorsPresent != tc.wantErrors {
+				t.Errorf("expected ErrorsPresent to be %t", tc.wantErrors)
+			}
+		})
+	}
+}